@@ -0,0 +1,115 @@
+// This package implements the mel command. Its only subcommand is repl, that starts an interactive SQL REPL:
+//
+//	mel repl [-theme name|file] [-history file]
+//
+// On a terminal the code is highlighted as it is typed. The statements are only checked, since no executor is wired in.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/repl"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal/rgb"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/theme"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command with the arguments args and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "repl" {
+		fmt.Fprintln(stderr, "usage: mel repl [-theme name|file] [-history file]")
+		return 2
+	}
+
+	flags := flag.NewFlagSet("mel repl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	themeName := flags.String("theme", "dark", "the built-in theme (light or dark) or the theme file used to highlight the code")
+	historyFile := flags.String("history", "", "the file where the history is kept")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	th, err := loadTheme(*themeName)
+	if err != nil {
+		fmt.Fprintf(stderr, "mel: %s\n", err)
+		return 1
+	}
+
+	history := repl.NewHistory(repl.DefaultHistorySize)
+	if *historyFile != "" {
+		if err := readHistory(history, *historyFile); err != nil {
+			fmt.Fprintf(stderr, "mel: %s\n", err)
+			return 1
+		}
+	}
+
+	opts := []repl.Option{repl.WithHistory(history)}
+	if f, ok := stdin.(*os.File); ok && repl.IsTerminal(int(f.Fd())) {
+		restore, err := repl.MakeRaw(int(f.Fd()))
+		if err != nil {
+			fmt.Fprintf(stderr, "mel: %s\n", err)
+			return 1
+		}
+		defer restore()
+		opts = append(opts, repl.WithHighlighter(repl.NewHighlighter(th, rgb.DetectMode(os.Getenv))))
+	} else {
+		opts = append(opts, repl.WithLineMode(), repl.WithPrompts("", ""))
+	}
+
+	code := 0
+	if err := repl.New(stdin, stdout, opts...).Run(context.Background()); err != nil {
+		fmt.Fprintf(stderr, "mel: %s\n", err)
+		code = 1
+	}
+	if *historyFile != "" {
+		if err := writeHistory(history, *historyFile); err != nil {
+			fmt.Fprintf(stderr, "mel: %s\n", err)
+			code = 1
+		}
+	}
+	return code
+}
+
+// loadTheme returns the built-in theme with the given name or, if there is none, loads the theme from the file name.
+func loadTheme(name string) (*theme.Theme, error) {
+	th, err := theme.Builtin(name)
+	if errors.Is(err, theme.ErrUnknownTheme) {
+		return theme.LoadFile(name)
+	}
+	return th, err
+}
+
+// readHistory adds the lines of the file name to h. A file that doesn't exist is an empty history.
+func readHistory(h *repl.History, name string) error {
+	f, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = h.ReadFrom(f)
+	return err
+}
+
+// writeHistory writes h to the file name.
+func writeHistory(h *repl.History, name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := h.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
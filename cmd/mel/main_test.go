@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	history := filepath.Join(t.TempDir(), "history")
+	if err := os.WriteFile(history, []byte("VACUUM;\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr strings.Builder
+	code := run([]string{"repl", "-theme", "light", "-history", history}, strings.NewReader("SELECT 1;\nSELEC 1;\n"), &stdout,
+		&stderr)
+	if code != 0 {
+		t.Fatalf("got exit code %d (%s), want 0", code, stderr.String())
+	}
+	if want := "SELEC 1;\n^ unexpected Identifier\n"; stdout.String() != want {
+		t.Errorf("got output %q, want %q", stdout.String(), want)
+	}
+
+	data, err := os.ReadFile(history)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "VACUUM;\nSELECT 1;\nSELEC 1;\n"; string(data) != want {
+		t.Errorf("got history %q, want %q", data, want)
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	cases := [][]string{
+		nil,
+		{"other"},
+		{"repl", "-unknown"},
+	}
+	for _, args := range cases {
+		var stdout, stderr strings.Builder
+		if code := run(args, strings.NewReader(""), &stdout, &stderr); code != 2 {
+			t.Errorf("run(%q) got exit code %d, want 2", args, code)
+		}
+	}
+}
+
+func TestRunTheme(t *testing.T) {
+	var stdout, stderr strings.Builder
+	code := run([]string{"repl", "-theme", filepath.Join(t.TempDir(), "missing.json")}, strings.NewReader(""), &stdout, &stderr)
+	if code != 1 || stderr.String() == "" {
+		t.Errorf("got exit code %d and error %q, want 1 and an error", code, stderr.String())
+	}
+}
@@ -0,0 +1,688 @@
+// This package builds a catalog of the schema objects, that is, the tables, indexes, views and triggers, defined by SQL
+// statements. The statements are applied in order, like SQLite would execute them, so a catalog can follow a sequence of
+// migrations. The objects are identified by schema and name, ignoring the case of the ASCII letters, and the unqualified
+// names are looked up like SQLite does. The dependencies between the objects form a graph, that gives the order to
+// create or to drop them.
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+var (
+	// ErrExists is returned when an object is created with the name of an existing one.
+	ErrExists = errors.New("catalog: object already exists")
+	// ErrNoSuchTable is returned when a statement refers to a table that doesn't exist.
+	ErrNoSuchTable = errors.New("catalog: no such table")
+	// ErrNoSuchIndex is returned when a statement refers to an index that doesn't exist.
+	ErrNoSuchIndex = errors.New("catalog: no such index")
+	// ErrNoSuchView is returned when a statement refers to a view that doesn't exist.
+	ErrNoSuchView = errors.New("catalog: no such view")
+	// ErrNoSuchTrigger is returned when a statement refers to a trigger that doesn't exist.
+	ErrNoSuchTrigger = errors.New("catalog: no such trigger")
+	// ErrNoSuchColumn is returned when a statement refers to a column that doesn't exist.
+	ErrNoSuchColumn = errors.New("catalog: no such column")
+	// ErrCannotDropColumn is returned when a column that SQLite doesn't drop is dropped.
+	ErrCannotDropColumn = errors.New("catalog: cannot drop column")
+)
+
+// Catalog contains the schema objects. The objects are in the order that they were created.
+type Catalog struct {
+	Tables   []*Table
+	Indexes  []*Index
+	Views    []*View
+	Triggers []*Trigger
+
+	// notes are the comments of the statements being executed, if any.
+	notes *notes
+}
+
+// New creates an empty catalog.
+func New() *Catalog {
+	return &Catalog{}
+}
+
+// Load creates a catalog with the objects defined by the statements of code.
+func Load(code []byte) (*Catalog, error) {
+	c := New()
+	if err := c.Exec(code); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Exec parses and applies the statements of code, in order. It stops at the first statement that has a syntax error or
+// can't be applied, and the error returned tells which statement it is. The comments of the statements are kept in the
+// Comment fields of the objects created.
+func (c *Catalog) Exec(code []byte) error {
+	c.notes = newNotes(lexer.New(code))
+	defer func() { c.notes = nil }()
+	p := parser.New(c.notes)
+	for i := 1; ; i++ {
+		stmt, err := next(p, c.notes)
+		if err != nil {
+			return fmt.Errorf("statement %d: %w", i, err)
+		}
+		if err := c.Apply(stmt); err != nil {
+			return fmt.Errorf("statement %d: %w", i, err)
+		}
+		if nt, ok := stmt.(parsetree.NonTerminal); !ok || syntax.Last(nt) {
+			return nil
+		}
+	}
+}
+
+// next parses the next statement of p, recovering from the panics of the parser, and adds your comments to n.
+func next(p *parser.Parser, n *notes) (stmt parsetree.Construction, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok {
+				panic(r)
+			}
+			err = e
+		}
+	}()
+	stmt, comments := p.SQLStatement()
+	n.add(comments)
+	return stmt, nil
+}
+
+// Apply applies the statement stmt, a tree of kind parsetree.KindSQLStatement. The statements that don't change the
+// schema, like SELECT, and the statements with EXPLAIN are ignored. If stmt has a syntax error, it is returned.
+func (c *Catalog) Apply(stmt parsetree.Construction) error {
+	if err := syntax.FirstError(stmt); err != nil {
+		return err
+	}
+	nt, ok := stmt.(parsetree.NonTerminal)
+	if !ok {
+		return nil
+	}
+	for _, child := range children(nt) {
+		if child, ok := child.(parsetree.NonTerminal); ok {
+			return c.apply(child, c.notes.of(stmt))
+		}
+	}
+	return nil
+}
+
+// apply applies the statement in tree. The comment is given to the object created, if any.
+func (c *Catalog) apply(tree parsetree.NonTerminal, comment string) error {
+	switch tree.Kind() {
+	case parsetree.KindCreateTable, parsetree.KindCreateVirtualTable:
+		t := newTable(tree)
+		if c.exists(t.SchemaName(), t.Name) {
+			return c.existing(tree, t.Name)
+		}
+		t.Comment = comment
+		c.notes.describeColumns(t, tree)
+		c.Tables = append(c.Tables, t)
+	case parsetree.KindCreateIndex:
+		i := newIndex(tree)
+		t := c.tableOf(i)
+		if t == nil {
+			return fmt.Errorf("%w: %s", ErrNoSuchTable, dotted(i.Schema, i.Table))
+		}
+		if c.exists(t.SchemaName(), i.Name) {
+			return c.existing(tree, i.Name)
+		}
+		i.Comment = comment
+		for _, ic := range i.Columns {
+			if ic.Name != "" && t.Column(ic.Name) == nil && len(t.Columns) > 0 {
+				return fmt.Errorf("%w: %s.%s", ErrNoSuchColumn, t.Name, ic.Name)
+			}
+		}
+		c.Indexes = append(c.Indexes, i)
+	case parsetree.KindCreateView:
+		v := newView(tree)
+		if c.exists(v.SchemaName(), v.Name) {
+			return c.existing(tree, v.Name)
+		}
+		v.Comment = comment
+		c.Views = append(c.Views, v)
+	case parsetree.KindCreateTrigger:
+		t := newTrigger(tree)
+		if tbl, v := c.targetOf(t); tbl == nil && v == nil {
+			return fmt.Errorf("%w: %s", ErrNoSuchTable, dotted(t.Schema, t.Table))
+		}
+		if c.TriggerIn(c.triggerSchema(t), t.Name) != nil {
+			return c.existing(tree, t.Name)
+		}
+		t.Comment = comment
+		c.Triggers = append(c.Triggers, t)
+	case parsetree.KindDropTable:
+		return c.drop(tree, parsetree.KindTableName, ErrNoSuchTable, c.dropTable)
+	case parsetree.KindDropIndex:
+		return c.drop(tree, parsetree.KindIndexName, ErrNoSuchIndex, func(schema, n string) bool {
+			return remove(&c.Indexes, c.IndexIn(schema, n))
+		})
+	case parsetree.KindDropView:
+		return c.drop(tree, parsetree.KindViewName, ErrNoSuchView, func(schema, n string) bool {
+			return remove(&c.Views, c.ViewIn(schema, n))
+		})
+	case parsetree.KindDropTrigger:
+		return c.drop(tree, parsetree.KindTriggerName, ErrNoSuchTrigger, func(schema, n string) bool {
+			return remove(&c.Triggers, c.TriggerIn(schema, n))
+		})
+	case parsetree.KindAlterTable:
+		return c.alterTable(tree, comment)
+	}
+	return nil
+}
+
+// existing returns the error of the creation of an object with the name of an existing one, or nil if the statement in
+// tree has IF NOT EXISTS.
+func (c *Catalog) existing(tree parsetree.NonTerminal, name string) error {
+	if hasToken(tree, token.KindExists) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrExists, name)
+}
+
+// drop applies the DROP statement in tree. The name of the object is in the child of kind nameKind, qualified by the
+// schema in the child of kind parsetree.KindSchemaName, if any, and del deletes the object, reporting whether it exists.
+func (c *Catalog) drop(tree parsetree.NonTerminal, nameKind parsetree.Kind, notFound error, del func(schema, name string) bool) error {
+	schema := syntax.ChildName(tree, parsetree.KindSchemaName)
+	n := syntax.ChildName(tree, nameKind)
+	if !del(schema, n) && !hasToken(tree, token.KindExists) {
+		return fmt.Errorf("%w: %s", notFound, dotted(schema, n))
+	}
+	return nil
+}
+
+// dropTable deletes the table with the given name in the schema, and its indexes and triggers. It reports whether the
+// table exists.
+func (c *Catalog) dropTable(schema, n string) bool {
+	t := c.TableIn(schema, n)
+	if t == nil {
+		return false
+	}
+	_, indexes, triggers := c.referrers(t)
+	c.Indexes = slices.DeleteFunc(c.Indexes, func(i *Index) bool { return slices.Contains(indexes, i) })
+	c.Triggers = slices.DeleteFunc(c.Triggers, func(tr *Trigger) bool { return slices.Contains(triggers, tr) })
+	return remove(&c.Tables, t)
+}
+
+// alterTable applies the ALTER TABLE statement in tree. The comment is given to the column added, if any.
+func (c *Catalog) alterTable(tree parsetree.NonTerminal, comment string) error {
+	var t *Table
+	for _, child := range children(tree) {
+		switch child.Kind() {
+		case parsetree.KindTableName:
+			schema, n := syntax.ChildName(tree, parsetree.KindSchemaName), syntax.Name(child.(parsetree.Terminal))
+			if t = c.TableIn(schema, n); t == nil {
+				return fmt.Errorf("%w: %s", ErrNoSuchTable, dotted(schema, n))
+			}
+		case parsetree.KindRenameTo:
+			return c.renameTable(t, syntax.ChildName(child.(parsetree.NonTerminal), parsetree.KindTableName))
+		case parsetree.KindRenameColumn:
+			var ns []string
+			for _, cn := range children(child.(parsetree.NonTerminal)) {
+				if cn.Kind() == parsetree.KindColumnName {
+					ns = append(ns, syntax.Name(cn.(parsetree.Terminal)))
+				}
+			}
+			return c.renameColumn(t, ns[0], ns[1])
+		case parsetree.KindAddColumn:
+			for _, cd := range children(child.(parsetree.NonTerminal)) {
+				if cd.Kind() == parsetree.KindColumnDefinition {
+					col := newColumn(cd.(parsetree.NonTerminal))
+					if t.Column(col.Name) != nil {
+						return fmt.Errorf("%w: %s.%s", ErrExists, t.Name, col.Name)
+					}
+					col.Comment = strings.TrimSpace(comment + "\n" + c.notes.of(cd))
+					t.Columns = append(t.Columns, col)
+				}
+			}
+		case parsetree.KindDropColumn:
+			return c.dropColumn(t, syntax.ChildName(child.(parsetree.NonTerminal), parsetree.KindColumnName))
+		}
+	}
+	return nil
+}
+
+// dropColumn drops the column of t with the given name. Like SQLite, it doesn't drop the only column, a column of the
+// primary key or a column with UNIQUE.
+func (c *Catalog) dropColumn(t *Table, n string) error {
+	col := t.Column(n)
+	if col == nil {
+		return fmt.Errorf("%w: %s.%s", ErrNoSuchColumn, t.Name, n)
+	}
+	switch {
+	case len(t.Columns) == 1:
+		return fmt.Errorf("%w: %s.%s: no other columns exist", ErrCannotDropColumn, t.Name, n)
+	case slices.ContainsFunc(t.PrimaryKey(), func(pk string) bool { return literal.EqualIdentifiers(pk, n) }):
+		return fmt.Errorf("%w: %s.%s: PRIMARY KEY", ErrCannotDropColumn, t.Name, n)
+	case col.Constraint(ConstraintUnique) != nil || slices.ContainsFunc(t.Constraints, func(cons *Constraint) bool {
+		return cons.Kind == ConstraintUnique && slices.ContainsFunc(cons.ColumnNames(), func(u string) bool { return literal.EqualIdentifiers(u, n) })
+	}):
+		return fmt.Errorf("%w: %s.%s: UNIQUE", ErrCannotDropColumn, t.Name, n)
+	}
+	t.Columns = slices.DeleteFunc(t.Columns, func(c *Column) bool { return c == col })
+	return nil
+}
+
+// RenameTable renames a table. The table is looked up like in Table. Like SQLite, the references to the table in the
+// indexes, triggers, views and foreign keys are renamed too.
+func (c *Catalog) RenameTable(from, to string) error {
+	t := c.Table(from)
+	if t == nil {
+		return fmt.Errorf("%w: %s", ErrNoSuchTable, from)
+	}
+	return c.renameTable(t, to)
+}
+
+// renameTable renames the table t. The references to t are renamed in the foreign keys of the tables of your schema, in
+// your indexes and triggers, and in the views and the bodies of the triggers that may refer to t.
+func (c *Catalog) renameTable(t *Table, to string) error {
+	if c.exists(t.SchemaName(), to) {
+		return fmt.Errorf("%w: %s", ErrExists, to)
+	}
+	// the objects that refer to t are found before the rename, because they are found by the name of t.
+	fks, indexes, triggers := c.referrers(t)
+	old := t.Name
+	t.Name = to
+
+	rename := func(ancestors []parsetree.NonTerminal, term parsetree.Terminal) (string, bool) {
+		return to, term.Kind() == parsetree.KindTableName && literal.EqualIdentifiers(syntax.Name(term), old)
+	}
+	for _, fk := range fks {
+		fk.Table = to
+	}
+	for _, i := range indexes {
+		i.Table = to
+	}
+	for _, tr := range triggers {
+		tr.Table = to
+	}
+	for _, v := range c.Views {
+		if c.sees(v.SchemaName(), t) {
+			v.Select = rewrite(v.Select, rename)
+		}
+	}
+	for _, tr := range c.Triggers {
+		if c.sees(c.triggerSchema(tr), t) {
+			for i, stmt := range tr.Body {
+				tr.Body[i] = rewrite(stmt, rename)
+			}
+		}
+	}
+	return nil
+}
+
+// referrers returns the foreign keys whose parent is t, the indexes of t and the triggers of t.
+func (c *Catalog) referrers(t *Table) (fks []*ForeignKey, indexes []*Index, triggers []*Trigger) {
+	for _, other := range c.Tables {
+		for _, fk := range foreignKeys(other) {
+			if c.Parent(other, fk) == t {
+				fks = append(fks, fk)
+			}
+		}
+	}
+	for _, i := range c.Indexes {
+		if c.tableOf(i) == t {
+			indexes = append(indexes, i)
+		}
+	}
+	for _, tr := range c.Triggers {
+		if tbl, _ := c.targetOf(tr); tbl == t {
+			triggers = append(triggers, tr)
+		}
+	}
+	return fks, indexes, triggers
+}
+
+// sees reports whether the statements of the objects of the given schema may refer to the table t. Like in SQLite, the
+// objects of temp may refer to the tables of any schema, and the others only to the tables of your schema.
+func (c *Catalog) sees(schema string, t *Table) bool {
+	key := schemaKey(schema, false)
+	return key == tempSchema || key == schemaKey(t.Schema, t.Temporary)
+}
+
+// RenameColumn renames a column of a table. The table is looked up like in Table. Like SQLite, the references to the
+// column in the constraints of the table, in the foreign keys, indexes, triggers and views are renamed too. The
+// references are found by the names of the tables, that is, the columns qualified by the table, by NEW or OLD in its
+// triggers, or unqualified in statements that use the table.
+func (c *Catalog) RenameColumn(table, from, to string) error {
+	t := c.Table(table)
+	if t == nil {
+		return fmt.Errorf("%w: %s", ErrNoSuchTable, table)
+	}
+	return c.renameColumn(t, from, to)
+}
+
+// renameColumn renames the column from of the table t.
+func (c *Catalog) renameColumn(t *Table, from, to string) error {
+	col := t.Column(from)
+	if col == nil {
+		return fmt.Errorf("%w: %s.%s", ErrNoSuchColumn, t.Name, from)
+	}
+	if t.Column(to) != nil {
+		return fmt.Errorf("%w: %s.%s", ErrExists, t.Name, to)
+	}
+	old := col.Name
+	col.Name = to
+
+	renameName := func(n *string) {
+		if literal.EqualIdentifiers(*n, old) {
+			*n = to
+		}
+	}
+	own := columnRenamer(t.Name, old, to, true)
+	for _, cons := range constraints(t) {
+		for i := range cons.Columns {
+			renameName(&cons.Columns[i].Name)
+			cons.Columns[i].Expression = rewriteExpression(cons.Columns[i].Expression, own)
+		}
+		if cons.Kind == ConstraintCheck || cons.Kind == ConstraintGenerated {
+			cons.Expression = rewriteExpression(cons.Expression, own)
+		}
+		if cons.ForeignKey != nil {
+			for i := range cons.ForeignKey.Columns {
+				renameName(&cons.ForeignKey.Columns[i])
+			}
+		}
+	}
+	fks, indexes, triggers := c.referrers(t)
+	for _, fk := range fks {
+		for i := range fk.ReferencedColumns {
+			renameName(&fk.ReferencedColumns[i])
+		}
+	}
+	for _, i := range indexes {
+		for j := range i.Columns {
+			renameName(&i.Columns[j].Name)
+			i.Columns[j].Expression = rewriteExpression(i.Columns[j].Expression, own)
+		}
+		i.Where = rewriteExpression(i.Where, own)
+	}
+	for _, v := range c.Views {
+		if !c.sees(v.SchemaName(), t) {
+			continue
+		}
+		uses := slices.ContainsFunc(v.References(), func(r string) bool { return literal.EqualIdentifiers(r, t.Name) })
+		v.Select = rewrite(v.Select, columnRenamer(t.Name, old, to, uses))
+	}
+	for _, tr := range c.Triggers {
+		if !c.sees(c.triggerSchema(tr), t) {
+			continue
+		}
+		onTable := slices.Contains(triggers, tr)
+		if onTable {
+			for i := range tr.Columns {
+				renameName(&tr.Columns[i])
+			}
+			tr.When = rewriteExpression(tr.When, columnRenamer(t.Name, old, to, false, "NEW", "OLD"))
+		}
+		for i, stmt := range tr.Body {
+			uses := slices.ContainsFunc(references(stmt), func(r string) bool { return literal.EqualIdentifiers(r, t.Name) })
+			var qualifiers []string
+			if onTable {
+				qualifiers = []string{"NEW", "OLD"}
+			}
+			tr.Body[i] = rewrite(stmt, columnRenamer(t.Name, old, to, uses, qualifiers...))
+		}
+	}
+	return nil
+}
+
+// columnRenamer returns a renamer that renames the column from of table to the name to. The column is renamed where it
+// is qualified by table or by one of the qualifiers, where it is unqualified if unqualified is true, and in the column
+// lists of INSERT and UPDATE statements on table.
+func columnRenamer(table, from, to string, unqualified bool, qualifiers ...string) renamer {
+	qualifiers = append(qualifiers, table)
+	return func(ancestors []parsetree.NonTerminal, t parsetree.Terminal) (string, bool) {
+		if t.Kind() != parsetree.KindColumnName || !literal.EqualIdentifiers(syntax.Name(t), from) {
+			return "", false
+		}
+		parent := ancestors[len(ancestors)-1]
+		if parent.Kind() == parsetree.KindColumnReference {
+			q := syntax.ChildName(parent, parsetree.KindTableName)
+			if q == "" {
+				return to, unqualified
+			}
+			return to, slices.ContainsFunc(qualifiers, func(s string) bool { return literal.EqualIdentifiers(s, q) })
+		}
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			switch ancestors[i].Kind() {
+			case parsetree.KindInsert:
+				return to, literal.EqualIdentifiers(syntax.ChildName(ancestors[i], parsetree.KindTableName), table)
+			case parsetree.KindUpdate:
+				target := ""
+				for _, c := range children(ancestors[i]) {
+					if c.Kind() == parsetree.KindQualifiedTableName {
+						target = syntax.ChildName(c.(parsetree.NonTerminal), parsetree.KindTableName)
+					}
+				}
+				return to, literal.EqualIdentifiers(target, table)
+			case parsetree.KindSimpleSelect, parsetree.KindCompoundSelect, parsetree.KindDelete:
+				return "", false
+			}
+		}
+		return "", false
+	}
+}
+
+// rewrite returns the statement in code with the names renamed by rename. If code has a syntax error it is returned
+// unchanged.
+func rewrite(code string, rename renamer) string {
+	stmt, err := syntax.Parse(parser.New(lexer.New([]byte(code))))
+	if err != nil {
+		return code
+	}
+	return textRenamed(stmt, rename)
+}
+
+// rewriteExpression returns the expression expr with the names renamed by rename. If expr is empty or has a syntax
+// error it is returned unchanged.
+func rewriteExpression(expr string, rename renamer) string {
+	if expr == "" {
+		return expr
+	}
+	const prefix = "SELECT "
+	s := rewrite(prefix+expr, rename)
+	if len(s) < len(prefix) {
+		return expr
+	}
+	return s[len(prefix):]
+}
+
+// constraints returns the column and table constraints of t.
+func constraints(t *Table) []*Constraint {
+	var cs []*Constraint
+	for _, col := range t.Columns {
+		cs = append(cs, col.Constraints...)
+	}
+	return append(cs, t.Constraints...)
+}
+
+// foreignKeys returns the foreign keys of the constraints of t. Unlike Table.ForeignKeys, they are not copies.
+func foreignKeys(t *Table) []*ForeignKey {
+	var fks []*ForeignKey
+	for _, cons := range constraints(t) {
+		if cons.ForeignKey != nil {
+			fks = append(fks, cons.ForeignKey)
+		}
+	}
+	return fks
+}
+
+// Table returns the table with the given name, or nil if there is none. The name is looked up like in TableIn with an
+// empty schema.
+func (c *Catalog) Table(name string) *Table {
+	return c.TableIn("", name)
+}
+
+// Index returns the index with the given name, or nil if there is none. The name is looked up like in TableIn with an
+// empty schema.
+func (c *Catalog) Index(name string) *Index {
+	return c.IndexIn("", name)
+}
+
+// View returns the view with the given name, or nil if there is none. The name is looked up like in TableIn with an
+// empty schema.
+func (c *Catalog) View(name string) *View {
+	return c.ViewIn("", name)
+}
+
+// Trigger returns the trigger with the given name, or nil if there is none. The name is looked up like in TableIn with
+// an empty schema.
+func (c *Catalog) Trigger(name string) *Trigger {
+	return c.TriggerIn("", name)
+}
+
+// IndexesOf returns the indexes of the table with the given name in the schema. An empty schema is like in TableIn.
+func (c *Catalog) IndexesOf(schema, table string) []*Index {
+	t := c.TableIn(schema, table)
+	if t == nil {
+		return nil
+	}
+	_, is, _ := c.referrers(t)
+	return is
+}
+
+// TriggersOf returns the triggers of the table or view with the given name in the schema. An empty schema is like in
+// TableIn.
+func (c *Catalog) TriggersOf(schema, table string) []*Trigger {
+	tbl, v := c.TableIn(schema, table), c.ViewIn(schema, table)
+	if tbl == nil && v == nil {
+		return nil
+	}
+	var ts []*Trigger
+	for _, t := range c.Triggers {
+		if ttbl, tv := c.targetOf(t); tbl != nil && ttbl == tbl || v != nil && tv == v {
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// UniqueKeys returns the keys of the table with the given name in the schema, that is, the sets of columns whose values
+// are unique: the primary key, the columns and the table constraints with UNIQUE, and the unique indexes that are not
+// partial. The keys with expressions are omitted. An empty schema is like in TableIn.
+func (c *Catalog) UniqueKeys(schema, table string) [][]string {
+	t := c.TableIn(schema, table)
+	if t == nil {
+		return nil
+	}
+	var keys [][]string
+	if pk := t.PrimaryKey(); pk != nil {
+		keys = append(keys, pk)
+	}
+	for _, col := range t.Columns {
+		if col.Constraint(ConstraintUnique) != nil {
+			keys = append(keys, []string{col.Name})
+		}
+	}
+	for _, cons := range t.Constraints {
+		if cons.Kind == ConstraintUnique {
+			keys = append(keys, cons.ColumnNames())
+		}
+	}
+	_, indexes, _ := c.referrers(t)
+	for _, i := range indexes {
+		if !i.Unique || i.Where != "" || slices.ContainsFunc(i.Columns, func(ic IndexedColumn) bool { return ic.Name == "" }) {
+			continue
+		}
+		var key []string
+		for _, ic := range i.Columns {
+			key = append(key, ic.Name)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Unique reports whether the columns are a key of the table with the given name in the schema, in any order. Like in
+// SQLite, the parent columns of a foreign key must be a key of the parent table. An empty schema is like in TableIn.
+func (c *Catalog) Unique(schema, table string, columns []string) bool {
+	return slices.ContainsFunc(c.UniqueKeys(schema, table), func(key []string) bool { return sameColumns(key, columns) })
+}
+
+// sameColumns reports whether a and b have the same columns, in any order.
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, n := range a {
+		if !slices.ContainsFunc(b, func(m string) bool { return literal.EqualIdentifiers(n, m) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of c.
+func (c *Catalog) Clone() *Catalog {
+	d := &Catalog{}
+	for _, t := range c.Tables {
+		d.Tables = append(d.Tables, t.clone())
+	}
+	for _, i := range c.Indexes {
+		ci := *i
+		ci.Columns = slices.Clone(i.Columns)
+		d.Indexes = append(d.Indexes, &ci)
+	}
+	for _, v := range c.Views {
+		cv := *v
+		cv.Columns = slices.Clone(v.Columns)
+		d.Views = append(d.Views, &cv)
+	}
+	for _, t := range c.Triggers {
+		ct := *t
+		ct.Columns = slices.Clone(t.Columns)
+		ct.Body = slices.Clone(t.Body)
+		d.Triggers = append(d.Triggers, &ct)
+	}
+	return d
+}
+
+// exists reports whether there is a table, index or view with the given name in the schema. Like in SQLite, they share
+// the names of a schema.
+func (c *Catalog) exists(schema, name string) bool {
+	return c.TableIn(schema, name) != nil || c.IndexIn(schema, name) != nil || c.ViewIn(schema, name) != nil
+}
+
+// remove deletes e from s. It reports whether e is not nil.
+func remove[T any](s *[]*T, e *T) bool {
+	if e == nil {
+		return false
+	}
+	*s = slices.DeleteFunc(*s, func(o *T) bool { return o == e })
+	return true
+}
+
+// appendName appends n to ns, unless ns already has it.
+func appendName(ns []string, n string) []string {
+	if slices.ContainsFunc(ns, func(s string) bool { return literal.EqualIdentifiers(s, n) }) {
+		return ns
+	}
+	return append(ns, n)
+}
+
+// withoutNames returns ns without the names in del.
+func withoutNames(ns, del []string) []string {
+	return slices.DeleteFunc(ns, func(n string) bool {
+		return slices.ContainsFunc(del, func(d string) bool { return literal.EqualIdentifiers(d, n) })
+	})
+}
+
+// hasToken reports whether tree has a child that is a token of kind k.
+func hasToken(tree parsetree.NonTerminal, k token.Kind) bool {
+	for _, c := range children(tree) {
+		if t, ok := c.(parsetree.Terminal); ok && t.Kind() == parsetree.KindToken && t.Token().Kind == k {
+			return true
+		}
+	}
+	return false
+}
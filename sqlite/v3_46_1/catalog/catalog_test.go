@@ -0,0 +1,190 @@
+package catalog
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// load loads code in a catalog, failing the test on error.
+func load(t *testing.T, code string) *Catalog {
+	t.Helper()
+	c, err := Load([]byte(code))
+	if err != nil {
+		t.Fatalf("Load(%q): %v", code, err)
+	}
+	return c
+}
+
+// TestExec tests that Exec applies the statements in order.
+func TestExec(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE a(x INTEGER PRIMARY KEY, y TEXT);
+		CREATE TABLE b(z);
+		CREATE INDEX i ON a(y);
+		CREATE VIEW v AS SELECT y FROM a;
+		CREATE TRIGGER tr AFTER INSERT ON a BEGIN INSERT INTO b VALUES (new.y); END;
+		SELECT * FROM a;
+		EXPLAIN DROP TABLE a;
+		DROP TABLE b;
+		ALTER TABLE a ADD COLUMN w INT DEFAULT 0;
+		ALTER TABLE a DROP COLUMN w;
+		CREATE TABLE IF NOT EXISTS a(q);
+		DROP VIEW IF EXISTS none;
+	`)
+	if len(c.Tables) != 1 || c.Tables[0].Name != "a" || len(c.Tables[0].Columns) != 2 {
+		t.Fatalf("unexpected tables %v", c.Tables)
+	}
+	if c.Index("I") == nil || c.View("v") == nil || c.Trigger("tr") == nil {
+		t.Errorf("missing objects")
+	}
+	if len(c.IndexesOf("", "a")) != 1 || len(c.TriggersOf("", "A")) != 1 {
+		t.Errorf("IndexesOf or TriggersOf are wrong")
+	}
+}
+
+// TestExecErrors tests the errors returned by Exec.
+func TestExecErrors(t *testing.T) {
+	cases := []struct {
+		code string
+		want error
+	}{
+		{code: "CREATE TABLE a(x); CREATE TABLE A(y);", want: ErrExists},
+		{code: "CREATE TABLE a(x); CREATE INDEX a ON a(x);", want: ErrExists},
+		{code: "DROP TABLE a;", want: ErrNoSuchTable},
+		{code: "CREATE INDEX i ON a(x);", want: ErrNoSuchTable},
+		{code: "DROP INDEX i;", want: ErrNoSuchIndex},
+		{code: "DROP VIEW v;", want: ErrNoSuchView},
+		{code: "DROP TRIGGER tr;", want: ErrNoSuchTrigger},
+		{code: "CREATE TABLE a(x, y); ALTER TABLE a DROP COLUMN z;", want: ErrNoSuchColumn},
+		{code: "CREATE TABLE a(x); ALTER TABLE a DROP COLUMN x;", want: ErrCannotDropColumn},
+		{code: "CREATE TABLE a(x PRIMARY KEY, y); ALTER TABLE a DROP COLUMN x;", want: ErrCannotDropColumn},
+		{code: "CREATE TABLE a(x, y, UNIQUE(y)); ALTER TABLE a DROP COLUMN y;", want: ErrCannotDropColumn},
+	}
+	for _, c := range cases {
+		if _, err := Load([]byte(c.code)); !errors.Is(err, c.want) {
+			t.Errorf("Load(%q) = %v, want %v", c.code, err, c.want)
+		}
+	}
+
+	if _, err := Load([]byte("CREATE TABLE a(x); CREATE TABLE (")); err == nil || err.Error()[:11] != "statement 2" {
+		t.Errorf("want a syntax error in the statement 2, got %v", err)
+	}
+}
+
+// TestDropTable tests that dropping a table drops its indexes and triggers.
+func TestDropTable(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE a(x);
+		CREATE TABLE b(y);
+		CREATE INDEX i ON a(x);
+		CREATE INDEX j ON b(y);
+		CREATE TRIGGER tr AFTER DELETE ON a BEGIN DELETE FROM b; END;
+		DROP TABLE a;
+	`)
+	if len(c.Tables) != 1 || len(c.Indexes) != 1 || c.Indexes[0].Name != "j" || len(c.Triggers) != 0 {
+		t.Errorf("unexpected catalog %+v", c)
+	}
+}
+
+// TestRenameTable tests that renaming a table updates the objects that refer to it.
+func TestRenameTable(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE a(x PRIMARY KEY);
+		CREATE TABLE b(y REFERENCES a(x), FOREIGN KEY (y) REFERENCES a);
+		CREATE INDEX i ON a(x);
+		CREATE VIEW v AS SELECT a.x FROM a JOIN b ON a.x = b.y;
+		CREATE TRIGGER tr AFTER INSERT ON b BEGIN UPDATE a SET x = 1; END;
+		ALTER TABLE a RENAME TO c;
+	`)
+	if c.Table("a") != nil || c.Table("c") == nil {
+		t.Fatalf("the table was not renamed")
+	}
+	fks := c.Table("b").ForeignKeys()
+	if len(fks) != 2 || fks[0].Table != "c" || fks[1].Table != "c" {
+		t.Errorf("foreign keys not updated: %v", fks)
+	}
+	if c.Index("i").Table != "c" {
+		t.Errorf("index not updated")
+	}
+	if want := "SELECT c.x FROM c JOIN b ON c.x = b.y"; c.View("v").Select != want {
+		t.Errorf("got view %q, want %q", c.View("v").Select, want)
+	}
+	if want := []string{"UPDATE c SET x = 1"}; !slices.Equal(c.Trigger("tr").Body, want) {
+		t.Errorf("got trigger body %q, want %q", c.Trigger("tr").Body, want)
+	}
+
+	if err := c.RenameTable("nothing", "d"); !errors.Is(err, ErrNoSuchTable) {
+		t.Errorf("got %v, want ErrNoSuchTable", err)
+	}
+	if err := c.RenameTable("b", "i"); !errors.Is(err, ErrExists) {
+		t.Errorf("got %v, want ErrExists", err)
+	}
+}
+
+// TestRenameColumn tests that renaming a column updates the objects that refer to it.
+func TestRenameColumn(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE t(a INTEGER, b TEXT CHECK (b <> ''), c, UNIQUE (b COLLATE nocase));
+		CREATE TABLE u(x REFERENCES t(b));
+		CREATE INDEX i ON t(b DESC, c + 1) WHERE b > 0;
+		CREATE VIEW v AS SELECT b, t.c FROM t;
+		CREATE TRIGGER tr AFTER UPDATE OF b ON t WHEN new.b <> old.b BEGIN UPDATE u SET x = new.b; END;
+		ALTER TABLE t RENAME COLUMN b TO bb;
+	`)
+	tbl := c.Table("t")
+	if tbl.Column("b") != nil || tbl.Column("bb") == nil {
+		t.Fatalf("the column was not renamed")
+	}
+	checks := []struct{ got, want string }{
+		{got: tbl.SQL(), want: "CREATE TABLE t(\n  a INTEGER,\n  bb TEXT CHECK (bb <> ''),\n  c,\n  UNIQUE (bb COLLATE nocase)\n)"},
+		{got: c.Table("u").SQL(), want: "CREATE TABLE u(\n  x REFERENCES t(bb)\n)"},
+		{got: c.Index("i").SQL(), want: "CREATE INDEX i ON t(bb DESC, c + 1) WHERE bb > 0"},
+		{got: c.View("v").SQL(), want: "CREATE VIEW v AS SELECT bb, t.c FROM t"},
+		{got: c.Trigger("tr").SQL(), want: "CREATE TRIGGER tr AFTER UPDATE OF bb ON t WHEN new.bb <> old.bb BEGIN\n  UPDATE u SET x = new.bb;\nEND"},
+	}
+	for _, ch := range checks {
+		if ch.got != ch.want {
+			t.Errorf("got\n%s\nwant\n%s", ch.got, ch.want)
+		}
+	}
+
+	if err := c.RenameColumn("t", "nothing", "z"); !errors.Is(err, ErrNoSuchColumn) {
+		t.Errorf("got %v, want ErrNoSuchColumn", err)
+	}
+}
+
+// TestClone tests that Clone returns a copy that doesn't share the objects.
+func TestClone(t *testing.T) {
+	c := load(t, "CREATE TABLE a(x); CREATE INDEX i ON a(x);")
+	d := c.Clone()
+	if err := d.RenameColumn("a", "x", "y"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Table("a").Column("x") == nil || c.Index("i").Columns[0].Name != "x" {
+		t.Errorf("the original was changed")
+	}
+}
+
+// TestUniqueKeys tests the keys of a table.
+func TestUniqueKeys(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE t(a INTEGER PRIMARY KEY, b UNIQUE, c, d, e, UNIQUE (c, d));
+		CREATE UNIQUE INDEX i1 ON t(d, e);
+		CREATE UNIQUE INDEX i2 ON t(e) WHERE e > 0;
+		CREATE UNIQUE INDEX i3 ON t(e + 1);
+		CREATE INDEX i4 ON t(e);
+		CREATE TABLE u(a, b, PRIMARY KEY (a, b));
+	`)
+	got := c.UniqueKeys("", "T")
+	want := [][]string{{"a"}, {"b"}, {"c", "d"}, {"d", "e"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := c.UniqueKeys("", "none"); got != nil {
+		t.Errorf("got %q, want nil", got)
+	}
+	if !c.Unique("", "u", []string{"B", "a"}) || c.Unique("", "u", []string{"a"}) || c.Unique("", "t", []string{"e"}) {
+		t.Errorf("wrong Unique")
+	}
+}
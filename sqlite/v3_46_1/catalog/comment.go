@@ -0,0 +1,153 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// notes records the comments read from a lexer, to describe the objects. A comment describes the construction after
+// it, unless it is on the same line as the token before it: then it describes the construction that ends on that
+// token, or on the comma after it.
+type notes struct {
+	l *lexer.Lexer
+	// before maps the tokens to the comments before them, as returned by the parser.
+	before map[*token.Token][]*token.Token
+	// trailing contains the comments that are on the same line as the token before them.
+	trailing map[*token.Token]bool
+	// next maps the tokens to the next one, ignoring the white spaces and comments.
+	next map[*token.Token]*token.Token
+	// prev is the last token that is not a white space or a comment, and sameLine reports whether no new line was read
+	// after it.
+	prev     *token.Token
+	sameLine bool
+}
+
+// newNotes creates a notes that records the comments of the tokens of l.
+func newNotes(l *lexer.Lexer) *notes {
+	return &notes{
+		l:        l,
+		before:   make(map[*token.Token][]*token.Token),
+		trailing: make(map[*token.Token]bool),
+		next:     make(map[*token.Token]*token.Token),
+	}
+}
+
+// Next implements lexical.TokenProvider.
+func (n *notes) Next() *token.Token {
+	tok := n.l.Next()
+	switch tok.Kind {
+	case token.KindWhiteSpace:
+		n.sameLine = n.sameLine && !bytes.ContainsRune(tok.Lexeme, '\n')
+	case token.KindByteOrderMark:
+	case token.KindSQLComment, token.KindCComment:
+		if n.sameLine {
+			n.trailing[tok] = true
+		}
+		n.sameLine = n.sameLine && tok.Kind == token.KindCComment && !bytes.ContainsRune(tok.Lexeme, '\n')
+	default:
+		if n.prev != nil {
+			n.next[n.prev] = tok
+		}
+		n.prev, n.sameLine = tok, true
+	}
+	return tok
+}
+
+// add adds the comments returned by the parser.
+func (n *notes) add(comments map[*token.Token][]*token.Token) {
+	for tok, cs := range comments {
+		n.before[tok] = cs
+	}
+}
+
+// of returns the text of the comments that describe the construction c.
+func (n *notes) of(c parsetree.Construction) string {
+	if n == nil {
+		return ""
+	}
+	first, last := terminals(c)
+	if first == nil {
+		return ""
+	}
+	var texts []string
+	for _, cm := range n.before[first] {
+		if !n.trailing[cm] {
+			texts = append(texts, commentText(cm.Lexeme))
+		}
+	}
+	for tok, i := n.next[last], 0; tok != nil && i < 2; tok, i = n.next[tok], i+1 {
+		for _, cm := range n.before[tok] {
+			if n.trailing[cm] {
+				texts = append(texts, commentText(cm.Lexeme))
+			}
+		}
+		if tok.Kind != token.KindComma {
+			break
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// describeColumns sets the comments of the columns of t, created from tree, with the column definitions of tree.
+func (n *notes) describeColumns(t *Table, tree parsetree.NonTerminal) {
+	i := 0
+	walk(tree, nil, func(c parsetree.Construction, parent parsetree.NonTerminal) {
+		if c.Kind() == parsetree.KindColumnDefinition && i < len(t.Columns) {
+			t.Columns[i].Comment = n.of(c)
+			i++
+		}
+	})
+}
+
+// terminals returns the tokens of the first and of the last terminals of c, or nil if c has no terminals.
+func terminals(c parsetree.Construction) (first, last *token.Token) {
+	walk(c, nil, func(c parsetree.Construction, parent parsetree.NonTerminal) {
+		if t, ok := c.(parsetree.Terminal); ok && t.Token() != nil {
+			if first == nil {
+				first = t.Token()
+			}
+			last = t.Token()
+		}
+	})
+	return first, last
+}
+
+// commentText returns the text of the comment with the given lexeme, without the delimiters and the white spaces
+// around the lines. In a C comment, the asterisks at the start of the lines are removed too.
+func commentText(lexeme []byte) string {
+	s := string(lexeme)
+	if rest, ok := strings.CutPrefix(s, "--"); ok {
+		return strings.TrimSpace(rest)
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "/*"), "*/")
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "*" {
+			line = strings.TrimSpace(strings.TrimPrefix(line, "* "))
+		} else {
+			line = ""
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// commented returns sql preceded by the lines of comment as SQL comments, with the given indentation.
+func commented(comment, indent, sql string) string {
+	if comment == "" {
+		return sql
+	}
+	var b strings.Builder
+	for _, line := range strings.Split(comment, "\n") {
+		b.WriteString(indent)
+		b.WriteString(strings.TrimRight("-- "+line, " "))
+		b.WriteString("\n")
+	}
+	b.WriteString(sql)
+	return b.String()
+}
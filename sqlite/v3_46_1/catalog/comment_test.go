@@ -0,0 +1,97 @@
+package catalog
+
+import "testing"
+
+// TestComments tests that Exec keeps the comments of the objects and of the columns.
+func TestComments(t *testing.T) {
+	c := load(t, `-- the users
+-- of the system
+CREATE TABLE users(
+  -- the identifier
+  id INTEGER PRIMARY KEY, -- never reused
+  name TEXT /* the full name */,
+  email TEXT
+); -- a trailing comment of the table
+
+/*
+ * the index
+ * of the names
+ */
+CREATE INDEX users_name ON users(name);
+CREATE VIEW names AS SELECT name FROM users; -- the names
+-- the trigger
+CREATE TRIGGER tr AFTER INSERT ON users BEGIN SELECT 1; END;
+-- the age
+ALTER TABLE users ADD COLUMN age INT; -- in years
+`)
+	u := c.Table("users")
+	cases := []struct{ got, want string }{
+		{u.Comment, "the users\nof the system\na trailing comment of the table"},
+		{u.Columns[0].Comment, "the identifier\nnever reused"},
+		{u.Columns[1].Comment, "the full name"},
+		{u.Columns[2].Comment, ""},
+		{u.Columns[3].Comment, "the age\nin years"},
+		{c.Index("users_name").Comment, "the index\nof the names"},
+		{c.View("names").Comment, "the names"},
+		{c.Trigger("tr").Comment, "the trigger"},
+	}
+	for i, cs := range cases {
+		if cs.got != cs.want {
+			t.Errorf("case %d: got %q, want %q", i, cs.got, cs.want)
+		}
+	}
+
+	want := `-- the users
+-- of the system
+-- a trailing comment of the table
+CREATE TABLE users(
+  -- the identifier
+  -- never reused
+  id INTEGER PRIMARY KEY,
+  -- the full name
+  name TEXT,
+  email TEXT,
+  -- the age
+  -- in years
+  age INT
+)`
+	if got := u.CommentedSQL(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if got, want := c.View("names").CommentedSQL(), "-- the names\n"+c.View("names").SQL(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := c.Index("users_name").CommentedSQL(), "-- the index\n-- of the names\n"+c.Index("users_name").SQL(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// TestApplyWithoutComments tests that Apply, that has no comments, leaves the comments empty.
+func TestApplyWithoutComments(t *testing.T) {
+	c := load(t, "-- the table\nCREATE TABLE t(a);")
+	if c.Table("t").Comment != "the table" {
+		t.Fatalf("got %q", c.Table("t").Comment)
+	}
+	if c.notes != nil {
+		t.Errorf("the notes were not cleared")
+	}
+	if got := c.Clone().Table("t").Comment; got != "the table" {
+		t.Errorf("got %q from the clone", got)
+	}
+}
+
+// TestCommentText tests commentText.
+func TestCommentText(t *testing.T) {
+	cases := []struct{ lexeme, want string }{
+		{"-- text\r", "text"},
+		{"--text", "text"},
+		{"/* text */", "text"},
+		{"/**\n * a\n *\n * b\n */", "a\n\nb"},
+		{"/*\n  indented\n*/", "indented"},
+	}
+	for _, c := range cases {
+		if got := commentText([]byte(c.lexeme)); got != c.want {
+			t.Errorf("commentText(%q) = %q, want %q", c.lexeme, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+)
+
+// ErrCycle is returned when the objects can't be ordered because they depend on each other.
+var ErrCycle = errors.New("catalog: dependency cycle")
+
+// ObjectKind is the kind of a schema object.
+type ObjectKind int
+
+const (
+	// ObjectTable is a table, including the virtual tables.
+	ObjectTable ObjectKind = iota
+	// ObjectIndex is an index created with CREATE INDEX.
+	ObjectIndex
+	// ObjectView is a view.
+	ObjectView
+	// ObjectTrigger is a trigger.
+	ObjectTrigger
+)
+
+// String returns a string representation of k.
+func (k ObjectKind) String() string {
+	if k < 0 || int(k) >= len(objectKindStrings) {
+		return strconv.Itoa(int(k))
+	}
+	return objectKindStrings[k]
+}
+
+// objectKindStrings contains the string representation of the object kinds. Note that the value of a kind is the index
+// of your string representation.
+var objectKindStrings = []string{"Table", "Index", "View", "Trigger"}
+
+// Object identifies a schema object.
+type Object struct {
+	Kind ObjectKind
+	// Schema is the schema of the object. It is empty for main and temp for the temporary objects.
+	Schema string
+	Name   string
+}
+
+// String returns the kind and the name of o, qualified by the schema if it is not main, like "Table users" or
+// "Table aux.users".
+func (o Object) String() string {
+	return o.Kind.String() + " " + dotted(o.Schema, o.Name)
+}
+
+// SchemaName returns the schema of o, that is Schema or main if it is empty.
+func (o Object) SchemaName() string {
+	return schemaName(o.Schema, false)
+}
+
+// DependencyKind is the kind of a dependency.
+type DependencyKind int
+
+const (
+	// DependencyForeignKey is the dependency of a table on the table referenced by one of your foreign keys.
+	DependencyForeignKey DependencyKind = iota
+	// DependencyReference is the dependency of a view, a trigger or a table created with CREATE TABLE ... AS SELECT on
+	// a table or a view that it reads or writes.
+	DependencyReference
+	// DependencyOwner is the dependency of an index or a trigger on your table, or of a trigger on your view.
+	DependencyOwner
+)
+
+// String returns a string representation of k.
+func (k DependencyKind) String() string {
+	if k < 0 || int(k) >= len(dependencyKindStrings) {
+		return strconv.Itoa(int(k))
+	}
+	return dependencyKindStrings[k]
+}
+
+// dependencyKindStrings contains the string representation of the dependency kinds. Note that the value of a kind is
+// the index of your string representation.
+var dependencyKindStrings = []string{"ForeignKey", "Reference", "Owner"}
+
+// Dependency is the dependency of Object on On.
+type Dependency struct {
+	Object, On Object
+	Kind       DependencyKind
+}
+
+// Graph is the dependency graph of the objects of a catalog. The names that don't identify an object, like the name of
+// a table that was not created, are not in the graph, and an object doesn't depend on itself.
+type Graph struct {
+	objects []Object
+	// indexes maps the kind, the schema and the folded name of the objects to your index in objects.
+	indexes map[objectKey]int
+	// dependencies are the dependencies of the objects and dependents are the dependencies on them, by the index of the
+	// object in objects.
+	dependencies, dependents [][]Dependency
+}
+
+// objectKey is the key of an object in Graph.indexes.
+type objectKey struct {
+	kind ObjectKind
+	// schema is the key of the schema, given by schemaKey.
+	schema string
+	// name is folded with literal.FoldIdentifier.
+	name string
+}
+
+// Graph returns the dependency graph of the objects of c.
+func (c *Catalog) Graph() *Graph {
+	g := &Graph{}
+	for _, t := range c.Tables {
+		g.objects = append(g.objects, tableObject(t))
+	}
+	for _, i := range c.Indexes {
+		g.objects = append(g.objects, c.indexObject(i))
+	}
+	for _, v := range c.Views {
+		g.objects = append(g.objects, viewObject(v))
+	}
+	for _, t := range c.Triggers {
+		g.objects = append(g.objects, c.triggerObject(t))
+	}
+	g.indexes = make(map[objectKey]int, len(g.objects))
+	for i, o := range g.objects {
+		k := keyOf(o)
+		if _, ok := g.indexes[k]; !ok {
+			g.indexes[k] = i
+		}
+	}
+	g.dependencies = make([][]Dependency, len(g.objects))
+	g.dependents = make([][]Dependency, len(g.objects))
+
+	for i, t := range c.Tables {
+		o := g.objects[i]
+		for _, fk := range foreignKeys(t) {
+			if p := c.Parent(t, fk); p != nil {
+				g.add(o, tableObject(p), DependencyForeignKey)
+			}
+		}
+		if t.Select != "" {
+			for _, r := range references(t.Select) {
+				g.add(o, c.relation(o.SchemaName(), r), DependencyReference)
+			}
+		}
+	}
+	for _, i := range c.Indexes {
+		if t := c.tableOf(i); t != nil {
+			g.add(c.indexObject(i), tableObject(t), DependencyOwner)
+		}
+	}
+	for _, v := range c.Views {
+		o := viewObject(v)
+		for _, r := range v.References() {
+			g.add(o, c.relation(v.SchemaName(), r), DependencyReference)
+		}
+	}
+	for _, t := range c.Triggers {
+		o := c.triggerObject(t)
+		if tbl, v := c.targetOf(t); tbl != nil {
+			g.add(o, tableObject(tbl), DependencyOwner)
+		} else if v != nil {
+			g.add(o, viewObject(v), DependencyOwner)
+		}
+		for _, r := range t.References() {
+			g.add(o, c.relation(o.SchemaName(), r), DependencyReference)
+		}
+	}
+	return g
+}
+
+// tableObject returns the object of t.
+func tableObject(t *Table) Object {
+	return Object{Kind: ObjectTable, Schema: objectSchema(t.SchemaName()), Name: t.Name}
+}
+
+// viewObject returns the object of v.
+func viewObject(v *View) Object {
+	return Object{Kind: ObjectView, Schema: objectSchema(v.SchemaName()), Name: v.Name}
+}
+
+// indexObject returns the object of i.
+func (c *Catalog) indexObject(i *Index) Object {
+	return Object{Kind: ObjectIndex, Schema: objectSchema(c.indexSchema(i)), Name: i.Name}
+}
+
+// triggerObject returns the object of t.
+func (c *Catalog) triggerObject(t *Trigger) Object {
+	return Object{Kind: ObjectTrigger, Schema: objectSchema(c.triggerSchema(t)), Name: t.Name}
+}
+
+// objectSchema returns the value of Object.Schema for an object of the given schema, that is empty for main.
+func objectSchema(schema string) string {
+	if schemaKey(schema, false) == mainSchema {
+		return ""
+	}
+	return schema
+}
+
+// relation returns the table or, if there is none, the view with the given name referred by a statement of an object of
+// the given schema. Like in SQLite, the statements of temp may refer to the tables and views of any schema, and the
+// others only to the ones of your schema. It returns an object of kind -1 if there is none.
+func (c *Catalog) relation(schema, name string) Object {
+	if schemaKey(schema, false) == tempSchema {
+		schema = ""
+	}
+	if t := c.TableIn(schema, name); t != nil {
+		return tableObject(t)
+	}
+	if v := c.ViewIn(schema, name); v != nil {
+		return viewObject(v)
+	}
+	return Object{Kind: -1, Name: name}
+}
+
+// keyOf returns the key of o in Graph.indexes.
+func keyOf(o Object) objectKey {
+	return objectKey{kind: o.Kind, schema: schemaKey(o.Schema, false), name: literal.FoldIdentifier(o.Name)}
+}
+
+// index returns the index of o in g.objects, or -1 if o is not in g.
+func (g *Graph) index(o Object) int {
+	if i, ok := g.indexes[keyOf(o)]; ok {
+		return i
+	}
+	return -1
+}
+
+// add adds the dependency of o on on, unless on is not in g, is o or is already a dependency of kind k.
+func (g *Graph) add(o, on Object, k DependencyKind) {
+	i, j := g.index(o), g.index(on)
+	if j < 0 || i == j {
+		return
+	}
+	d := Dependency{Object: g.objects[i], On: g.objects[j], Kind: k}
+	if slices.Contains(g.dependencies[i], d) {
+		return
+	}
+	g.dependencies[i] = append(g.dependencies[i], d)
+	g.dependents[j] = append(g.dependents[j], d)
+}
+
+// Objects returns the objects of g: the tables, the indexes, the views and the triggers, in the order of the catalog.
+func (g *Graph) Objects() []Object {
+	return slices.Clone(g.objects)
+}
+
+// Dependencies returns the dependencies of o, or nil if o is not in g.
+func (g *Graph) Dependencies(o Object) []Dependency {
+	if i := g.index(o); i >= 0 {
+		return slices.Clone(g.dependencies[i])
+	}
+	return nil
+}
+
+// Dependents returns the dependencies on o, or nil if o is not in g.
+func (g *Graph) Dependents(o Object) []Dependency {
+	if i := g.index(o); i >= 0 {
+		return slices.Clone(g.dependents[i])
+	}
+	return nil
+}
+
+// Sort returns the objects in an order that they can be created: each object comes after the objects that it depends
+// on. The objects that don't depend on each other keep the order of the catalog, so the tables come first, followed by
+// the indexes, the views and the triggers. A cycle of foreign keys is allowed, like in SQLite, but other cycles make
+// Sort return an error that wraps ErrCycle. Even then, all the objects are returned, the cycles broken at some
+// dependency.
+func (g *Graph) Sort() ([]Object, error) {
+	order := make([]Object, 0, len(g.objects))
+	var err error
+	// state is 0 for the objects not visited, 1 for the ones being visited and 2 for the ones in order.
+	state := make([]int, len(g.objects))
+	// path are the dependencies from the object whose visit started to the one being visited.
+	var path []Dependency
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = 1
+		for _, d := range g.dependencies[i] {
+			j := g.index(d.On)
+			switch state[j] {
+			case 0:
+				path = append(path, d)
+				visit(j)
+				path = path[:len(path)-1]
+			case 1:
+				cycle := append(cycleOf(path, d.On), d)
+				if err == nil && slices.ContainsFunc(cycle, func(d Dependency) bool { return d.Kind != DependencyForeignKey }) {
+					err = fmt.Errorf("%w: %s", ErrCycle, describeCycle(cycle))
+				}
+			}
+		}
+		state[i] = 2
+		order = append(order, g.objects[i])
+	}
+	for i := range g.objects {
+		if state[i] == 0 {
+			visit(i)
+		}
+	}
+	return order, err
+}
+
+// cycleOf returns the dependencies of path from the one of the object o.
+func cycleOf(path []Dependency, o Object) []Dependency {
+	for i, d := range path {
+		if d.Object == o {
+			return slices.Clone(path[i:])
+		}
+	}
+	return nil
+}
+
+// describeCycle returns a description of the cycle, like "View a -> View b -> View a".
+func describeCycle(cycle []Dependency) string {
+	var b strings.Builder
+	for _, d := range cycle {
+		b.WriteString(d.Object.String())
+		b.WriteString(" -> ")
+	}
+	b.WriteString(cycle[len(cycle)-1].On.String())
+	return b.String()
+}
+
+// DropOrder returns the objects in an order that they can be dropped, that is, the reverse of the order of Sort. The
+// error is the one of Sort.
+func (g *Graph) DropOrder() ([]Object, error) {
+	order, err := g.Sort()
+	slices.Reverse(order)
+	return order, err
+}
+
+// Cycles returns the groups of objects that depend on each other, directly or indirectly, including the ones of foreign
+// keys. The objects of a group, and the groups, are in the order of the catalog.
+func (g *Graph) Cycles() [][]Object {
+	// This is the algorithm of Tarjan for the strongly connected components.
+	n := len(g.objects)
+	index, low := make([]int, n), make([]int, n)
+	onStack := make([]bool, n)
+	var stack []int
+	var components [][]int
+	next := 1
+	var connect func(i int)
+	connect = func(i int) {
+		index[i], low[i] = next, next
+		next++
+		stack = append(stack, i)
+		onStack[i] = true
+		for _, d := range g.dependencies[i] {
+			j := g.index(d.On)
+			if index[j] == 0 {
+				connect(j)
+				low[i] = min(low[i], low[j])
+			} else if onStack[j] {
+				low[i] = min(low[i], index[j])
+			}
+		}
+		if low[i] != index[i] {
+			return
+		}
+		var component []int
+		for {
+			j := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[j] = false
+			component = append(component, j)
+			if j == i {
+				break
+			}
+		}
+		if len(component) > 1 {
+			slices.Sort(component)
+			components = append(components, component)
+		}
+	}
+	for i := range g.objects {
+		if index[i] == 0 {
+			connect(i)
+		}
+	}
+	slices.SortFunc(components, func(a, b []int) int { return a[0] - b[0] })
+
+	var cycles [][]Object
+	for _, component := range components {
+		var cycle []Object
+		for _, i := range component {
+			cycle = append(cycle, g.objects[i])
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles
+}
+
+// Impact returns the objects that depend on o, directly or indirectly, that is, the objects that break, or that are
+// dropped too, if o is dropped. Each object is given by the dependency through which it is reached from o, the nearest
+// objects first.
+func (g *Graph) Impact(o Object) []Dependency {
+	i := g.index(o)
+	if i < 0 {
+		return nil
+	}
+	var impact []Dependency
+	reached := make([]bool, len(g.objects))
+	reached[i] = true
+	for queue := []int{i}; len(queue) > 0; queue = queue[1:] {
+		for _, d := range g.dependents[queue[0]] {
+			j := g.index(d.Object)
+			if !reached[j] {
+				reached[j] = true
+				impact = append(impact, d)
+				queue = append(queue, j)
+			}
+		}
+	}
+	return impact
+}
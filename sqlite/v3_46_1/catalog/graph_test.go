@@ -0,0 +1,176 @@
+package catalog
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// objectStrings returns the string representations of the objects.
+func objectStrings(os []Object) []string {
+	var ss []string
+	for _, o := range os {
+		ss = append(ss, o.String())
+	}
+	return ss
+}
+
+// TestGraph tests the dependencies of the graph.
+func TestGraph(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE posts(id INTEGER PRIMARY KEY, author INT REFERENCES users, editor INT REFERENCES users(id), parent INT REFERENCES posts);
+		CREATE TABLE users(id INTEGER PRIMARY KEY, name TEXT, FOREIGN KEY (id) REFERENCES missing);
+		CREATE INDEX users_name ON users(name);
+		CREATE VIEW authors AS SELECT DISTINCT u.* FROM active u JOIN posts p ON p.author = u.id;
+		CREATE VIEW active AS WITH w AS (SELECT 1) SELECT * FROM users, w;
+		CREATE TRIGGER users_delete AFTER DELETE ON users BEGIN DELETE FROM posts WHERE author = old.id; END;
+		CREATE TABLE copy AS SELECT * FROM authors;
+	`)
+	g := c.Graph()
+	want := []string{"Table posts", "Table users", "Table copy", "Index users_name", "View authors", "View active", "Trigger users_delete"}
+	if got := objectStrings(g.Objects()); !slices.Equal(got, want) {
+		t.Errorf("got objects %q, want %q", got, want)
+	}
+
+	cases := []struct {
+		object Object
+		want   []Dependency
+	}{
+		{Object{Kind: ObjectTable, Name: "posts"}, []Dependency{{Object{Kind: ObjectTable, Name: "posts"}, Object{Kind: ObjectTable, Name: "users"}, DependencyForeignKey}}},
+		{Object{Kind: ObjectTable, Name: "users"}, nil},
+		{Object{Kind: ObjectTable, Name: "COPY"}, []Dependency{{Object{Kind: ObjectTable, Name: "copy"}, Object{Kind: ObjectView, Name: "authors"}, DependencyReference}}},
+		{Object{Kind: ObjectIndex, Name: "users_name"}, []Dependency{{Object{Kind: ObjectIndex, Name: "users_name"}, Object{Kind: ObjectTable, Name: "users"}, DependencyOwner}}},
+		{Object{Kind: ObjectView, Name: "authors"}, []Dependency{
+			{Object{Kind: ObjectView, Name: "authors"}, Object{Kind: ObjectView, Name: "active"}, DependencyReference},
+			{Object{Kind: ObjectView, Name: "authors"}, Object{Kind: ObjectTable, Name: "posts"}, DependencyReference},
+		}},
+		{Object{Kind: ObjectView, Name: "active"}, []Dependency{{Object{Kind: ObjectView, Name: "active"}, Object{Kind: ObjectTable, Name: "users"}, DependencyReference}}},
+		{Object{Kind: ObjectTrigger, Name: "users_delete"}, []Dependency{
+			{Object{Kind: ObjectTrigger, Name: "users_delete"}, Object{Kind: ObjectTable, Name: "users"}, DependencyOwner},
+			{Object{Kind: ObjectTrigger, Name: "users_delete"}, Object{Kind: ObjectTable, Name: "posts"}, DependencyReference},
+		}},
+		{Object{Kind: ObjectView, Name: "users"}, nil},
+	}
+	for _, cs := range cases {
+		if got := g.Dependencies(cs.object); !slices.Equal(got, cs.want) {
+			t.Errorf("dependencies of %s: got %v, want %v", cs.object, got, cs.want)
+		}
+	}
+	if got := g.Dependents(Object{Kind: ObjectTable, Name: "users"}); len(got) != 4 {
+		t.Errorf("got dependents %v, want 4", got)
+	}
+	if got := g.Dependents(Object{Kind: ObjectTrigger, Name: "none"}); got != nil {
+		t.Errorf("got dependents %v, want nil", got)
+	}
+
+	order, err := g.Sort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"Table users", "Table posts", "View active", "View authors", "Table copy", "Index users_name", "Trigger users_delete"}
+	if got := objectStrings(order); !slices.Equal(got, want) {
+		t.Errorf("got order %q, want %q", got, want)
+	}
+	order, _ = g.DropOrder()
+	slices.Reverse(want)
+	if got := objectStrings(order); !slices.Equal(got, want) {
+		t.Errorf("got drop order %q, want %q", got, want)
+	}
+
+	var impact []string
+	for _, d := range g.Impact(Object{Kind: ObjectView, Name: "active"}) {
+		impact = append(impact, d.Object.String()+" "+d.Kind.String())
+	}
+	if want := []string{"View authors Reference", "Table copy Reference"}; !slices.Equal(impact, want) {
+		t.Errorf("got impact %q, want %q", impact, want)
+	}
+	if got := g.Impact(Object{Kind: ObjectTable, Name: "users"}); len(got) != 6 {
+		t.Errorf("got impact %v, want 6 objects", got)
+	}
+	if got := g.Impact(Object{Kind: ObjectTable, Name: "none"}); got != nil {
+		t.Errorf("got impact %v, want nil", got)
+	}
+	if got := g.Cycles(); got != nil {
+		t.Errorf("got cycles %v", got)
+	}
+}
+
+// TestGraphCycles tests the cycles of foreign keys, that are allowed, and of views, that are not.
+func TestGraphCycles(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE a(x REFERENCES b);
+		CREATE TABLE b(y REFERENCES a);
+		CREATE TABLE c(z);
+	`)
+	g := c.Graph()
+	order, err := g.Sort()
+	if err != nil {
+		t.Errorf("got %v for a cycle of foreign keys", err)
+	}
+	if got, want := objectStrings(order), []string{"Table b", "Table a", "Table c"}; !slices.Equal(got, want) {
+		t.Errorf("got order %q, want %q", got, want)
+	}
+	if got := g.Cycles(); len(got) != 1 || !slices.Equal(objectStrings(got[0]), []string{"Table a", "Table b"}) {
+		t.Errorf("got cycles %v", got)
+	}
+
+	c = load(t, `
+		CREATE VIEW v1 AS SELECT * FROM v2;
+		CREATE VIEW v2 AS SELECT * FROM v3;
+		CREATE VIEW v3 AS SELECT * FROM v1;
+		CREATE VIEW v4 AS SELECT * FROM v4;
+	`)
+	order, err = c.Graph().Sort()
+	if !errors.Is(err, ErrCycle) || err.Error() != "catalog: dependency cycle: View v1 -> View v2 -> View v3 -> View v1" {
+		t.Errorf("got %v, want ErrCycle", err)
+	}
+	if len(order) != 4 {
+		t.Errorf("got order %v, want all the objects", order)
+	}
+	if got := c.Graph().Cycles(); len(got) != 1 || len(got[0]) != 3 {
+		t.Errorf("got cycles %v", got)
+	}
+	if got := ObjectKind(9).String(); got != "9" {
+		t.Errorf("got %q", got)
+	}
+	if got := DependencyKind(-1).String(); got != "-1" {
+		t.Errorf("got %q", got)
+	}
+}
+
+// TestGraphSchemas tests the graph of objects with the same name in different schemas.
+func TestGraphSchemas(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.owners(user INT REFERENCES users);
+		CREATE INDEX aux.users_id ON users(id);
+		CREATE TEMP VIEW v AS SELECT * FROM owners;
+		CREATE VIEW aux.v AS SELECT * FROM users;
+	`)
+	g := c.Graph()
+	want := []string{"Table users", "Table aux.users", "Table aux.owners", "Index aux.users_id", "View temp.v", "View aux.v"}
+	if got := objectStrings(g.Objects()); !slices.Equal(got, want) {
+		t.Errorf("got objects %q, want %q", got, want)
+	}
+
+	users := Object{Kind: ObjectTable, Schema: "aux", Name: "users"}
+	cases := []struct {
+		object Object
+		want   []Dependency
+	}{
+		{Object{Kind: ObjectTable, Schema: "AUX", Name: "owners"}, []Dependency{{Object{Kind: ObjectTable, Schema: "aux", Name: "owners"}, users, DependencyForeignKey}}},
+		{Object{Kind: ObjectIndex, Schema: "aux", Name: "users_id"}, []Dependency{{Object{Kind: ObjectIndex, Schema: "aux", Name: "users_id"}, users, DependencyOwner}}},
+		{Object{Kind: ObjectView, Schema: "temp", Name: "v"}, []Dependency{{Object{Kind: ObjectView, Schema: "temp", Name: "v"}, Object{Kind: ObjectTable, Schema: "aux", Name: "owners"}, DependencyReference}}},
+		{Object{Kind: ObjectView, Schema: "aux", Name: "v"}, []Dependency{{Object{Kind: ObjectView, Schema: "aux", Name: "v"}, users, DependencyReference}}},
+		{Object{Kind: ObjectTable, Schema: "main", Name: "users"}, nil},
+	}
+	for _, cs := range cases {
+		if got := g.Dependencies(cs.object); !slices.Equal(got, cs.want) {
+			t.Errorf("dependencies of %s: got %v, want %v", cs.object, got, cs.want)
+		}
+	}
+	if got := g.Dependents(Object{Kind: ObjectTable, Name: "users"}); got != nil {
+		t.Errorf("got dependents of main.users %v, want none", got)
+	}
+}
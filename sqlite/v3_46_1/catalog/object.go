@@ -0,0 +1,320 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Index is an index of the catalog.
+type Index struct {
+	// Schema is the schema given in the CREATE INDEX statement, if any.
+	Schema string
+	// Name is the name of the index.
+	Name string
+	// Table is the name of the table of the index.
+	Table string
+	// Unique reports whether the index was created with CREATE UNIQUE INDEX.
+	Unique bool
+	// Columns are the indexed columns.
+	Columns []IndexedColumn
+	// Where is the expression of the WHERE clause of a partial index.
+	Where string
+	// Comment is the text of the comments before the CREATE INDEX statement, without the comment delimiters.
+	Comment string
+}
+
+// CommentedSQL is like SQL, but with the comment of i as SQL comments before the statement.
+func (i *Index) CommentedSQL() string {
+	return commented(i.Comment, "", i.SQL())
+}
+
+// SQL returns the CREATE INDEX statement of i, without the semicolon.
+func (i *Index) SQL() string {
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if i.Unique {
+		b.WriteString("UNIQUE ")
+	}
+	b.WriteString("INDEX ")
+	b.WriteString(qualify(i.Schema, i.Name))
+	b.WriteString(" ON ")
+	b.WriteString(quote(i.Table))
+	b.WriteString(indexedColumns(i.Columns))
+	if i.Where != "" {
+		b.WriteString(" WHERE ")
+		b.WriteString(i.Where)
+	}
+	return b.String()
+}
+
+// newIndex creates an index from a tree of kind parsetree.KindCreateIndex.
+func newIndex(tree parsetree.NonTerminal) *Index {
+	i := &Index{}
+	where := false
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindToken:
+			switch c.(parsetree.Terminal).Token().Kind {
+			case token.KindUnique:
+				i.Unique = true
+			case token.KindWhere:
+				where = true
+			}
+		case parsetree.KindSchemaName:
+			i.Schema = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindIndexName:
+			i.Name = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindTableName:
+			i.Table = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindCommaList:
+			for _, item := range children(c.(parsetree.NonTerminal)) {
+				if item.Kind() == parsetree.KindIndexedColumn {
+					i.Columns = append(i.Columns, newIndexedColumn(item.(parsetree.NonTerminal)))
+				}
+			}
+		case parsetree.KindExpression:
+			if where {
+				i.Where = Text(c)
+			}
+		}
+	}
+	return i
+}
+
+// View is a view of the catalog.
+type View struct {
+	// Schema is the schema given in the CREATE VIEW statement, if any.
+	Schema string
+	// Name is the name of the view.
+	Name string
+	// Temporary reports whether the view was created with CREATE TEMP VIEW.
+	Temporary bool
+	// Columns are the names of the columns given in the CREATE VIEW statement, if any.
+	Columns []string
+	// Select is the select of the view.
+	Select string
+	// Comment is the text of the comments before the CREATE VIEW statement, without the comment delimiters.
+	Comment string
+}
+
+// CommentedSQL is like SQL, but with the comment of v as SQL comments before the statement.
+func (v *View) CommentedSQL() string {
+	return commented(v.Comment, "", v.SQL())
+}
+
+// SQL returns the CREATE VIEW statement of v, without the semicolon.
+func (v *View) SQL() string {
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if v.Temporary {
+		b.WriteString("TEMP ")
+	}
+	b.WriteString("VIEW ")
+	b.WriteString(qualify(v.Schema, v.Name))
+	if len(v.Columns) > 0 {
+		b.WriteString(names(v.Columns))
+	}
+	b.WriteString(" AS ")
+	b.WriteString(v.Select)
+	return b.String()
+}
+
+// References returns the names of the tables and views that v reads, in the order that they appear, without
+// repetitions.
+func (v *View) References() []string {
+	return references(v.Select)
+}
+
+// newView creates a view from a tree of kind parsetree.KindCreateView.
+func newView(tree parsetree.NonTerminal) *View {
+	v := &View{}
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindToken:
+			switch c.(parsetree.Terminal).Token().Kind {
+			case token.KindTemp, token.KindTemporary:
+				v.Temporary = true
+			}
+		case parsetree.KindSchemaName:
+			v.Schema = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindViewName:
+			v.Name = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindCommaList:
+			for _, item := range children(c.(parsetree.NonTerminal)) {
+				if item.Kind() == parsetree.KindColumnName {
+					v.Columns = append(v.Columns, syntax.Name(item.(parsetree.Terminal)))
+				}
+			}
+		case parsetree.KindSimpleSelect, parsetree.KindCompoundSelect:
+			v.Select = Text(c)
+		}
+	}
+	return v
+}
+
+// Trigger is a trigger of the catalog.
+type Trigger struct {
+	// Schema is the schema given in the CREATE TRIGGER statement, if any.
+	Schema string
+	// Name is the name of the trigger.
+	Name string
+	// Temporary reports whether the trigger was created with CREATE TEMP TRIGGER.
+	Temporary bool
+	// Time is BEFORE, AFTER or INSTEAD OF, if given.
+	Time string
+	// Event is DELETE, INSERT or UPDATE.
+	Event string
+	// Columns are the columns of an UPDATE OF event.
+	Columns []string
+	// Table is the name of the table or view of the trigger.
+	Table string
+	// ForEachRow reports whether FOR EACH ROW was given.
+	ForEachRow bool
+	// When is the expression of the WHEN clause, if any.
+	When string
+	// Body are the statements of the trigger, without the semicolons.
+	Body []string
+	// Comment is the text of the comments before the CREATE TRIGGER statement, without the comment delimiters.
+	Comment string
+}
+
+// CommentedSQL is like SQL, but with the comment of t as SQL comments before the statement.
+func (t *Trigger) CommentedSQL() string {
+	return commented(t.Comment, "", t.SQL())
+}
+
+// SQL returns the CREATE TRIGGER statement of t, without the semicolon. Each statement of the body is on its own line.
+func (t *Trigger) SQL() string {
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if t.Temporary {
+		b.WriteString("TEMP ")
+	}
+	b.WriteString("TRIGGER ")
+	b.WriteString(qualify(t.Schema, t.Name))
+	if t.Time != "" {
+		b.WriteString(" " + t.Time)
+	}
+	b.WriteString(" " + t.Event)
+	if len(t.Columns) > 0 {
+		b.WriteString(" OF ")
+		list := names(t.Columns)
+		b.WriteString(list[1 : len(list)-1])
+	}
+	b.WriteString(" ON ")
+	b.WriteString(quote(t.Table))
+	if t.ForEachRow {
+		b.WriteString(" FOR EACH ROW")
+	}
+	if t.When != "" {
+		b.WriteString(" WHEN ")
+		b.WriteString(t.When)
+	}
+	b.WriteString(" BEGIN")
+	for _, stmt := range t.Body {
+		b.WriteString("\n  ")
+		b.WriteString(stmt)
+		b.WriteString(";")
+	}
+	b.WriteString("\nEND")
+	return b.String()
+}
+
+// References returns the names of the tables and views that the body of t uses, in the order that they appear, without
+// repetitions. The table of t is not included unless the body uses it.
+func (t *Trigger) References() []string {
+	var refs []string
+	for _, stmt := range t.Body {
+		for _, ref := range references(stmt) {
+			refs = appendName(refs, ref)
+		}
+	}
+	return refs
+}
+
+// newTrigger creates a trigger from a tree of kind parsetree.KindCreateTrigger.
+func newTrigger(tree parsetree.NonTerminal) *Trigger {
+	t := &Trigger{}
+	when := false
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindToken:
+			switch tok := c.(parsetree.Terminal).Token(); tok.Kind {
+			case token.KindTemp, token.KindTemporary:
+				t.Temporary = true
+			case token.KindBefore, token.KindAfter:
+				t.Time = Text(c)
+			case token.KindInstead:
+				t.Time = "INSTEAD OF"
+			case token.KindDelete, token.KindInsert, token.KindUpdate:
+				t.Event = Text(c)
+			case token.KindRow:
+				t.ForEachRow = true
+			case token.KindWhen:
+				when = true
+			}
+		case parsetree.KindSchemaName:
+			t.Schema = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindTriggerName:
+			t.Name = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindTableName:
+			t.Table = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindCommaList:
+			for _, item := range children(c.(parsetree.NonTerminal)) {
+				if item.Kind() == parsetree.KindColumnName {
+					t.Columns = append(t.Columns, syntax.Name(item.(parsetree.Terminal)))
+				}
+			}
+		case parsetree.KindExpression:
+			if when {
+				t.When = Text(c)
+			}
+		case parsetree.KindTriggerBody:
+			for _, stmt := range children(c.(parsetree.NonTerminal)) {
+				if stmt.Kind() != parsetree.KindToken {
+					t.Body = append(t.Body, Text(stmt))
+				}
+			}
+		}
+	}
+	return t
+}
+
+// references returns the names of the tables and views that the statement in code reads or writes, in the order that
+// they appear, without repetitions. The names of the common table expressions are not included.
+func references(code string) []string {
+	stmt, err := syntax.Parse(parser.New(lexer.New([]byte(code))))
+	if err != nil {
+		return nil
+	}
+	var ctes, refs []string
+	walk(stmt, nil, func(c parsetree.Construction, parent parsetree.NonTerminal) {
+		if c.Kind() != parsetree.KindTableName {
+			return
+		}
+		n := syntax.Name(c.(parsetree.Terminal))
+		switch parent.Kind() {
+		case parsetree.KindCommonTableExpression:
+			ctes = appendName(ctes, n)
+		case parsetree.KindTableOrSubquery, parsetree.KindQualifiedTableName, parsetree.KindInsert:
+			refs = appendName(refs, n)
+		}
+	})
+	return withoutNames(refs, ctes)
+}
+
+// walk calls f for c and its descendants, in order. parent is the parent of c.
+func walk(c parsetree.Construction, parent parsetree.NonTerminal, f func(c parsetree.Construction, parent parsetree.NonTerminal)) {
+	f(c, parent)
+	if nt, ok := c.(parsetree.NonTerminal); ok {
+		nt.Children(func(child parsetree.Construction) bool {
+			walk(child, nt, f)
+			return true
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package catalog
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestObjectSQL tests that the indexes, views and triggers are rendered in a canonical form.
+func TestObjectSQL(t *testing.T) {
+	c := load(t, `
+		create table s.t(a, b);
+		create unique index if not exists s.i on t(a collate nocase asc, b+1) where a>0;
+		create temp view v(x, y) as select a, b from t union all select 1, 2;
+		create trigger tr instead of update of x, y on v for each row when new.x>0 begin
+			update t set a = new.x;
+			delete from t where b = old.y;
+		end;
+	`)
+	checks := []struct{ got, want string }{
+		{got: c.Index("i").SQL(), want: "CREATE UNIQUE INDEX s.i ON t(a COLLATE nocase ASC, b + 1) WHERE a > 0"},
+		{got: c.View("v").SQL(), want: "CREATE TEMP VIEW v(x, y) AS SELECT a, b FROM t UNION ALL SELECT 1, 2"},
+		{
+			got: c.Trigger("tr").SQL(),
+			want: "CREATE TRIGGER tr INSTEAD OF UPDATE OF x, y ON v FOR EACH ROW WHEN new.x > 0 BEGIN\n" +
+				"  UPDATE t SET a = new.x;\n  DELETE FROM t WHERE b = old.y;\nEND",
+		},
+	}
+	for _, ch := range checks {
+		if ch.got != ch.want {
+			t.Errorf("got\n%s\nwant\n%s", ch.got, ch.want)
+		}
+	}
+}
+
+// TestReferences tests the references of the views and triggers.
+func TestReferences(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE a(x);
+		CREATE TABLE b(y);
+		CREATE VIEW v AS WITH c AS (SELECT x FROM a) SELECT * FROM c JOIN b JOIN a;
+		CREATE TRIGGER tr AFTER INSERT ON a BEGIN INSERT INTO b VALUES (new.x); DELETE FROM v2; UPDATE b SET y = 1; END;
+	`)
+	if got, want := c.View("v").References(), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("got view references %v, want %v", got, want)
+	}
+	if got, want := c.Trigger("tr").References(), []string{"b", "v2"}; !slices.Equal(got, want) {
+		t.Errorf("got trigger references %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Text returns the SQL code of c in a canonical form: the tokens are separated by single spaces, except where no space
+// is usual, like before a comma, and the keywords are in upper case. The comments are not included.
+func Text(c parsetree.Construction) string {
+	r := renderer{}
+	r.walk(c)
+	return r.b.String()
+}
+
+// renamer returns the new name of the terminal t, if it must be renamed. The ancestors of t are in ancestors, the
+// parent being the last.
+type renamer func(ancestors []parsetree.NonTerminal, t parsetree.Terminal) (name string, ok bool)
+
+// renderer renders a parse tree as text.
+type renderer struct {
+	b strings.Builder
+	// prev is the text of the previous token and prevKind is its kind.
+	prev     string
+	prevKind token.Kind
+	// tight reports whether there must be no space before the next token.
+	tight  bool
+	rename renamer
+	// ancestors are the ancestors of the construction being rendered.
+	ancestors []parsetree.NonTerminal
+}
+
+// walk renders c.
+func (r *renderer) walk(c parsetree.Construction) {
+	switch c := c.(type) {
+	case parsetree.Terminal:
+		r.terminal(c)
+	case parsetree.NonTerminal:
+		unary := c.Kind() == parsetree.KindNegate || c.Kind() == parsetree.KindPrefixPlus || c.Kind() == parsetree.KindBitNot
+		r.ancestors = append(r.ancestors, c)
+		c.Children(func(child parsetree.Construction) bool {
+			r.walk(child)
+			if unary {
+				// the operator is the first child.
+				r.tight, unary = true, false
+			}
+			return true
+		})
+		r.ancestors = r.ancestors[:len(r.ancestors)-1]
+	}
+}
+
+// terminal renders t.
+func (r *renderer) terminal(t parsetree.Terminal) {
+	tok := t.Token()
+	if tok.Kind == token.KindEOF {
+		return
+	}
+	text := string(tok.Lexeme)
+	if name, ok := r.renamed(t); ok {
+		text = literal.FormatIdentifier(name, literal.QuoteDouble)
+	} else if t.Kind() == parsetree.KindToken && tok.Kind.IsKeyword() && t.Fallback() == parsetree.FallbackNone {
+		text = strings.ToUpper(text)
+	}
+
+	if r.b.Len() > 0 && r.spaceBefore(text) {
+		r.b.WriteByte(' ')
+	}
+	r.b.WriteString(text)
+
+	// the sign of a default value, like in "DEFAULT -1".
+	parent := r.parent()
+	unarySign := parent != nil && parent.Kind() == parsetree.KindDefaultColumnConstraint && (text == "-" || text == "+")
+	r.tight = text == "(" || text == "." || unarySign
+	r.prev, r.prevKind = text, tok.Kind
+}
+
+// parent returns the parent of the construction being rendered, or nil if it has none.
+func (r *renderer) parent() parsetree.NonTerminal {
+	if len(r.ancestors) == 0 {
+		return nil
+	}
+	return r.ancestors[len(r.ancestors)-1]
+}
+
+// renamed returns the new name of t, if it must be renamed.
+func (r *renderer) renamed(t parsetree.Terminal) (string, bool) {
+	if r.rename == nil || t.Kind() == parsetree.KindToken {
+		return "", false
+	}
+	return r.rename(r.ancestors, t)
+}
+
+// spaceBefore reports whether there must be a space before a token with the given text.
+func (r *renderer) spaceBefore(text string) bool {
+	if r.tight {
+		// "- -1" must not become a comment.
+		return strings.HasSuffix(r.prev, "-") && strings.HasPrefix(text, "-")
+	}
+	switch text {
+	case ",", ")", ".", ";":
+		return false
+	case "(":
+		upper := strings.ToUpper(r.prev)
+		return r.prevKind != token.KindIdentifier && !(r.prevKind.IsKeyword() && (upper == "CAST" || upper == "RAISE"))
+	}
+	return true
+}
+
+// textRenamed returns the text of c like Text, but with the names of the terminals for which rename returns ok
+// replaced.
+func textRenamed(c parsetree.Construction, rename renamer) string {
+	r := renderer{rename: rename}
+	r.walk(c)
+	return r.b.String()
+}
+
+// quote returns s as an identifier, quoted if needed.
+func quote(s string) string {
+	return literal.FormatIdentifier(s, literal.QuoteDouble)
+}
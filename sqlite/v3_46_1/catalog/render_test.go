@@ -0,0 +1,31 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+)
+
+// TestText tests the canonical form of the statements.
+func TestText(t *testing.T) {
+	cases := []struct {
+		code, want string
+	}{
+		{code: "select  a,b from   t", want: "SELECT a, b FROM t"},
+		{code: "SELECT /* comment */ f ( a ) , count(*) FROM t -- comment", want: "SELECT f(a), count(*) FROM t"},
+		{code: "SELECT - a, + b, ~ c, - -1, t . x", want: "SELECT -a, +b, ~c, - -1, t.x"},
+		{code: "SELECT cast ( a as int ) IN (1, 2)", want: "SELECT CAST(a AS int) IN (1, 2)"},
+		{code: `SELECT "key", [value] FROM "t"`, want: `SELECT "key", [value] FROM "t"`},
+	}
+	for _, c := range cases {
+		stmt, err := syntax.Parse(parser.New(lexer.New([]byte(c.code))))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.code, err)
+		}
+		if got := Text(stmt); got != c.want {
+			t.Errorf("Text(%q) = %q, want %q", c.code, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package catalog
+
+import (
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+)
+
+// The keys of the schemas main and temp. The key of the other schemas is your name folded with
+// literal.FoldIdentifier.
+const (
+	mainSchema = "main"
+	tempSchema = "temp"
+)
+
+// schemaKey returns the key of the schema with the given name, that is main for the empty name and temp for the
+// temporary objects.
+func schemaKey(schema string, temporary bool) string {
+	switch {
+	case temporary || literal.EqualIdentifiers(schema, tempSchema):
+		return tempSchema
+	case schema == "" || literal.EqualIdentifiers(schema, mainSchema):
+		return mainSchema
+	}
+	return literal.FoldIdentifier(schema)
+}
+
+// SchemaName returns the schema of t: Schema if it was given, temp for a temporary table or main.
+func (t *Table) SchemaName() string {
+	return schemaName(t.Schema, t.Temporary)
+}
+
+// SchemaName returns the schema of v: Schema if it was given, temp for a temporary view or main.
+func (v *View) SchemaName() string {
+	return schemaName(v.Schema, v.Temporary)
+}
+
+// schemaName returns the name of the schema of an object created with the given schema name.
+func schemaName(schema string, temporary bool) string {
+	switch {
+	case temporary:
+		return tempSchema
+	case schema == "":
+		return mainSchema
+	}
+	return schema
+}
+
+// TableIn returns the table with the given name in the schema, or nil if there is none. If schema is empty, the table
+// is looked up like SQLite looks up an unqualified name: in temp, in main and then in the other schemas.
+func (c *Catalog) TableIn(schema, name string) *Table {
+	return lookup(c.Tables, schema, name, func(t *Table) (string, string) {
+		return schemaKey(t.Schema, t.Temporary), t.Name
+	})
+}
+
+// IndexIn returns the index with the given name in the schema, or nil if there is none. An empty schema is like in
+// TableIn.
+func (c *Catalog) IndexIn(schema, name string) *Index {
+	return lookup(c.Indexes, schema, name, func(i *Index) (string, string) {
+		return schemaKey(c.indexSchema(i), false), i.Name
+	})
+}
+
+// ViewIn returns the view with the given name in the schema, or nil if there is none. An empty schema is like in
+// TableIn.
+func (c *Catalog) ViewIn(schema, name string) *View {
+	return lookup(c.Views, schema, name, func(v *View) (string, string) {
+		return schemaKey(v.Schema, v.Temporary), v.Name
+	})
+}
+
+// TriggerIn returns the trigger with the given name in the schema, or nil if there is none. An empty schema is like in
+// TableIn.
+func (c *Catalog) TriggerIn(schema, name string) *Trigger {
+	return lookup(c.Triggers, schema, name, func(t *Trigger) (string, string) {
+		return schemaKey(c.triggerSchema(t), false), t.Name
+	})
+}
+
+// Parent returns the parent table of the foreign key fk of the table t, or nil if there is none. Like SQLite, the
+// parent table is looked up in the schema of t.
+func (c *Catalog) Parent(t *Table, fk *ForeignKey) *Table {
+	return c.TableIn(t.SchemaName(), fk.Table)
+}
+
+// indexSchema returns the schema of i, that is the schema given in CREATE INDEX or the one of your table.
+func (c *Catalog) indexSchema(i *Index) string {
+	if i.Schema != "" {
+		return i.Schema
+	}
+	if t := c.tableOf(i); t != nil {
+		return t.SchemaName()
+	}
+	return mainSchema
+}
+
+// triggerSchema returns the schema of t, that is temp for a temporary trigger, the schema given in CREATE TRIGGER or the
+// one of your table or view.
+func (c *Catalog) triggerSchema(t *Trigger) string {
+	switch {
+	case t.Temporary:
+		return tempSchema
+	case t.Schema != "":
+		return t.Schema
+	}
+	if tbl, v := c.targetOf(t); tbl != nil {
+		return tbl.SchemaName()
+	} else if v != nil {
+		return v.SchemaName()
+	}
+	return mainSchema
+}
+
+// tableOf returns the table of i, or nil if there is none. Like in SQLite, the schema of i qualifies the table.
+func (c *Catalog) tableOf(i *Index) *Table {
+	return c.TableIn(i.Schema, i.Table)
+}
+
+// targetOf returns the table or the view of t. Both are nil if there is none. Like in SQLite, the schema of t qualifies
+// the table or view.
+func (c *Catalog) targetOf(t *Trigger) (*Table, *View) {
+	schema := t.Schema
+	if t.Temporary {
+		// the temporary triggers may be on the tables and views of any schema.
+		schema = ""
+	}
+	if tbl := c.TableIn(schema, t.Table); tbl != nil {
+		return tbl, nil
+	}
+	return nil, c.ViewIn(schema, t.Table)
+}
+
+// lookup returns the element of s with the given name in the schema, or nil if there is none. keyOf returns the key of
+// the schema and the name of an element. If schema is empty, the element is looked up in temp, in main and then in the
+// other schemas, in the order of s.
+func lookup[T any](s []*T, schema, name string, keyOf func(*T) (schema, name string)) *T {
+	var found *T
+	// rank is the position of the schema of found in the order of the lookup.
+	rank := 3
+	for _, e := range s {
+		es, en := keyOf(e)
+		if !literal.EqualIdentifiers(en, name) {
+			continue
+		}
+		if schema != "" {
+			if es == schemaKey(schema, false) {
+				return e
+			}
+			continue
+		}
+		r := 2
+		switch es {
+		case tempSchema:
+			r = 0
+		case mainSchema:
+			r = 1
+		}
+		if r < rank {
+			found, rank = e, r
+		}
+	}
+	return found
+}
+
+// dotted returns the name qualified by the schema, if any, like "aux.users". Unlike qualify, the names are not quoted.
+func dotted(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
+}
@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// TestSchemas tests that the objects are identified by schema and name.
+func TestSchemas(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.users(key TEXT PRIMARY KEY, name TEXT);
+		CREATE TABLE aux.owners(user TEXT REFERENCES users);
+		CREATE TABLE owners(user INT REFERENCES users);
+		CREATE INDEX aux.users_name ON users(name);
+		CREATE INDEX users_name ON users(id);
+		CREATE TRIGGER aux.users_insert AFTER INSERT ON users BEGIN SELECT 1; END;
+		CREATE TRIGGER users_insert AFTER INSERT ON users BEGIN SELECT 1; END;
+	`)
+	main, aux := c.TableIn("main", "users"), c.TableIn("AUX", "USERS")
+	if main == nil || aux == nil || main == aux || aux.Schema != "aux" {
+		t.Fatalf("got main.users %v and aux.users %v", main, aux)
+	}
+	if got := c.Table("users"); got != main {
+		t.Errorf("Table(users) = %v, want main.users", got)
+	}
+	if got := c.TableIn("temp", "users"); got != nil {
+		t.Errorf("TableIn(temp, users) = %v, want nil", got)
+	}
+	if got := aux.SchemaName(); got != "aux" {
+		t.Errorf("got schema %q, want aux", got)
+	}
+	if got := main.SchemaName(); got != "main" {
+		t.Errorf("got schema %q, want main", got)
+	}
+
+	for schema, want := range map[string]*Table{"main": main, "aux": aux} {
+		owners := c.TableIn(schema, "owners")
+		if got := c.Parent(owners, owners.Columns[0].Constraints[0].ForeignKey); got != want {
+			t.Errorf("parent of %s.owners: got %v, want %v", schema, got, want)
+		}
+	}
+	if is := c.IndexesOf("aux", "users"); len(is) != 1 || is[0].Name != "users_name" || is[0].Columns[0].Name != "name" {
+		t.Errorf("got indexes of aux.users %v", is)
+	}
+	if is := c.IndexesOf("", "users"); len(is) != 1 || is[0].Columns[0].Name != "id" {
+		t.Errorf("got indexes of users %v", is)
+	}
+	if ts := c.TriggersOf("aux", "users"); len(ts) != 1 || ts[0].Schema != "aux" {
+		t.Errorf("got triggers of aux.users %v", ts)
+	}
+	if keys := c.UniqueKeys("aux", "users"); len(keys) != 1 || !slices.Equal(keys[0], []string{"key"}) {
+		t.Errorf("got unique keys of aux.users %v", keys)
+	}
+
+	if err := c.Exec([]byte("DROP TABLE aux.users")); err != nil {
+		t.Fatal(err)
+	}
+	if c.TableIn("aux", "users") != nil || c.Table("users") != main || len(c.Indexes) != 1 || len(c.Triggers) != 1 {
+		t.Errorf("DROP TABLE aux.users dropped the wrong objects: %v, %v, %v", c.Tables, c.Indexes, c.Triggers)
+	}
+	if err := c.Exec([]byte("DROP INDEX aux.users_name")); !errors.Is(err, ErrNoSuchIndex) {
+		t.Errorf("got error %v, want %v", err, ErrNoSuchIndex)
+	}
+}
+
+// TestSchemasSearchOrder tests that the unqualified names are looked up in temp, in main and then in the other
+// schemas.
+func TestSchemasSearchOrder(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE aux.t(a);
+		CREATE TABLE t(b);
+		CREATE TEMP TABLE t(c);
+		CREATE TABLE aux.only(d);
+	`)
+	if got := c.Table("t"); got == nil || !got.Temporary {
+		t.Errorf("Table(t) = %v, want temp.t", got)
+	}
+	if got := c.Table("only"); got == nil || got.Schema != "aux" {
+		t.Errorf("Table(only) = %v, want aux.only", got)
+	}
+	if err := c.Exec([]byte("DROP TABLE t")); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Table("t"); got == nil || got.Schema != "" || got.Columns[0].Name != "b" {
+		t.Errorf("Table(t) = %v, want main.t", got)
+	}
+}
+
+// TestSchemasExists tests that the names are unique in a schema only.
+func TestSchemasExists(t *testing.T) {
+	cases := []struct {
+		code string
+		want error
+	}{
+		{"CREATE TABLE t(a); CREATE TABLE aux.t(a); CREATE TEMP TABLE t(a);", nil},
+		{"CREATE TABLE t(a); CREATE VIEW main.t AS SELECT 1;", ErrExists},
+		{"CREATE TABLE aux.t(a); CREATE INDEX aux.t ON t(a);", ErrExists},
+		{"CREATE TABLE aux.t(a); CREATE TABLE t(a); CREATE INDEX aux.i ON t(a); CREATE INDEX i ON t(a);", nil},
+		{"CREATE TABLE t(a); CREATE INDEX aux.i ON t(a);", ErrNoSuchTable},
+	}
+	for _, cs := range cases {
+		if _, err := Load([]byte(cs.code)); !errors.Is(err, cs.want) {
+			t.Errorf("Load(%q): got error %v, want %v", cs.code, err, cs.want)
+		}
+	}
+}
+
+// TestSchemasRename tests that ALTER TABLE renames the references to the table of the schema only.
+func TestSchemasRename(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.owners(user INT REFERENCES users(id));
+		CREATE TABLE owners(user INT REFERENCES users(id));
+		CREATE INDEX aux.users_id ON users(id);
+		CREATE VIEW aux.v AS SELECT id FROM users;
+		CREATE VIEW v AS SELECT id FROM users;
+		ALTER TABLE aux.users RENAME TO people;
+		ALTER TABLE aux.people RENAME COLUMN id TO code;
+	`)
+	if c.TableIn("aux", "people") == nil || c.TableIn("main", "users") == nil {
+		t.Fatalf("got tables %v", c.Tables)
+	}
+	checks := []struct{ got, want string }{
+		{c.TableIn("aux", "owners").Columns[0].Constraints[0].ForeignKey.Table, "people"},
+		{c.TableIn("aux", "owners").Columns[0].Constraints[0].ForeignKey.ReferencedColumns[0], "code"},
+		{c.TableIn("main", "owners").Columns[0].Constraints[0].ForeignKey.Table, "users"},
+		{c.TableIn("main", "owners").Columns[0].Constraints[0].ForeignKey.ReferencedColumns[0], "id"},
+		{c.IndexIn("aux", "users_id").Table, "people"},
+		{c.ViewIn("aux", "v").Select, "SELECT code FROM people"},
+		{c.ViewIn("main", "v").Select, "SELECT id FROM users"},
+	}
+	for _, ch := range checks {
+		if ch.got != ch.want {
+			t.Errorf("got %q, want %q", ch.got, ch.want)
+		}
+	}
+}
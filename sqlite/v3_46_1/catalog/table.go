@@ -0,0 +1,702 @@
+package catalog
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Table is a table of the catalog.
+type Table struct {
+	// Schema is the schema given in the CREATE TABLE statement, if any.
+	Schema string
+	// Name is the name of the table.
+	Name string
+	// Temporary reports whether the table was created with CREATE TEMP TABLE.
+	Temporary bool
+	// Columns are the columns of the table. A table created with CREATE TABLE ... AS SELECT has no columns.
+	Columns []*Column
+	// Constraints are the table constraints.
+	Constraints []*Constraint
+	// WithoutRowid and Strict report whether the table has the options WITHOUT ROWID and STRICT.
+	WithoutRowid, Strict bool
+	// Select is the select of a table created with CREATE TABLE ... AS SELECT.
+	Select string
+	// Module is the module of a virtual table and Arguments are the arguments of the module.
+	Module    string
+	Arguments []string
+	// Comment is the text of the comments before the CREATE TABLE statement, without the comment delimiters.
+	Comment string
+}
+
+// Virtual reports whether t is a virtual table.
+func (t *Table) Virtual() bool {
+	return t.Module != ""
+}
+
+// Column returns the column of t with the given name, or nil if there is none.
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if literal.EqualIdentifiers(c.Name, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// PrimaryKey returns the names of the columns of the primary key of t, that is nil if t has no primary key.
+func (t *Table) PrimaryKey() []string {
+	for _, c := range t.Columns {
+		if c.Constraint(ConstraintPrimaryKey) != nil {
+			return []string{c.Name}
+		}
+	}
+	for _, c := range t.Constraints {
+		if c.Kind == ConstraintPrimaryKey {
+			return c.ColumnNames()
+		}
+	}
+	return nil
+}
+
+// ForeignKeys returns the foreign keys of t, of the column constraints and of the table constraints. The foreign keys
+// of the column constraints are copies whose Columns is the column.
+func (t *Table) ForeignKeys() []*ForeignKey {
+	var fks []*ForeignKey
+	for _, c := range t.Columns {
+		for _, cons := range c.Constraints {
+			if cons.Kind == ConstraintForeignKey {
+				fk := *cons.ForeignKey
+				fk.Columns = []string{c.Name}
+				fks = append(fks, &fk)
+			}
+		}
+	}
+	for _, cons := range t.Constraints {
+		if cons.Kind == ConstraintForeignKey {
+			fks = append(fks, cons.ForeignKey)
+		}
+	}
+	return fks
+}
+
+// SQL returns the CREATE TABLE statement of t, without the semicolon. Each column and table constraint is on its own
+// line.
+func (t *Table) SQL() string {
+	return t.sql(false)
+}
+
+// CommentedSQL is like SQL, but the comments of t and of your columns are included as SQL comments.
+func (t *Table) CommentedSQL() string {
+	return commented(t.Comment, "", t.sql(true))
+}
+
+// sql returns the CREATE TABLE statement of t, with the comments of the columns if withComments is true.
+func (t *Table) sql(withComments bool) string {
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if t.Temporary {
+		b.WriteString("TEMP ")
+	}
+	if t.Virtual() {
+		b.WriteString("VIRTUAL ")
+	}
+	b.WriteString("TABLE ")
+	b.WriteString(t.qualifiedName())
+	switch {
+	case t.Virtual():
+		b.WriteString(" USING ")
+		b.WriteString(quote(t.Module))
+		if len(t.Arguments) > 0 {
+			b.WriteString("(" + strings.Join(t.Arguments, ", ") + ")")
+		}
+		return b.String()
+	case t.Select != "":
+		b.WriteString(" AS ")
+		b.WriteString(t.Select)
+		return b.String()
+	}
+
+	b.WriteString("(")
+	var lines, comments []string
+	for _, c := range t.Columns {
+		lines = append(lines, c.SQL())
+		comments = append(comments, c.Comment)
+	}
+	for _, c := range t.Constraints {
+		lines = append(lines, c.SQL())
+		comments = append(comments, "")
+	}
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString("\n")
+		if withComments {
+			b.WriteString(commented(comments[i], "  ", ""))
+		}
+		b.WriteString("  ")
+		b.WriteString(line)
+	}
+	b.WriteString("\n)")
+	var options []string
+	if t.WithoutRowid {
+		options = append(options, "WITHOUT ROWID")
+	}
+	if t.Strict {
+		options = append(options, "STRICT")
+	}
+	if len(options) > 0 {
+		b.WriteString(" " + strings.Join(options, ", "))
+	}
+	return b.String()
+}
+
+// qualifiedName returns the name of t, qualified by the schema if it has one.
+func (t *Table) qualifiedName() string {
+	return qualify(t.Schema, t.Name)
+}
+
+// clone returns a deep copy of t.
+func (t *Table) clone() *Table {
+	c := *t
+	c.Columns = make([]*Column, len(t.Columns))
+	for i, col := range t.Columns {
+		c.Columns[i] = col.clone()
+	}
+	c.Constraints = cloneConstraints(t.Constraints)
+	c.Arguments = append([]string(nil), t.Arguments...)
+	return &c
+}
+
+// Column is a column of a table.
+type Column struct {
+	// Name is the name of the column.
+	Name string
+	// Type is the type name of the column, like "VARCHAR(10)", or the empty string if the column has no type name.
+	Type string
+	// Constraints are the constraints of the column.
+	Constraints []*Constraint
+	// Comment is the text of the comments before the definition of the column, or on the same line after it, without the
+	// comment delimiters.
+	Comment string
+}
+
+// Constraint returns the first constraint of c with kind k, or nil if there is none.
+func (c *Column) Constraint(k ConstraintKind) *Constraint {
+	for _, cons := range c.Constraints {
+		if cons.Kind == k {
+			return cons
+		}
+	}
+	return nil
+}
+
+// SQL returns the definition of c, as in a CREATE TABLE statement.
+func (c *Column) SQL() string {
+	parts := []string{quote(c.Name)}
+	if def := c.Definition(); def != "" {
+		parts = append(parts, def)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Definition returns the definition of c without the name, that is, the type name and the constraints.
+func (c *Column) Definition() string {
+	var parts []string
+	if c.Type != "" {
+		parts = append(parts, c.Type)
+	}
+	for _, cons := range c.Constraints {
+		parts = append(parts, cons.SQL())
+	}
+	return strings.Join(parts, " ")
+}
+
+// clone returns a deep copy of c.
+func (c *Column) clone() *Column {
+	d := *c
+	d.Constraints = cloneConstraints(c.Constraints)
+	return &d
+}
+
+// ConstraintKind is the kind of a constraint.
+type ConstraintKind int
+
+const (
+	ConstraintPrimaryKey ConstraintKind = iota
+	ConstraintNotNull
+	ConstraintUnique
+	ConstraintCheck
+	ConstraintDefault
+	ConstraintCollate
+	ConstraintForeignKey
+	ConstraintGenerated
+)
+
+// String returns a string representation of k.
+func (k ConstraintKind) String() string {
+	if k < 0 || int(k) >= len(constraintKindStrings) {
+		return strconv.Itoa(int(k))
+	}
+	return constraintKindStrings[k]
+}
+
+// constraintKindStrings contains the string representation of the constraint kinds. Note that the value of a kind is the
+// index of your string representation.
+var constraintKindStrings = []string{
+	"PrimaryKey", "NotNull", "Unique", "Check", "Default", "Collate", "ForeignKey", "Generated",
+}
+
+// Constraint is a column constraint or a table constraint.
+type Constraint struct {
+	// Kind is the kind of the constraint. A table constraint is of kind ConstraintPrimaryKey, ConstraintUnique,
+	// ConstraintCheck or ConstraintForeignKey.
+	Kind ConstraintKind
+	// Name is the name given with CONSTRAINT, if any.
+	Name string
+	// Columns are the columns of a PRIMARY KEY or UNIQUE table constraint.
+	Columns []IndexedColumn
+	// Order is ASC or DESC, if given in a PRIMARY KEY column constraint.
+	Order string
+	// Conflict is the conflict resolution algorithm given with ON CONFLICT, if any.
+	Conflict string
+	// Autoincrement reports whether a PRIMARY KEY constraint has AUTOINCREMENT.
+	Autoincrement bool
+	// Expression is the expression of a CHECK, DEFAULT or generated column constraint. The expression of a DEFAULT
+	// constraint is the text after DEFAULT, so it has the parentheses, if any.
+	Expression string
+	// Collation is the collation of a COLLATE constraint.
+	Collation string
+	// Stored reports whether a generated column is stored.
+	Stored bool
+	// ForeignKey is the foreign key of a FOREIGN KEY constraint.
+	ForeignKey *ForeignKey
+}
+
+// ColumnNames returns the names of the columns of c, omitting the expressions.
+func (c *Constraint) ColumnNames() []string {
+	var names []string
+	for _, ic := range c.Columns {
+		if ic.Name != "" {
+			names = append(names, ic.Name)
+		}
+	}
+	return names
+}
+
+// SQL returns c as in a CREATE TABLE statement.
+func (c *Constraint) SQL() string {
+	var parts []string
+	if c.Name != "" {
+		parts = append(parts, "CONSTRAINT", quote(c.Name))
+	}
+	switch c.Kind {
+	case ConstraintPrimaryKey:
+		parts = append(parts, "PRIMARY KEY")
+		if c.Order != "" {
+			parts = append(parts, c.Order)
+		}
+		if len(c.Columns) > 0 {
+			parts = append(parts, indexedColumns(c.Columns))
+		}
+	case ConstraintNotNull:
+		parts = append(parts, "NOT NULL")
+	case ConstraintUnique:
+		parts = append(parts, "UNIQUE")
+		if len(c.Columns) > 0 {
+			parts = append(parts, indexedColumns(c.Columns))
+		}
+	case ConstraintCheck:
+		parts = append(parts, "CHECK ("+c.Expression+")")
+	case ConstraintDefault:
+		parts = append(parts, "DEFAULT", c.Expression)
+	case ConstraintCollate:
+		parts = append(parts, "COLLATE", quote(c.Collation))
+	case ConstraintForeignKey:
+		parts = append(parts, c.ForeignKey.SQL())
+	case ConstraintGenerated:
+		parts = append(parts, "AS ("+c.Expression+")")
+		if c.Stored {
+			parts = append(parts, "STORED")
+		}
+	}
+	if c.Conflict != "" {
+		parts = append(parts, "ON CONFLICT", c.Conflict)
+	}
+	if c.Autoincrement {
+		parts = append(parts, "AUTOINCREMENT")
+	}
+	return strings.Join(parts, " ")
+}
+
+// cloneConstraints returns a deep copy of cs.
+func cloneConstraints(cs []*Constraint) []*Constraint {
+	if cs == nil {
+		return nil
+	}
+	d := make([]*Constraint, len(cs))
+	for i, c := range cs {
+		cc := *c
+		cc.Columns = append([]IndexedColumn(nil), c.Columns...)
+		if c.ForeignKey != nil {
+			fk := *c.ForeignKey
+			fk.Columns = append([]string(nil), fk.Columns...)
+			fk.ReferencedColumns = append([]string(nil), fk.ReferencedColumns...)
+			cc.ForeignKey = &fk
+		}
+		d[i] = &cc
+	}
+	return d
+}
+
+// ForeignKey is a foreign key.
+type ForeignKey struct {
+	// Columns are the columns of the child table. They are empty in a column constraint, see Table.ForeignKeys.
+	Columns []string
+	// Table is the parent table.
+	Table string
+	// ReferencedColumns are the columns of the parent table. If they are empty the primary key of the parent table is
+	// referenced.
+	ReferencedColumns []string
+	// OnDelete and OnUpdate are the actions of ON DELETE and ON UPDATE, like "CASCADE" or "SET NULL", if given.
+	OnDelete, OnUpdate string
+	// Match is the name given with MATCH, if any.
+	Match string
+	// Deferrable is the deferrable clause, like "DEFERRABLE INITIALLY DEFERRED", if any.
+	Deferrable string
+}
+
+// SQL returns fk as in a CREATE TABLE statement. The FOREIGN KEY and the columns of the child table are included only
+// if fk has them.
+func (fk *ForeignKey) SQL() string {
+	var parts []string
+	if len(fk.Columns) > 0 {
+		parts = append(parts, "FOREIGN KEY "+names(fk.Columns))
+	}
+	ref := "REFERENCES " + quote(fk.Table)
+	if len(fk.ReferencedColumns) > 0 {
+		ref += names(fk.ReferencedColumns)
+	}
+	parts = append(parts, ref)
+	if fk.OnDelete != "" {
+		parts = append(parts, "ON DELETE", fk.OnDelete)
+	}
+	if fk.OnUpdate != "" {
+		parts = append(parts, "ON UPDATE", fk.OnUpdate)
+	}
+	if fk.Match != "" {
+		parts = append(parts, "MATCH", quote(fk.Match))
+	}
+	if fk.Deferrable != "" {
+		parts = append(parts, fk.Deferrable)
+	}
+	return strings.Join(parts, " ")
+}
+
+// IndexedColumn is a column of an index, or of a PRIMARY KEY or UNIQUE table constraint.
+type IndexedColumn struct {
+	// Name is the name of the column, or the empty string if it is an expression.
+	Name string
+	// Expression is the expression, if it is not a column.
+	Expression string
+	// Collation is the collation given with COLLATE, if any.
+	Collation string
+	// Order is ASC or DESC, if given.
+	Order string
+}
+
+// SQL returns ic as in a CREATE INDEX statement.
+func (ic IndexedColumn) SQL() string {
+	s := ic.Expression
+	if ic.Name != "" {
+		s = quote(ic.Name)
+	}
+	if ic.Collation != "" {
+		s += " COLLATE " + quote(ic.Collation)
+	}
+	if ic.Order != "" {
+		s += " " + ic.Order
+	}
+	return s
+}
+
+// indexedColumns returns the list of cs, between parentheses.
+func indexedColumns(cs []IndexedColumn) string {
+	list := make([]string, len(cs))
+	for i, c := range cs {
+		list[i] = c.SQL()
+	}
+	return "(" + strings.Join(list, ", ") + ")"
+}
+
+// names returns the list of the names ns, quoted if needed, between parentheses.
+func names(ns []string) string {
+	list := make([]string, len(ns))
+	for i, n := range ns {
+		list[i] = quote(n)
+	}
+	return "(" + strings.Join(list, ", ") + ")"
+}
+
+// qualify returns name qualified by schema, if schema isn't empty.
+func qualify(schema, name string) string {
+	if schema == "" {
+		return quote(name)
+	}
+	return quote(schema) + "." + quote(name)
+}
+
+// newTable creates a table from a tree of kind parsetree.KindCreateTable or parsetree.KindCreateVirtualTable.
+func newTable(tree parsetree.NonTerminal) *Table {
+	t := &Table{}
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindToken:
+			switch c.(parsetree.Terminal).Token().Kind {
+			case token.KindTemp, token.KindTemporary:
+				t.Temporary = true
+			}
+		case parsetree.KindSchemaName:
+			t.Schema = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindTableName:
+			t.Name = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindModuleName:
+			t.Module = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindCommaList:
+			for _, item := range children(c.(parsetree.NonTerminal)) {
+				switch item.Kind() {
+				case parsetree.KindColumnDefinition:
+					t.Columns = append(t.Columns, newColumn(item.(parsetree.NonTerminal)))
+				case parsetree.KindTableConstraint:
+					t.Constraints = append(t.Constraints, newConstraint(item.(parsetree.NonTerminal)))
+				case parsetree.KindTableOption:
+					option := strings.ToUpper(Text(item))
+					t.WithoutRowid = t.WithoutRowid || option == "WITHOUT ROWID"
+					t.Strict = t.Strict || option == "STRICT"
+				case parsetree.KindModuleArgument:
+					t.Arguments = append(t.Arguments, Text(item))
+				}
+			}
+		case parsetree.KindSimpleSelect, parsetree.KindCompoundSelect:
+			t.Select = Text(c)
+		}
+	}
+	return t
+}
+
+// newColumn creates a column from a tree of kind parsetree.KindColumnDefinition.
+func newColumn(tree parsetree.NonTerminal) *Column {
+	col := &Column{}
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindColumnName:
+			col.Name = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindTypeName:
+			col.Type = Text(c)
+		case parsetree.KindColumnConstraint:
+			col.Constraints = append(col.Constraints, newConstraint(c.(parsetree.NonTerminal)))
+		}
+	}
+	return col
+}
+
+// newConstraint creates a constraint from a tree of kind parsetree.KindColumnConstraint or
+// parsetree.KindTableConstraint.
+func newConstraint(tree parsetree.NonTerminal) *Constraint {
+	cons := &Constraint{}
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindConstraintName:
+			cons.Name = syntax.Name(c.(parsetree.Terminal))
+			continue
+		case parsetree.KindPrimaryKeyColumnConstraint, parsetree.KindPrimaryKeyTableConstraint:
+			cons.Kind = ConstraintPrimaryKey
+		case parsetree.KindNotNullColumnConstraint:
+			cons.Kind = ConstraintNotNull
+		case parsetree.KindUniqueColumnConstraint, parsetree.KindUniqueTableConstraint:
+			cons.Kind = ConstraintUnique
+		case parsetree.KindCheckColumnConstraint, parsetree.KindCheckTableConstraint:
+			cons.Kind = ConstraintCheck
+		case parsetree.KindDefaultColumnConstraint:
+			cons.Kind = ConstraintDefault
+		case parsetree.KindCollateColumnConstraint:
+			cons.Kind = ConstraintCollate
+		case parsetree.KindForeignKeyColumnConstraint, parsetree.KindForeignKeyTableConstraint:
+			cons.Kind = ConstraintForeignKey
+		case parsetree.KindGeneratedColumnConstraint:
+			cons.Kind = ConstraintGenerated
+		default:
+			continue
+		}
+		cons.fill(c.(parsetree.NonTerminal))
+	}
+	return cons
+}
+
+// fill fills the fields of c from the tree of the constraint.
+func (c *Constraint) fill(tree parsetree.NonTerminal) {
+	for _, child := range children(tree) {
+		switch child.Kind() {
+		case parsetree.KindToken:
+			switch child.(parsetree.Terminal).Token().Kind {
+			case token.KindAsc:
+				c.Order = "ASC"
+			case token.KindDesc:
+				c.Order = "DESC"
+			case token.KindAutoincrement:
+				c.Autoincrement = true
+			case token.KindStored:
+				c.Stored = true
+			}
+		case parsetree.KindConflictClause:
+			ts := children(child.(parsetree.NonTerminal))
+			c.Conflict = Text(ts[len(ts)-1])
+		case parsetree.KindCommaList:
+			for _, item := range children(child.(parsetree.NonTerminal)) {
+				switch item.Kind() {
+				case parsetree.KindIndexedColumn:
+					c.Columns = append(c.Columns, newIndexedColumn(item.(parsetree.NonTerminal)))
+				case parsetree.KindColumnName:
+					if c.ForeignKey == nil {
+						c.ForeignKey = &ForeignKey{}
+					}
+					c.ForeignKey.Columns = append(c.ForeignKey.Columns, syntax.Name(item.(parsetree.Terminal)))
+				}
+			}
+		case parsetree.KindExpression:
+			c.Expression = Text(child)
+		case parsetree.KindCollationName:
+			c.Collation = syntax.Name(child.(parsetree.Terminal))
+		case parsetree.KindForeignKeyClause:
+			if c.ForeignKey == nil {
+				c.ForeignKey = &ForeignKey{}
+			}
+			c.ForeignKey.fill(child.(parsetree.NonTerminal))
+		}
+	}
+	if c.Kind == ConstraintDefault {
+		// the tokens after DEFAULT.
+		c.Expression = textOf(tree, children(tree)[1:])
+	}
+}
+
+// fill fills the fields of fk from a tree of kind parsetree.KindForeignKeyClause.
+func (fk *ForeignKey) fill(tree parsetree.NonTerminal) {
+	var words []string
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindTableName:
+			fk.Table = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindCommaList:
+			for _, item := range children(c.(parsetree.NonTerminal)) {
+				if item.Kind() == parsetree.KindColumnName {
+					fk.ReferencedColumns = append(fk.ReferencedColumns, syntax.Name(item.(parsetree.Terminal)))
+				}
+			}
+		case parsetree.KindToken:
+			t := c.(parsetree.Terminal).Token()
+			switch t.Kind {
+			case token.KindReferences, token.KindLeftParen, token.KindRightParen:
+			default:
+				words = append(words, Text(c))
+			}
+		}
+	}
+
+	// the clauses are ON DELETE action, ON UPDATE action, MATCH name and [NOT] DEFERRABLE [INITIALLY ...].
+	for i := 0; i < len(words); {
+		switch {
+		case words[i] == "ON" && i+2 < len(words):
+			action, n := foreignKeyAction(words[i+2:])
+			if words[i+1] == "DELETE" {
+				fk.OnDelete = action
+			} else {
+				fk.OnUpdate = action
+			}
+			i += 2 + n
+		case words[i] == "MATCH" && i+1 < len(words):
+			fk.Match, _ = literal.Identifier([]byte(words[i+1]))
+			i += 2
+		default:
+			fk.Deferrable = strings.Join(words[i:], " ")
+			return
+		}
+	}
+}
+
+// foreignKeyAction returns the action at the start of words and the number of words of the action.
+func foreignKeyAction(words []string) (action string, n int) {
+	if (words[0] == "SET" || words[0] == "NO") && len(words) > 1 {
+		return words[0] + " " + words[1], 2
+	}
+	return words[0], 1
+}
+
+// newIndexedColumn creates an indexed column from a tree of kind parsetree.KindIndexedColumn.
+func newIndexedColumn(tree parsetree.NonTerminal) IndexedColumn {
+	var ic IndexedColumn
+	for _, c := range children(tree) {
+		switch c.Kind() {
+		case parsetree.KindColumnName:
+			ic.Name = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindCollationName:
+			ic.Collation = syntax.Name(c.(parsetree.Terminal))
+		case parsetree.KindExpression:
+			e := c.(parsetree.NonTerminal)
+			if collate := onlyChild(e, parsetree.KindCollate); collate != nil {
+				// the collation is of the indexed column.
+				cs := children(collate)
+				ic.Collation = syntax.Name(cs[len(cs)-1].(parsetree.Terminal))
+				e = parsetree.NewNonTerminal(parsetree.KindExpression)
+				e.AddChild(cs[0])
+			}
+			if ref := onlyChild(e, parsetree.KindColumnReference); ref != nil && ref.NumberOfChildren() == 1 {
+				ic.Name = syntax.Name(children(ref)[0].(parsetree.Terminal))
+			} else {
+				ic.Expression = Text(e)
+			}
+		case parsetree.KindToken:
+			switch c.(parsetree.Terminal).Token().Kind {
+			case token.KindAsc:
+				ic.Order = "ASC"
+			case token.KindDesc:
+				ic.Order = "DESC"
+			}
+		}
+	}
+	return ic
+}
+
+// children returns the children of nt.
+func children(nt parsetree.NonTerminal) []parsetree.Construction {
+	cs := make([]parsetree.Construction, 0, nt.NumberOfChildren())
+	nt.Children(func(c parsetree.Construction) bool {
+		cs = append(cs, c)
+		return true
+	})
+	return cs
+}
+
+// onlyChild returns the only child of nt if it is a non terminal of kind k, or nil otherwise.
+func onlyChild(nt parsetree.NonTerminal, k parsetree.Kind) parsetree.NonTerminal {
+	cs := children(nt)
+	if len(cs) != 1 || cs[0].Kind() != k {
+		return nil
+	}
+	c, _ := cs[0].(parsetree.NonTerminal)
+	return c
+}
+
+// textOf returns the text of the constructions cs, that are children of parent, like Text.
+func textOf(parent parsetree.NonTerminal, cs []parsetree.Construction) string {
+	r := renderer{ancestors: []parsetree.NonTerminal{parent}}
+	for _, c := range cs {
+		r.walk(c)
+	}
+	return r.b.String()
+}
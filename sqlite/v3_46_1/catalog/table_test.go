@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestTableSQL tests that the tables are rendered in a canonical form.
+func TestTableSQL(t *testing.T) {
+	cases := []struct {
+		code, want string
+	}{
+		{
+			code: "create table t(a integer primary key desc on conflict replace autoincrement, b)",
+			want: "CREATE TABLE t(\n  a integer PRIMARY KEY DESC ON CONFLICT REPLACE AUTOINCREMENT,\n  b\n)",
+		},
+		{
+			code: `CREATE TEMP TABLE main."my table"(x varchar ( 10 ) not null default -1 collate nocase, ` +
+				`constraint c check(x>0)) without rowid, strict`,
+			want: "CREATE TEMP TABLE main.\"my table\"(\n  x varchar(10) NOT NULL DEFAULT -1 COLLATE nocase,\n" +
+				"  CONSTRAINT c CHECK (x > 0)\n) WITHOUT ROWID, STRICT",
+		},
+		{
+			code: "CREATE TABLE t(a, b AS (a * 2) STORED, c GENERATED ALWAYS AS (a + 1))",
+			want: "CREATE TABLE t(\n  a,\n  b AS (a * 2) STORED,\n  c AS (a + 1)\n)",
+		},
+		{
+			code: "CREATE TABLE t(a REFERENCES u(x) ON DELETE CASCADE, FOREIGN KEY (a) REFERENCES u ON UPDATE SET NULL " +
+				"DEFERRABLE INITIALLY DEFERRED)",
+			want: "CREATE TABLE t(\n  a REFERENCES u(x) ON DELETE CASCADE,\n  FOREIGN KEY (a) REFERENCES u ON UPDATE SET NULL " +
+				"DEFERRABLE INITIALLY DEFERRED\n)",
+		},
+		{code: "CREATE TABLE t AS SELECT 1 AS x", want: "CREATE TABLE t AS SELECT 1 AS x"},
+		{code: "CREATE VIRTUAL TABLE t USING fts5(a, b)", want: "CREATE VIRTUAL TABLE t USING fts5(a, b)"},
+	}
+	for _, c := range cases {
+		cat := load(t, c.code)
+		if got := cat.Tables[0].SQL(); got != c.want {
+			t.Errorf("got\n%s\nwant\n%s", got, c.want)
+		}
+	}
+}
+
+// TestTableMethods tests the methods that query a table.
+func TestTableMethods(t *testing.T) {
+	c := load(t, `CREATE TABLE t(a INT REFERENCES u(x), b TEXT NOT NULL, c, PRIMARY KEY (b, c), FOREIGN KEY (b, c) REFERENCES v(y, z));
+		CREATE VIRTUAL TABLE w USING fts5(x);`)
+	tbl := c.Table("T")
+	if tbl.Virtual() || !c.Table("w").Virtual() {
+		t.Errorf("wrong Virtual")
+	}
+	if tbl.Column("B") == nil || tbl.Column("d") != nil {
+		t.Errorf("wrong Column")
+	}
+	if got := tbl.PrimaryKey(); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("got primary key %v", got)
+	}
+	fks := tbl.ForeignKeys()
+	if len(fks) != 2 || !slices.Equal(fks[0].Columns, []string{"a"}) || fks[0].Table != "u" ||
+		!slices.Equal(fks[1].ReferencedColumns, []string{"y", "z"}) {
+		t.Errorf("wrong foreign keys %v", fks)
+	}
+	if fks[0] == tbl.Columns[0].Constraints[0].ForeignKey {
+		t.Errorf("ForeignKeys must return copies")
+	}
+	if got := tbl.Columns[1].Definition(); got != "TEXT NOT NULL" {
+		t.Errorf("got definition %q", got)
+	}
+	if cons := tbl.Columns[1].Constraint(ConstraintNotNull); cons == nil || cons.Kind.String() != "NotNull" {
+		t.Errorf("wrong Constraint")
+	}
+}
@@ -0,0 +1,134 @@
+// This package draws entity-relationship diagrams of the tables of a catalog, in the formats of Mermaid and of
+// Graphviz. The entities are the tables, with your columns, and the relationships are the foreign keys.
+package diagram
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+)
+
+// Options are the options of a diagram.
+type Options struct {
+	// From is the name of a table. If it is given, only the tables reachable from it through the foreign keys, followed
+	// in both directions, are included.
+	From string
+}
+
+// entity is a table in a diagram.
+type entity struct {
+	table *catalog.Table
+	// attributes are the columns of the table.
+	attributes []attribute
+}
+
+// attribute is a column in a diagram.
+type attribute struct {
+	name, typ, comment string
+	// primary, foreign and unique report whether the column is in the primary key, in a foreign key and alone in a
+	// unique key.
+	primary, foreign, unique bool
+}
+
+// relationship is a foreign key in a diagram.
+type relationship struct {
+	child, parent *catalog.Table
+	// columns are the columns of child and referenced are the columns of parent.
+	columns, referenced []string
+	// mandatory reports whether the columns of child are NOT NULL, so a child has a parent, and unique reports whether
+	// they are a key of child, so a parent has at most one child.
+	mandatory, unique bool
+}
+
+// model returns the entities and the relationships of the diagram of c. The entities are ordered by schema, the ones
+// without schema first, and otherwise are in the order of c.
+func model(c *catalog.Catalog, opts Options) ([]*entity, []relationship, error) {
+	tables := c.Tables
+	if opts.From != "" {
+		from := c.Table(opts.From)
+		if from == nil {
+			return nil, nil, fmt.Errorf("%w: %s", catalog.ErrNoSuchTable, opts.From)
+		}
+		tables = reachable(c, from)
+	}
+	tables = slices.Clone(tables)
+	slices.SortStableFunc(tables, func(a, b *catalog.Table) int { return cmp.Compare(a.Schema, b.Schema) })
+
+	var entities []*entity
+	var rels []relationship
+	for _, t := range tables {
+		e := &entity{table: t}
+		pk := t.PrimaryKey()
+		fks := t.ForeignKeys()
+		for _, col := range t.Columns {
+			a := attribute{
+				name:    col.Name,
+				typ:     col.Type,
+				comment: col.Comment,
+				primary: contains(pk, col.Name),
+				foreign: slices.ContainsFunc(fks, func(fk *catalog.ForeignKey) bool { return contains(fk.Columns, col.Name) }),
+			}
+			// the primary key is not marked as unique too.
+			a.unique = !(a.primary && len(pk) == 1) && c.Unique(t.SchemaName(), t.Name, []string{col.Name})
+			e.attributes = append(e.attributes, a)
+		}
+		entities = append(entities, e)
+
+		for _, fk := range fks {
+			parent := c.Parent(t, fk)
+			if parent == nil || !slices.Contains(tables, parent) {
+				continue
+			}
+			r := relationship{
+				child: t, parent: parent, columns: fk.Columns, referenced: fk.ReferencedColumns,
+				mandatory: true, unique: c.Unique(t.SchemaName(), t.Name, fk.Columns),
+			}
+			if len(r.referenced) == 0 {
+				r.referenced = parent.PrimaryKey()
+			}
+			for _, n := range fk.Columns {
+				if col := t.Column(n); col == nil || col.Constraint(catalog.ConstraintNotNull) == nil {
+					r.mandatory = false
+				}
+			}
+			rels = append(rels, r)
+		}
+	}
+	return entities, rels, nil
+}
+
+// reachable returns the tables of c reachable from the table from through the foreign keys, in both directions, in the
+// order of c. Like in SQLite, the parent of a foreign key is in the schema of the child.
+func reachable(c *catalog.Catalog, from *catalog.Table) []*catalog.Table {
+	reached := []*catalog.Table{from}
+	for queue := []*catalog.Table{from}; len(queue) > 0; queue = queue[1:] {
+		for _, t := range c.Tables {
+			for _, fk := range t.ForeignKeys() {
+				p := c.Parent(t, fk)
+				for _, n := range []*catalog.Table{t, p} {
+					if (t == queue[0] || p == queue[0]) && n != nil && !slices.Contains(reached, n) {
+						reached = append(reached, n)
+						queue = append(queue, n)
+					}
+				}
+			}
+		}
+	}
+	return slices.DeleteFunc(slices.Clone(c.Tables), func(t *catalog.Table) bool { return !slices.Contains(reached, t) })
+}
+
+// qualifiedName returns the name of t, qualified by the schema if it has one or if t is temporary.
+func qualifiedName(t *catalog.Table) string {
+	if t.Schema == "" && !t.Temporary {
+		return t.Name
+	}
+	return t.SchemaName() + "." + t.Name
+}
+
+// contains reports whether names contains name, ignoring the case.
+func contains(names []string, name string) bool {
+	return slices.ContainsFunc(names, func(n string) bool { return literal.EqualIdentifiers(n, name) })
+}
@@ -0,0 +1,133 @@
+package diagram
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// load loads code in a catalog, failing the test on error.
+func load(t *testing.T, code string) *catalog.Catalog {
+	t.Helper()
+	c, err := catalog.Load([]byte(code))
+	if err != nil {
+		t.Fatalf("Load(%q): %v", code, err)
+	}
+	return c
+}
+
+// schema is the schema of the tests.
+const schema = `
+	CREATE TABLE aux.logs(id INTEGER PRIMARY KEY, message TEXT);
+	CREATE TABLE users(
+		id INTEGER PRIMARY KEY,
+		-- the e-mail
+		email TEXT NOT NULL UNIQUE
+	);
+	CREATE TABLE profiles(user INT NOT NULL UNIQUE REFERENCES users, bio TEXT);
+	CREATE TABLE posts(
+		id INTEGER PRIMARY KEY,
+		author INT NOT NULL,
+		parent INT REFERENCES posts(id),
+		FOREIGN KEY (author) REFERENCES users(id)
+	);
+	CREATE TABLE tags(post INT, name TEXT, PRIMARY KEY (post, name), FOREIGN KEY (post) REFERENCES posts);
+	CREATE TABLE settings(name TEXT PRIMARY KEY, value DECIMAL(10, 2));
+	CREATE VIRTUAL TABLE search USING fts5(body);
+`
+
+// names returns the qualified names of the tables of the entities.
+func names(entities []*entity) []string {
+	var ns []string
+	for _, e := range entities {
+		ns = append(ns, qualifiedName(e.table))
+	}
+	return ns
+}
+
+// TestModel tests the entities and the relationships of the diagrams.
+func TestModel(t *testing.T) {
+	c := load(t, schema)
+	entities, rels, err := model(c, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"users", "profiles", "posts", "tags", "settings", "search", "aux.logs"}
+	if got := names(entities); !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	users := entities[0].attributes
+	if !users[0].primary || users[0].unique || !users[1].unique || users[1].comment != "the e-mail" {
+		t.Errorf("wrong attributes %+v", users)
+	}
+	if tags := entities[3].attributes; !tags[0].primary || !tags[0].foreign || tags[0].unique || !tags[1].primary {
+		t.Errorf("wrong attributes %+v", tags)
+	}
+
+	var got []string
+	for _, r := range rels {
+		s := r.child.Name + "->" + r.parent.Name + " " + r.referenced[0]
+		if r.mandatory {
+			s += " mandatory"
+		}
+		if r.unique {
+			s += " unique"
+		}
+		got = append(got, s)
+	}
+	want = []string{"profiles->users id mandatory unique", "posts->posts id", "posts->users id mandatory", "tags->posts id"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// TestReachable tests the option From.
+func TestReachable(t *testing.T) {
+	c := load(t, schema)
+	entities, rels, err := model(c, Options{From: "TAGS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := names(entities), []string{"users", "profiles", "posts", "tags"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if len(rels) != 4 {
+		t.Errorf("got %d relationships, want 4", len(rels))
+	}
+
+	entities, rels, err = model(c, Options{From: "settings"})
+	if err != nil || !slices.Equal(names(entities), []string{"settings"}) || len(rels) != 0 {
+		t.Errorf("got %q, %v and %v", names(entities), rels, err)
+	}
+
+	if _, _, err := model(c, Options{From: "none"}); !errors.Is(err, catalog.ErrNoSuchTable) {
+		t.Errorf("got %v, want ErrNoSuchTable", err)
+	}
+}
+
+// TestModelSchemas tests that the parent of a foreign key is the table of the schema of the child.
+func TestModelSchemas(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.users(id INTEGER PRIMARY KEY);
+		CREATE TABLE aux.owners(user INT REFERENCES users);
+		CREATE TEMP TABLE users(id INTEGER PRIMARY KEY);
+	`)
+	entities, rels, err := model(c, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := names(entities), []string{"users", "temp.users", "aux.users", "aux.owners"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if len(rels) != 1 || qualifiedName(rels[0].parent) != "aux.users" {
+		t.Errorf("got relationships %v, want aux.owners -> aux.users", rels)
+	}
+
+	entities, _, err = model(c, Options{From: "owners"})
+	if got, want := names(entities), []string{"aux.users", "aux.owners"}; err != nil || !slices.Equal(got, want) {
+		t.Errorf("got %q and %v, want %q", got, err, want)
+	}
+}
@@ -0,0 +1,102 @@
+package diagram
+
+import (
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// DOT returns the diagram of the tables of c in the DOT language of Graphviz. The tables are records whose rows are
+// the columns, the tables of each schema are in a cluster, and the foreign keys are edges from the first column of the
+// child table to the first referenced column, with the crow's foot notation. The comments of the columns are tooltips.
+func DOT(c *catalog.Catalog, opts Options) (string, error) {
+	entities, rels, err := model(c, opts)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString("digraph {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=plaintext];\n")
+	for i := 0; i < len(entities); {
+		schema := entities[i].table.Schema
+		indent := "\t"
+		if schema != "" {
+			b.WriteString("\tsubgraph " + dotID("cluster_"+schema) + " {\n")
+			b.WriteString("\t\tlabel=" + dotID(schema) + ";\n")
+			indent = "\t\t"
+		}
+		for ; i < len(entities) && entities[i].table.Schema == schema; i++ {
+			b.WriteString(indent + dotNode(entities[i]) + ";\n")
+		}
+		if schema != "" {
+			b.WriteString("\t}\n")
+		}
+	}
+	for _, r := range rels {
+		parent, child := "teeodot", "crowodot"
+		if r.mandatory {
+			parent = "teetee"
+		}
+		if r.unique {
+			child = "teeodot"
+		}
+		b.WriteString("\t" + dotID(qualifiedName(r.child)) + dotPort(r.child, r.columns))
+		b.WriteString(" -> " + dotID(qualifiedName(r.parent)) + dotPort(r.parent, r.referenced))
+		b.WriteString(" [label=" + dotID(strings.Join(r.columns, ", ")))
+		b.WriteString(", dir=both, arrowhead=" + parent + ", arrowtail=" + child + "];\n")
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// dotNode returns the statement of the node of e, without the semicolon.
+func dotNode(e *entity) string {
+	var b strings.Builder
+	b.WriteString(dotID(qualifiedName(e.table)))
+	b.WriteString(` [label=<<table border="0" cellborder="1" cellspacing="0">`)
+	b.WriteString(`<tr><td bgcolor="lightgrey"><b>` + html.EscapeString(qualifiedName(e.table)) + `</b></td></tr>`)
+	for i, a := range e.attributes {
+		b.WriteString(`<tr><td port="c` + strconv.Itoa(i) + `" align="left"`)
+		if a.comment != "" {
+			b.WriteString(` tooltip="` + html.EscapeString(a.comment) + `"`)
+		}
+		b.WriteString(">" + html.EscapeString(strings.TrimSpace(a.name+" "+a.typ)))
+		var keys []string
+		if a.primary {
+			keys = append(keys, "PK")
+		}
+		if a.foreign {
+			keys = append(keys, "FK")
+		}
+		if a.unique {
+			keys = append(keys, "UK")
+		}
+		if len(keys) > 0 {
+			b.WriteString(" <i>" + strings.Join(keys, ", ") + "</i>")
+		}
+		b.WriteString("</td></tr>")
+	}
+	b.WriteString("</table>>]")
+	return b.String()
+}
+
+// dotPort returns the port of the first of the columns of t, like ":c1", or the empty string if there is none.
+func dotPort(t *catalog.Table, columns []string) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	i := slices.IndexFunc(t.Columns, func(c *catalog.Column) bool { return contains(columns[:1], c.Name) })
+	if i < 0 {
+		return ""
+	}
+	return ":c" + strconv.Itoa(i)
+}
+
+// dotID returns s as a quoted identifier of DOT.
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
@@ -0,0 +1,38 @@
+package diagram
+
+import "testing"
+
+// TestDOT tests the DOT diagram, with a cluster and names that need escapes.
+func TestDOT(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE aux.users(
+			id INTEGER PRIMARY KEY,
+			name TEXT -- the <full> name
+		);
+		CREATE TABLE aux."a ""b"""(x, user INT NOT NULL REFERENCES users(id));
+		CREATE TABLE aux.c(y REFERENCES users(name));
+	`)
+	got, err := DOT(c, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph {
+	rankdir=LR;
+	node [shape=plaintext];
+	subgraph "cluster_aux" {
+		label="aux";
+		"aux.users" [label=<<table border="0" cellborder="1" cellspacing="0"><tr><td bgcolor="lightgrey"><b>aux.users</b></td></tr><tr><td port="c0" align="left">id INTEGER <i>PK</i></td></tr><tr><td port="c1" align="left" tooltip="the &lt;full&gt; name">name TEXT</td></tr></table>>];
+		"aux.a \"b\"" [label=<<table border="0" cellborder="1" cellspacing="0"><tr><td bgcolor="lightgrey"><b>aux.a &#34;b&#34;</b></td></tr><tr><td port="c0" align="left">x</td></tr><tr><td port="c1" align="left">user INT <i>FK</i></td></tr></table>>];
+		"aux.c" [label=<<table border="0" cellborder="1" cellspacing="0"><tr><td bgcolor="lightgrey"><b>aux.c</b></td></tr><tr><td port="c0" align="left">y <i>FK</i></td></tr></table>>];
+	}
+	"aux.a \"b\"":c1 -> "aux.users":c0 [label="user", dir=both, arrowhead=teetee, arrowtail=crowodot];
+	"aux.c":c0 -> "aux.users":c1 [label="y", dir=both, arrowhead=teeodot, arrowtail=crowodot];
+}
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if _, err := DOT(c, Options{From: "none"}); err == nil {
+		t.Errorf("want an error")
+	}
+}
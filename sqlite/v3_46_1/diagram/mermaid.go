@@ -0,0 +1,100 @@
+package diagram
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// Mermaid returns the diagram of the tables of c in the erDiagram syntax of Mermaid. Mermaid has no clusters, so the
+// tables of a schema are only kept together, with the names qualified by the schema. The comments of the columns are
+// included.
+func Mermaid(c *catalog.Catalog, opts Options) (string, error) {
+	entities, rels, err := model(c, opts)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, e := range entities {
+		b.WriteString("    " + mermaidEntity(e.table))
+		if len(e.attributes) == 0 {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString(" {\n")
+		for _, a := range e.attributes {
+			typ := a.typ
+			if typ == "" {
+				typ = "ANY"
+			}
+			b.WriteString("        " + mermaidWord(typ) + " " + mermaidWord(a.name))
+			var keys []string
+			if a.primary {
+				keys = append(keys, "PK")
+			}
+			if a.foreign {
+				keys = append(keys, "FK")
+			}
+			if a.unique {
+				keys = append(keys, "UK")
+			}
+			if len(keys) > 0 {
+				b.WriteString(" " + strings.Join(keys, ", "))
+			}
+			if a.comment != "" {
+				b.WriteString(" " + mermaidString(a.comment))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("    }\n")
+	}
+	for _, r := range rels {
+		parent, child := "|o", "o{"
+		if r.mandatory {
+			parent = "||"
+		}
+		if r.unique {
+			child = "o|"
+		}
+		b.WriteString("    " + mermaidEntity(r.parent) + " " + parent + "--" + child + " " + mermaidEntity(r.child))
+		b.WriteString(" : " + mermaidString(strings.Join(r.columns, ", ")) + "\n")
+	}
+	return b.String(), nil
+}
+
+// mermaidName matches the names that Mermaid accepts without quotes.
+var mermaidName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// mermaidEntity returns the name of the entity of t, qualified by the schema and quoted if needed.
+func mermaidEntity(t *catalog.Table) string {
+	n := qualifiedName(t)
+	if mermaidName.MatchString(n) {
+		return n
+	}
+	return mermaidString(n)
+}
+
+// mermaidNotWord matches the characters that are not allowed in the types and names of the attributes.
+var mermaidNotWord = regexp.MustCompile(`[^A-Za-z0-9_()\[\]-]`)
+
+// mermaidWordStart matches the first characters allowed in the types and names of the attributes.
+var mermaidWordStart = regexp.MustCompile(`^[A-Za-z_]`)
+
+// mermaidWord returns s with the characters not allowed in the types and names of the attributes replaced by
+// underscores, like in "DECIMAL(10_2)". If s doesn't start with a letter or an underscore, an underscore is prepended,
+// like in "_1col".
+func mermaidWord(s string) string {
+	s = mermaidNotWord.ReplaceAllString(s, "_")
+	if !mermaidWordStart.MatchString(s) {
+		s = "_" + s
+	}
+	return s
+}
+
+// mermaidString returns s in double quotes. Mermaid has no escapes, so the double quotes of s are replaced by single
+// ones and the new lines by spaces.
+func mermaidString(s string) string {
+	return `"` + strings.NewReplacer(`"`, "'", "\n", " ").Replace(s) + `"`
+}
@@ -0,0 +1,71 @@
+package diagram
+
+import "testing"
+
+// TestMermaid tests the Mermaid diagram of the tables reachable from a table.
+func TestMermaid(t *testing.T) {
+	got, err := Mermaid(load(t, schema), Options{From: "posts"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `erDiagram
+    users {
+        INTEGER id PK
+        TEXT email UK "the e-mail"
+    }
+    profiles {
+        INT user FK, UK
+        TEXT bio
+    }
+    posts {
+        INTEGER id PK
+        INT author FK
+        INT parent FK
+    }
+    tags {
+        INT post PK, FK
+        TEXT name PK
+    }
+    users ||--o| profiles : "user"
+    posts |o--o{ posts : "parent"
+    users ||--o{ posts : "author"
+    posts |o--o{ tags : "post"
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if _, err := Mermaid(load(t, schema), Options{From: "none"}); err == nil {
+		t.Errorf("want an error")
+	}
+}
+
+// TestMermaidNames tests the names that Mermaid doesn't accept as they are.
+func TestMermaidNames(t *testing.T) {
+	c := load(t, `
+		CREATE TABLE aux."my table"(
+			"a column" DECIMAL(10, 2),
+			"1col" TEXT,
+			"2" INT,
+			-- a "quoted"
+			-- comment
+			b
+		);
+		CREATE VIRTUAL TABLE search USING fts5(body);
+	`)
+	got, err := Mermaid(c, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `erDiagram
+    search
+    "aux.my table" {
+        DECIMAL(10__2) a_column
+        TEXT _1col
+        INT _2
+        ANY b "a 'quoted' comment"
+    }
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
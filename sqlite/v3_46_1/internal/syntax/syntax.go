@@ -0,0 +1,159 @@
+// This package has the helpers that the packages of the module use to parse statements and to read the parse trees.
+package syntax
+
+import (
+	"fmt"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Parse parses the next statement of p, recovering from the panics of the parser. It returns an error if the statement
+// has syntax errors.
+func Parse(p *parser.Parser) (stmt parsetree.NonTerminal, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok {
+				panic(r)
+			}
+			err = e
+		}
+	}()
+	tree, _ := p.SQLStatement()
+	if err := FirstError(tree); err != nil {
+		return nil, err
+	}
+	return tree.(parsetree.NonTerminal), nil
+}
+
+// FirstError returns the first error or skipped token of c, if any.
+func FirstError(c parsetree.Construction) error {
+	switch c := c.(type) {
+	case parsetree.Error:
+		return c
+	case parsetree.NonTerminal:
+		var err error
+		c.Children(func(child parsetree.Construction) bool {
+			if t, ok := child.(parsetree.Terminal); ok && c.Kind() == parsetree.KindSkipped {
+				err = fmt.Errorf("unexpected %s", t.Token().Kind)
+			} else {
+				err = FirstError(child)
+			}
+			return err == nil
+		})
+		return err
+	}
+	return nil
+}
+
+// FirstNonTerminal returns the first child of tree that is a nonterminal, or nil if there is none.
+func FirstNonTerminal(tree parsetree.NonTerminal) parsetree.NonTerminal {
+	var nt parsetree.NonTerminal
+	tree.Children(func(child parsetree.Construction) bool {
+		nt, _ = child.(parsetree.NonTerminal)
+		return nt == nil
+	})
+	return nt
+}
+
+// ChildName returns the name in the first terminal child of tree of kind k, or the empty string if there is none.
+func ChildName(tree parsetree.NonTerminal, k parsetree.Kind) string {
+	var n string
+	tree.Children(func(child parsetree.Construction) bool {
+		if t, ok := child.(parsetree.Terminal); ok && child.Kind() == k {
+			n = Name(t)
+			return false
+		}
+		return true
+	})
+	return n
+}
+
+// Name returns the name in the terminal t. The quoted identifiers and the string literals used as names are unquoted.
+func Name(t parsetree.Terminal) string {
+	lexeme := t.Token().Lexeme
+	var (
+		s   string
+		err error
+	)
+	if t.Token().Kind == token.KindString {
+		s, err = literal.String(lexeme)
+	} else {
+		s, err = literal.Identifier(lexeme)
+	}
+	if err != nil {
+		return string(lexeme)
+	}
+	return s
+}
+
+// Last reports whether tree is the last statement of the code, that is, whether it ends with the EOF.
+func Last(tree parsetree.NonTerminal) bool {
+	var end parsetree.Construction
+	tree.Children(func(child parsetree.Construction) bool {
+		end = child
+		return true
+	})
+	t, ok := end.(parsetree.Terminal)
+	return end == nil || ok && t.Token().Kind == token.KindEOF
+}
+
+// Significant reports whether code has a token that is not a white space or a comment.
+func Significant(code []byte) bool {
+	l := lexer.New(code)
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		if !trivia(tok.Kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// trivia reports whether k is the kind of a white space or a comment.
+func trivia(k token.Kind) bool {
+	switch k {
+	case token.KindWhiteSpace, token.KindByteOrderMark, token.KindSQLComment, token.KindCComment:
+		return true
+	}
+	return false
+}
+
+// Recorder is a lexical.TokenProvider that records the offsets of the tokens provided by a lexer.
+type Recorder struct {
+	l *lexer.Lexer
+	// Offsets maps the tokens to their offsets.
+	Offsets map[*token.Token]int
+	// Significant are the tokens, except the white spaces, the comments and the EOF.
+	Significant []*token.Token
+	eof         bool
+}
+
+// NewRecorder creates a Recorder of the tokens of l.
+func NewRecorder(l *lexer.Lexer) *Recorder {
+	return &Recorder{l: l, Offsets: make(map[*token.Token]int)}
+}
+
+// Next implements lexical.TokenProvider.
+func (r *Recorder) Next() *token.Token {
+	offset := int(r.l.Offset())
+	tok := r.l.Next()
+	r.Offsets[tok] = offset
+	switch {
+	case tok.Kind == token.KindEOF:
+		r.eof = true
+	case !trivia(tok.Kind):
+		r.Significant = append(r.Significant, tok)
+	}
+	return tok
+}
+
+// Drain reads the tokens not read yet.
+func (r *Recorder) Drain() {
+	for !r.eof {
+		r.Next()
+	}
+}
@@ -0,0 +1,114 @@
+package syntax
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		code    string
+		invalid bool
+	}{
+		{code: "SELECT 1"},
+		{code: "SELECT FROM", invalid: true},
+		{code: "CREATE TABLE (a)", invalid: true},
+	}
+	for _, c := range cases {
+		stmt, err := Parse(parser.New(lexer.New([]byte(c.code))))
+		if c.invalid {
+			if err == nil {
+				t.Errorf("Parse(%q): want a syntax error", c.code)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.code, err)
+		} else if stmt.Kind() != parsetree.KindSQLStatement {
+			t.Errorf("Parse(%q): got a tree of kind %s", c.code, stmt.Kind())
+		}
+	}
+}
+
+func TestChildName(t *testing.T) {
+	cases := []struct {
+		code   string
+		kind   parsetree.Kind
+		expect string
+	}{
+		{code: "CREATE TABLE t(a)", kind: parsetree.KindTableName, expect: "t"},
+		{code: `CREATE TABLE "a ""b"""(a)`, kind: parsetree.KindTableName, expect: `a "b"`},
+		{code: "CREATE TABLE 'x'(a)", kind: parsetree.KindTableName, expect: "x"},
+		{code: "CREATE TABLE [y](a)", kind: parsetree.KindTableName, expect: "y"},
+		{code: "CREATE TABLE t(a)", kind: parsetree.KindSchemaName, expect: ""},
+	}
+	for _, c := range cases {
+		stmt, err := Parse(parser.New(lexer.New([]byte(c.code))))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.code, err)
+		}
+		create := FirstNonTerminal(stmt)
+		if create == nil || create.Kind() != parsetree.KindCreateTable {
+			t.Fatalf("FirstNonTerminal(%q): got %v", c.code, create)
+		}
+		if got := ChildName(create, c.kind); got != c.expect {
+			t.Errorf("ChildName(%q, %s) = %q, want %q", c.code, c.kind, got, c.expect)
+		}
+	}
+}
+
+func TestLast(t *testing.T) {
+	p := parser.New(lexer.New([]byte("SELECT 1; SELECT 2")))
+	for i, expect := range []bool{false, true} {
+		stmt, err := Parse(p)
+		if err != nil {
+			t.Fatalf("statement %d: %v", i+1, err)
+		}
+		if got := Last(stmt); got != expect {
+			t.Errorf("Last(statement %d) = %t, want %t", i+1, got, expect)
+		}
+	}
+}
+
+func TestSignificant(t *testing.T) {
+	cases := []struct {
+		code   string
+		expect bool
+	}{
+		{code: "", expect: false},
+		{code: " \n-- comment\n/* comment */", expect: false},
+		{code: "\uFEFF ;", expect: true},
+		{code: "/* comment */ SELECT 1", expect: true},
+	}
+	for _, c := range cases {
+		if got := Significant([]byte(c.code)); got != c.expect {
+			t.Errorf("Significant(%q) = %t, want %t", c.code, got, c.expect)
+		}
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	code := "SELECT /* c */ a; SELECT b"
+	r := NewRecorder(lexer.New([]byte(code)))
+	if _, err := Parse(parser.New(r)); err != nil {
+		t.Fatal(err)
+	}
+	r.Drain()
+
+	var got []string
+	for _, tok := range r.Significant {
+		offset := r.Offsets[tok]
+		if s := code[offset : offset+len(tok.Lexeme)]; s != string(tok.Lexeme) {
+			t.Errorf("the offset of %q is %d, where there is %q", tok.Lexeme, offset, s)
+		}
+		got = append(got, string(tok.Lexeme))
+	}
+	want := []string{"SELECT", "a", ";", "SELECT", "b"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got significant tokens %q, want %q", got, want)
+	}
+}
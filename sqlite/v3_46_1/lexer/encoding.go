@@ -0,0 +1,338 @@
+package lexer
+
+import (
+	"cmp"
+	"encoding/binary"
+	"io"
+	"slices"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Encoding is the encoding of the code read by a Lexer.
+type Encoding int
+
+const (
+	EncodingUTF8 Encoding = iota
+	EncodingUTF16LE
+	EncodingUTF16BE
+)
+
+// String returns a string representation of e.
+func (e Encoding) String() string {
+	switch e {
+	case EncodingUTF8:
+		return "UTF-8"
+	case EncodingUTF16LE:
+		return "UTF-16LE"
+	case EncodingUTF16BE:
+		return "UTF-16BE"
+	default:
+		return strconv.Itoa(int(e))
+	}
+}
+
+// byteOrder returns the byte order of the UTF-16 encodings.
+func (e Encoding) byteOrder() byteOrder {
+	if e == EncodingUTF16BE {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+// byteOrder is the byte order of the code units of UTF-16.
+type byteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// WithEncoding makes the lexer read code encoded with e, instead of detecting the encoding from the byte order mark.
+func WithEncoding(e Encoding) Option {
+	return func(l *Lexer) {
+		l.encoding = e
+		l.encodingSet = true
+	}
+}
+
+// WithSourceLexemes makes the lexemes of the tokens be in the encoding of the code instead of UTF-8.
+func WithSourceLexemes() Option {
+	return func(l *Lexer) {
+		l.sourceLexemes = true
+	}
+}
+
+// Encoding returns the encoding of the code. If the Lexer was created by NewReader without WithEncoding, the encoding
+// is known only after the first call to Next or NextInto.
+func (l *Lexer) Encoding() Encoding {
+	return l.encoding
+}
+
+// Offset returns the offset, on the code transcoded to UTF-8, of the end of the last token scanned.
+func (l *Lexer) Offset() int64 {
+	return l.r.getOffset()
+}
+
+// SourceOffset converts offset, an offset on the code transcoded to UTF-8, to the offset on the code in its original
+// encoding. An offset in the middle of a character is converted to the offset of the start of the character.
+func (l *Lexer) SourceOffset(offset int64) int64 {
+	if l.offsets == nil {
+		return offset
+	}
+	return l.offsets.source(offset)
+}
+
+// detectEncoding detects the encoding of code from its byte order mark.
+func detectEncoding(code []byte) Encoding {
+	if len(code) >= 2 && code[0] == 0xFF && code[1] == 0xFE {
+		return EncodingUTF16LE
+	} else if len(code) >= 2 && code[0] == 0xFE && code[1] == 0xFF {
+		return EncodingUTF16BE
+	}
+	return EncodingUTF8
+}
+
+// decodeUTF16 appends to dst the UTF-8 encoding of the UTF-16 code in src and adds the sizes of the characters to m.
+// It returns the extended dst and the number of bytes of src decoded. If atEOF is false, an incomplete code unit or
+// surrogate pair at the end of src is not decoded.
+//
+// Like SQLite, an unpaired surrogate is encoded as if it were a character, resulting in invalid UTF-8. An incomplete
+// code unit at the end of the code is transcoded to the invalid byte 0xFF.
+func decodeUTF16(dst, src []byte, order byteOrder, m *offsetMap, atEOF bool) ([]byte, int) {
+	i := 0
+	for i < len(src) {
+		if len(src)-i < 2 {
+			if !atEOF {
+				break
+			}
+			dst = append(dst, 0xFF)
+			m.add(1, 1)
+			i++
+			continue
+		}
+
+		u := order.Uint16(src[i:])
+		if !utf16.IsSurrogate(rune(u)) {
+			n := len(dst)
+			dst = utf8.AppendRune(dst, rune(u))
+			m.add(len(dst)-n, 2)
+			i += 2
+			continue
+		}
+
+		if u < 0xDC00 {
+			if len(src)-i < 4 && !atEOF {
+				break
+			}
+			if len(src)-i >= 4 {
+				if r := utf16.DecodeRune(rune(u), rune(order.Uint16(src[i+2:]))); r != utf8.RuneError {
+					dst = utf8.AppendRune(dst, r)
+					m.add(4, 4)
+					i += 4
+					continue
+				}
+			}
+		}
+		dst = append(dst, 0xE0|byte(u>>12), 0x80|byte(u>>6)&0x3F, 0x80|byte(u)&0x3F)
+		m.add(3, 2)
+		i += 2
+	}
+	return dst, i
+}
+
+// encodeUTF16 appends to dst the UTF-16 encoding of the code in src, that was transcoded to UTF-8 by decodeUTF16. The
+// unpaired surrogates are restored, but an invalid byte is encoded as utf8.RuneError.
+func encodeUTF16(dst, src []byte, order byteOrder) []byte {
+	for i := 0; i < len(src); {
+		if len(src)-i >= 3 && src[i] == 0xED && src[i+1]&0xE0 == 0xA0 && src[i+2]&0xC0 == 0x80 {
+			dst = order.AppendUint16(dst, 0xD000|uint16(src[i+1]&0x3F)<<6|uint16(src[i+2]&0x3F))
+			i += 3
+			continue
+		}
+
+		r, size := utf8.DecodeRune(src[i:])
+		if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+			dst = order.AppendUint16(dst, uint16(r1))
+			dst = order.AppendUint16(dst, uint16(r2))
+		} else {
+			dst = order.AppendUint16(dst, uint16(r))
+		}
+		i += size
+	}
+	return dst
+}
+
+// offsetMap maps the offsets on the code transcoded to UTF-8 to the offsets on the code in its original encoding. It
+// is a list of runs of characters that have the same sizes in both encodings, so it is small for most of the code.
+type offsetMap struct {
+	runs []offsetRun
+	// offset and sourceOffset are the offsets of the end of the code added to the map.
+	offset, sourceOffset int64
+}
+
+// offsetRun is a run of characters that have the same sizes in both encodings.
+type offsetRun struct {
+	// offset and sourceOffset are the offsets of the start of the run.
+	offset, sourceOffset int64
+	// size and sourceSize are the sizes of each character of the run.
+	size, sourceSize int64
+}
+
+// add adds to m a character of size bytes in UTF-8 and sourceSize bytes in the original encoding.
+func (m *offsetMap) add(size, sourceSize int) {
+	n := len(m.runs)
+	if n == 0 || m.runs[n-1].size != int64(size) || m.runs[n-1].sourceSize != int64(sourceSize) {
+		m.runs = append(m.runs, offsetRun{
+			offset:       m.offset,
+			sourceOffset: m.sourceOffset,
+			size:         int64(size),
+			sourceSize:   int64(sourceSize),
+		})
+	}
+	m.offset += int64(size)
+	m.sourceOffset += int64(sourceSize)
+}
+
+// source converts offset to the offset on the code in its original encoding.
+func (m *offsetMap) source(offset int64) int64 {
+	if offset >= m.offset {
+		return m.sourceOffset + offset - m.offset
+	}
+	i, found := slices.BinarySearchFunc(m.runs, offset, func(r offsetRun, offset int64) int {
+		return cmp.Compare(r.offset, offset)
+	})
+	if !found {
+		i--
+	}
+	if i < 0 {
+		return offset
+	}
+	r := m.runs[i]
+	return r.sourceOffset + (offset-r.offset)/r.size*r.sourceSize
+}
+
+// transcoderBufferSize is the size of the buffer of the bytes read by a transcoder.
+const transcoderBufferSize = 4 * 1024
+
+// transcoder is an io.Reader that reads the code from src and transcodes it to UTF-8. If the lexer has no encoding
+// set, it detects the encoding from the byte order mark. UTF-8 code is read directly from src.
+type transcoder struct {
+	src io.Reader
+	l   *Lexer
+	// in is the buffer of the bytes read from src, of which the first n are not yet transcoded.
+	in []byte
+	n  int
+	// out is the transcoded code not yet read, and outBuf is the buffer where the code is transcoded.
+	out, outBuf []byte
+	// detected reports whether the encoding is known.
+	detected bool
+	// err is the error returned by src, including io.EOF.
+	err error
+}
+
+// newTranscoder creates a transcoder that reads from src the code of l.
+func newTranscoder(src io.Reader, l *Lexer) *transcoder {
+	t := &transcoder{
+		src: src,
+		l:   l,
+	}
+	if l.encodingSet {
+		t.detect(nil)
+		t.buffer(nil)
+	}
+	return t
+}
+
+// Read implements io.Reader.
+func (t *transcoder) Read(p []byte) (int, error) {
+	if !t.detected {
+		n, err := t.src.Read(p)
+		if n == 0 && err == nil {
+			return 0, nil
+		}
+		code := p[:n]
+		if n == 1 && err == nil && (p[0] == 0xFF || p[0] == 0xFE) {
+			// the byte order mark may be incomplete.
+			t.buffer(code)
+			for empty := 0; t.n < 2 && err == nil; {
+				var m int
+				m, err = t.src.Read(t.in[t.n:2])
+				t.n += m
+				if m > 0 {
+					empty = 0
+				} else if empty++; empty == maxConsecutiveEmptyReads {
+					err = io.ErrNoProgress
+				}
+			}
+			n, code = 0, t.in[:t.n]
+		}
+		t.detect(code)
+		if t.l.encoding == EncodingUTF8 && n > 0 {
+			return n, err
+		}
+		if n > 0 {
+			t.buffer(code)
+		}
+		t.err = err
+	}
+
+	if t.l.encoding == EncodingUTF8 {
+		if t.n > 0 {
+			m := copy(p, t.in[:t.n])
+			t.n = copy(t.in, t.in[m:t.n])
+			return m, nil
+		}
+		if t.err != nil {
+			return 0, t.err
+		}
+		return t.src.Read(p)
+	}
+
+	empty := 0
+	for len(t.out) == 0 {
+		atEOF := t.err != nil
+		if !atEOF {
+			m, err := t.src.Read(t.in[t.n:])
+			t.n += m
+			if m > 0 || err != nil {
+				empty = 0
+			} else if empty++; empty == maxConsecutiveEmptyReads {
+				err = io.ErrNoProgress
+			}
+			if err != nil {
+				t.err = err
+				atEOF = true
+			}
+		}
+		var m int
+		t.out, m = decodeUTF16(t.outBuf[:0], t.in[:t.n], t.l.order, t.l.offsets, atEOF)
+		t.outBuf = t.out[:0]
+		t.n = copy(t.in, t.in[m:t.n])
+		if len(t.out) == 0 && atEOF {
+			return 0, t.err
+		}
+	}
+	m := copy(p, t.out)
+	t.out = t.out[m:]
+	return m, nil
+}
+
+// buffer allocates the buffer of the bytes read from src, if needed, and puts code in it.
+func (t *transcoder) buffer(code []byte) {
+	if t.in == nil {
+		t.in = make([]byte, max(transcoderBufferSize, len(code)))
+	}
+	t.n = copy(t.in, code)
+}
+
+// detect detects the encoding from the first bytes of the code, if the lexer has no encoding set.
+func (t *transcoder) detect(code []byte) {
+	t.detected = true
+	if !t.l.encodingSet {
+		t.l.encoding = detectEncoding(code)
+	}
+	if t.l.encoding != EncodingUTF8 {
+		t.l.order = t.l.encoding.byteOrder()
+		t.l.offsets = new(offsetMap)
+	}
+}
@@ -0,0 +1,201 @@
+package lexer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf16"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// utf16Code encodes code in UTF-16 with the byte order of e, appending the units after it.
+func utf16Code(e Encoding, code string, units ...uint16) []byte {
+	var b []byte
+	for _, u := range append(utf16.Encode([]rune(code)), units...) {
+		b = e.byteOrder().AppendUint16(b, u)
+	}
+	return b
+}
+
+// scanAll scans all the tokens of l, except the EOF.
+func scanAll(l *Lexer) (tokens []*token.Token) {
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		tokens = append(tokens, tok)
+	}
+	return
+}
+
+// TestEncoding tests the lexing of UTF-16 code.
+func TestEncoding(t *testing.T) {
+	cases := []struct {
+		code     []byte
+		opts     []Option
+		encoding Encoding
+		tokens   string
+	}{
+		{
+			code:     utf16Code(EncodingUTF16LE, "\uFEFFSELECT ação"),
+			encoding: EncodingUTF16LE,
+			tokens:   `<"\uFEFF", ByteOrderMark> <"SELECT", Select> <" ", WhiteSpace> <"ação", Identifier>`,
+		}, {
+			code:     utf16Code(EncodingUTF16BE, "\uFEFFSELECT 😀"),
+			encoding: EncodingUTF16BE,
+			tokens:   `<"\uFEFF", ByteOrderMark> <"SELECT", Select> <" ", WhiteSpace> <"😀", Identifier>`,
+		}, {
+			code:     utf16Code(EncodingUTF16BE, "SELECT 1"),
+			opts:     []Option{WithEncoding(EncodingUTF16BE)},
+			encoding: EncodingUTF16BE,
+			tokens:   `<"SELECT", Select> <" ", WhiteSpace> <"1", Numeric>`,
+		}, {
+			// like SQLite, an unpaired surrogate is transcoded as if it were a character.
+			code:     utf16Code(EncodingUTF16LE, "\uFEFFa", 0xD800, ' ', 0xDFFF),
+			encoding: EncodingUTF16LE,
+			tokens:   `<"\uFEFF", ByteOrderMark> <"a\xED\xA0\x80", Identifier> <" ", WhiteSpace> <"\xED\xBF\xBF", Identifier>`,
+		}, {
+			code:     append(utf16Code(EncodingUTF16LE, "\uFEFFa "), 'b'),
+			encoding: EncodingUTF16LE,
+			tokens:   `<"\uFEFF", ByteOrderMark> <"a", Identifier> <" ", WhiteSpace> <"\xFF", Identifier>`,
+		}, {
+			code:     utf16Code(EncodingUTF16LE, "\uFEFF'", 0xD800),
+			opts:     []Option{WithStrictUTF8()},
+			encoding: EncodingUTF16LE,
+			tokens:   `<"\uFEFF", ByteOrderMark> <"'\xED\xA0\x80", ErrorInvalidUTF8>`,
+		}, {
+			code:     []byte("\xFF"),
+			encoding: EncodingUTF8,
+			tokens:   `<"\xFF", Identifier>`,
+		},
+	}
+
+	for i, c := range cases {
+		expected := parseTokens(c.tokens)
+		lexers := []*Lexer{New(c.code, c.opts...), NewReader(iotest.OneByteReader(bytes.NewReader(c.code)), 1, c.opts...)}
+		for j, l := range lexers {
+			scanned := scanAll(l)
+			if l.Encoding() != c.encoding {
+				t.Errorf("case %d, lexer %d: want encoding %s, got %s", i, j, c.encoding, l.Encoding())
+			}
+			if !equalTokens(expected, scanned) {
+				t.Errorf("case %d, lexer %d: tokens differ", i, j)
+				var b strings.Builder
+				printTokens(&b, expected, scanned)
+				t.Log(b.String())
+			}
+		}
+	}
+}
+
+// equalTokens reports whether the tokens have the same kinds and lexemes.
+func equalTokens(a, b []*token.Token) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Kind != b[i].Kind || !bytes.Equal(a[i].Lexeme, b[i].Lexeme) {
+			return false
+		}
+	}
+	return true
+}
+
+// TestSourceLexemes tests the lexemes in the encoding of the code and the conversion of the offsets.
+func TestSourceLexemes(t *testing.T) {
+	code := utf16Code(EncodingUTF16LE, "\uFEFFINSERT INTO tábua VALUES ('😀😀', x'CAFE'); -- ação\n", 0xDC00)
+	opts := []Option{WithSourceLexemes()}
+	lexers := []*Lexer{New(code, opts...), NewReader(iotest.OneByteReader(bytes.NewReader(code)), 1, opts...)}
+	for i, l := range lexers {
+		var b bytes.Buffer
+		for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+			b.Write(tok.Lexeme)
+			if got := l.SourceOffset(l.Offset()); got != int64(b.Len()) {
+				t.Errorf("lexer %d: after %s want offset %d, got %d", i, tok, b.Len(), got)
+			}
+		}
+		if !bytes.Equal(b.Bytes(), code) {
+			t.Errorf("lexer %d: the lexemes differ from the code", i)
+		}
+	}
+
+	// an offset in the middle of a character.
+	l := New(utf16Code(EncodingUTF16BE, "a😀b"), WithEncoding(EncodingUTF16BE))
+	scanAll(l)
+	for offset, want := range []int64{0, 2, 2, 2, 2, 6, 8} {
+		if got := l.SourceOffset(int64(offset)); got != want {
+			t.Errorf("SourceOffset(%d): want %d, got %d", offset, want, got)
+		}
+	}
+
+	// UTF-8 code.
+	l = New([]byte("a b"), opts...)
+	if tok := l.Next(); string(tok.Lexeme) != "a" || l.SourceOffset(l.Offset()) != 1 {
+		t.Errorf("UTF-8: got %s at offset %d", tok, l.SourceOffset(l.Offset()))
+	}
+}
+
+// TestEncodingString tests the string representation of the encodings.
+func TestEncodingString(t *testing.T) {
+	for e, want := range map[Encoding]string{
+		EncodingUTF8: "UTF-8", EncodingUTF16LE: "UTF-16LE", EncodingUTF16BE: "UTF-16BE", Encoding(-1): "-1",
+	} {
+		if e.String() != want {
+			t.Errorf("want %q, got %q", want, e.String())
+		}
+	}
+}
+
+// TestTranscoderError tests that an error of the io.Reader of UTF-16 code ends the code and is returned by Err.
+func TestTranscoderError(t *testing.T) {
+	code := utf16Code(EncodingUTF16LE, "\uFEFFSELECT 1")
+	l := NewReader(iotest.TimeoutReader(bytes.NewReader(code)), 4)
+	var lexemes []string
+	for _, tok := range scanAll(l) {
+		lexemes = append(lexemes, string(tok.Lexeme))
+	}
+	if strings.Join(lexemes, "|") != "\uFEFF|S" {
+		t.Errorf("got %q", lexemes)
+	}
+	if l.Err() != iotest.ErrTimeout {
+		t.Errorf("want %s, got %v", iotest.ErrTimeout, l.Err())
+	}
+}
+
+// TestTranscoderNoProgress tests that an io.Reader that returns no bytes and no error many times in a row ends the
+// code while the encoding is detected and while UTF-16 code is transcoded.
+func TestTranscoderNoProgress(t *testing.T) {
+	cases := []struct {
+		name    string
+		r       io.Reader
+		opts    []Option
+		lexemes string
+	}{
+		{
+			name: "always empty",
+			r:    &emptyReader{bytes.NewReader(nil)},
+			opts: []Option{WithEncoding(EncodingUTF16LE)},
+		}, {
+			name:    "incomplete byte order mark",
+			r:       &emptyReader{bytes.NewReader([]byte{0xFF})},
+			lexemes: "\xFF",
+		}, {
+			name:    "UTF-16",
+			r:       &emptyReader{bytes.NewReader(utf16Code(EncodingUTF16LE, "\uFEFFSELECT 1"))},
+			lexemes: "\uFEFF|SELECT| |1",
+		},
+	}
+	for _, c := range cases {
+		l := NewReader(c.r, 4, c.opts...)
+		var lexemes []string
+		for _, tok := range scanAll(l) {
+			lexemes = append(lexemes, string(tok.Lexeme))
+		}
+		if got := strings.Join(lexemes, "|"); got != c.lexemes {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.lexemes)
+		}
+		if l.Err() != io.ErrNoProgress {
+			t.Errorf("%s: want %s, got %v", c.name, io.ErrNoProgress, l.Err())
+		}
+	}
+}
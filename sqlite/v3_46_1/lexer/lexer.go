@@ -2,9 +2,10 @@
 package lexer
 
 import (
-	"errors"
+	"bytes"
+	"io"
+	"slices"
 	"strings"
-	"unicode"
 	"unicode/utf8"
 
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
@@ -164,27 +165,166 @@ var keywords = map[string]token.Kind{
 	"WITHOUT":           token.KindWithout,
 }
 
-// Lexer is a lexical scanner
+// maxKeywordLength is the length of the longest keyword, CURRENT_TIMESTAMP.
+const maxKeywordLength = 17
+
+// LookupKeyword returns the kind of the keyword word, ignoring the case of the ASCII letters. It doesn't allocate.
+func LookupKeyword(word []byte) (kind token.Kind, isKeyword bool) {
+	if len(word) > maxKeywordLength {
+		return nil, false
+	}
+	var upper [maxKeywordLength]byte
+	for i, b := range word {
+		if b >= 'a' && b <= 'z' {
+			b -= 'a' - 'A'
+		} else if b >= utf8.RuneSelf {
+			return nil, false
+		}
+		upper[i] = b
+	}
+	// the compiler doesn't allocate the string used only as a map key.
+	kind, isKeyword = keywords[string(upper[:len(word)])]
+	return
+}
+
+// Lexer is a lexical scanner. Like SQLite, it treats every byte that isn't ASCII as a character of an identifier and
+// accepts invalid UTF-8 in the tokens, unless it is created with WithStrictUTF8. A UTF-8 byte order mark at the start
+// of the code is scanned as a token of kind token.KindByteOrderMark.
+//
+// The code can be encoded in UTF-8, UTF-16LE or UTF-16BE. The UTF-16 encodings are detected from the byte order mark,
+// or set by WithEncoding. The UTF-16 code is transcoded to UTF-8 before scanning, so the lexemes and the offsets are
+// of the transcoded code, unless the lexer is created with WithSourceLexemes. SourceOffset converts the offsets.
 type Lexer struct {
 	// r is the reader that the lexer uses for reading the runes from the code.
 	r *reader
+	// strictUTF8 reports whether the tokens with invalid UTF-8 are errors.
+	strictUTF8 bool
+	// encoding is the encoding of the code, and encodingSet reports whether it was set by WithEncoding.
+	encoding    Encoding
+	encodingSet bool
+	// order is the byte order of the UTF-16 encodings.
+	order byteOrder
+	// sourceLexemes reports whether the lexemes are in the encoding of the code.
+	sourceLexemes bool
+	// source is the code in its original encoding, if it is UTF-16 and the Lexer was created by New.
+	source []byte
+	// offsets maps the offsets on the transcoded code to the offsets on the code. It is nil if the code is UTF-8.
+	offsets *offsetMap
+}
+
+// Option is an option of a Lexer.
+type Option func(*Lexer)
+
+// WithStrictUTF8 makes the lexer scan a token that contains invalid UTF-8 as a token of kind
+// token.KindErrorInvalidUTF8. The extent of the token is the same as without this option.
+func WithStrictUTF8() Option {
+	return func(l *Lexer) {
+		l.strictUTF8 = true
+	}
+}
+
+// New creates a new Lexer that reads from code. The lexemes of the tokens are subslices of code, or of its transcoding
+// to UTF-8 if code is UTF-16 and the lexer isn't created with WithSourceLexemes.
+func New(code []byte, opts ...Option) *Lexer {
+	l := newLexer(opts)
+	if !l.encodingSet {
+		l.encoding = detectEncoding(code)
+	}
+	if l.encoding != EncodingUTF8 {
+		l.order = l.encoding.byteOrder()
+		l.offsets = new(offsetMap)
+		l.source = code
+		code, _ = decodeUTF16(make([]byte, 0, len(code)+len(code)/2), code, l.order, l.offsets, true)
+	}
+	l.r = newReader(code)
+	return l
+}
+
+// newLexer creates a new Lexer without reader and applies opts.
+func newLexer(opts []Option) *Lexer {
+	l := new(Lexer)
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// DefaultBufferSize is the size of the buffer used by NewReader when the given size is not positive.
+const DefaultBufferSize = 64 * 1024
+
+// NewReader creates a new Lexer that reads the code from r. Only a buffer of bufSize bytes is kept in memory, so the
+// code can be larger than the memory. The buffer grows if a token is larger than it. If bufSize is not positive
+// DefaultBufferSize is used. The lexemes of the tokens are copied out of the buffer, so they remain valid after the
+// next call to Next.
+//
+// An error returned by r, except io.EOF, is treated as the end of the code. It can be retrieved by Err.
+//
+// If the code is UTF-16, the map of the offsets used by SourceOffset grows with the code, though it is small for most
+// of the code.
+func NewReader(r io.Reader, bufSize int, opts ...Option) *Lexer {
+	if bufSize <= 0 {
+		bufSize = DefaultBufferSize
+	}
+	l := newLexer(opts)
+	if !l.encodingSet || l.encoding != EncodingUTF8 {
+		r = newTranscoder(r, l)
+	}
+	l.r = newStreamReader(r, bufSize)
+	return l
 }
 
-// New creates a new Lexer that reads from code.
-func New(code []byte) *Lexer {
-	return &Lexer{r: newReader(code)}
+// Err returns the first error, except io.EOF, returned by the io.Reader given to NewReader. It is io.ErrNoProgress if
+// the io.Reader returned no bytes and no error many times in a row.
+func (l *Lexer) Err() error {
+	return l.r.err
 }
 
 // Next returns the next token.
 func (l *Lexer) Next() *token.Token {
+	lexeme, kind := l.scan()
+	return token.New(lexeme, kind)
+}
+
+// NextInto stores the next token in tok. Unlike Next, it doesn't allocate a token, so tok can be reused for every
+// token. If the Lexer was created by New the lexeme is a subslice of the code and NextInto doesn't allocate at all.
+func (l *Lexer) NextInto(tok *token.Token) {
+	tok.Lexeme, tok.Kind = l.scan()
+}
+
+// scan scans the next token.
+func (l *Lexer) scan() ([]byte, token.Kind) {
+	offsetStart := l.r.getOffset()
+	lexeme, kind := l.scanToken()
+	if l.strictUTF8 && kind != token.KindEOF && !utf8.Valid(lexeme) {
+		kind = token.KindErrorInvalidUTF8
+	}
+	if l.sourceLexemes && l.offsets != nil && kind != token.KindEOF {
+		lexeme = l.sourceLexeme(offsetStart, lexeme)
+	}
+	return lexeme, kind
+}
+
+// sourceLexeme returns lexeme, that starts at offsetStart on the transcoded code, in the encoding of the code.
+func (l *Lexer) sourceLexeme(offsetStart int64, lexeme []byte) []byte {
+	if l.source != nil {
+		return l.source[l.offsets.source(offsetStart):l.offsets.source(offsetStart+int64(len(lexeme)))]
+	}
+	return encodeUTF16(nil, lexeme, l.order)
+}
+
+// scanToken scans the next token without checking the UTF-8 encoding.
+func (l *Lexer) scanToken() ([]byte, token.Kind) {
+	l.r.mark()
 	rs, _ := l.r.peekNRunes(2)
 	if len(rs) == 0 {
-		return token.New(nil, token.KindEOF)
+		return nil, token.KindEOF
 	}
 
-	if len(rs) == 2 && (rs[0] == 'x' || rs[0] == 'X') && rs[1] == '\'' {
+	if rs[0] == byteOrderMark && l.r.getOffset() == 0 {
+		return l.byteOrderMark()
+	} else if len(rs) == 2 && (rs[0] == 'x' || rs[0] == 'X') && rs[1] == '\'' {
 		return l.blob()
-	} else if unicode.IsLetter(rs[0]) || strings.ContainsRune("_`\"[", rs[0]) {
+	} else if l.isAlphabetic(rs[0]) || strings.ContainsRune("`\"[", rs[0]) {
 		return l.word()
 	} else if rs[0] == '\'' {
 		return l.string()
@@ -214,7 +354,7 @@ func (l *Lexer) Next() *token.Token {
 }
 
 // blob scans a blob.
-func (l *Lexer) blob() *token.Token {
+func (l *Lexer) blob() ([]byte, token.Kind) {
 	var (
 		eof bool
 		r   rune
@@ -227,18 +367,18 @@ func (l *Lexer) blob() *token.Token {
 			break
 		}
 		if !l.isHexadecimal(r) {
-			return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorBlobNotHexadecimal)
+			return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorBlobNotHexadecimal
 		}
 	}
 	lexeme := l.r.slice(offsetStart, l.r.getOffset())
 	if eof {
-		return token.New(lexeme, token.KindErrorUnexpectedEOF)
+		return lexeme, token.KindErrorUnexpectedEOF
 	}
-	return token.New(lexeme, token.KindBlob)
+	return lexeme, token.KindBlob
 }
 
 // word scans a keyword or an identifier.
-func (l *Lexer) word() *token.Token {
+func (l *Lexer) word() ([]byte, token.Kind) {
 	offsetStart := l.r.getOffset()
 	r, _ := l.r.readRune()
 	if l.isAlphabetic(r) {
@@ -252,10 +392,10 @@ func (l *Lexer) word() *token.Token {
 			l.r.unreadRune()
 		}
 		lexeme := l.r.slice(offsetStart, l.r.getOffset())
-		if kind, isKeyword := keywords[strings.ToUpper(string(lexeme))]; isKeyword {
-			return token.New(lexeme, kind)
+		if kind, isKeyword := LookupKeyword(lexeme); isKeyword {
+			return lexeme, kind
 		}
-		return token.New(lexeme, token.KindIdentifier)
+		return lexeme, token.KindIdentifier
 	} else if r == '"' {
 		var eof bool
 		for r, eof = l.r.readRune(); !eof; r, eof = l.r.readRune() {
@@ -268,9 +408,9 @@ func (l *Lexer) word() *token.Token {
 		}
 		lexeme := l.r.slice(offsetStart, l.r.getOffset())
 		if eof {
-			return token.New(lexeme, token.KindErrorUnexpectedEOF)
+			return lexeme, token.KindErrorUnexpectedEOF
 		}
-		return token.New(lexeme, token.KindIdentifier)
+		return lexeme, token.KindIdentifier
 	} else if r == '[' {
 		var eof bool
 		for r, eof = l.r.readRune(); !eof; r, eof = l.r.readRune() {
@@ -280,9 +420,9 @@ func (l *Lexer) word() *token.Token {
 		}
 		lexeme := l.r.slice(offsetStart, l.r.getOffset())
 		if eof {
-			return token.New(lexeme, token.KindErrorUnexpectedEOF)
+			return lexeme, token.KindErrorUnexpectedEOF
 		}
-		return token.New(lexeme, token.KindIdentifier)
+		return lexeme, token.KindIdentifier
 	} else { // r == '`'
 		var eof bool
 		for r, eof = l.r.readRune(); !eof; r, eof = l.r.readRune() {
@@ -295,14 +435,14 @@ func (l *Lexer) word() *token.Token {
 		}
 		lexeme := l.r.slice(offsetStart, l.r.getOffset())
 		if eof {
-			return token.New(lexeme, token.KindErrorUnexpectedEOF)
+			return lexeme, token.KindErrorUnexpectedEOF
 		}
-		return token.New(lexeme, token.KindIdentifier)
+		return lexeme, token.KindIdentifier
 	}
 }
 
 // string scans a string.
-func (l *Lexer) string() *token.Token {
+func (l *Lexer) string() ([]byte, token.Kind) {
 	var (
 		eof bool
 		r   rune
@@ -319,18 +459,18 @@ func (l *Lexer) string() *token.Token {
 	}
 	lexeme := l.r.slice(offsetStart, l.r.getOffset())
 	if eof {
-		return token.New(lexeme, token.KindErrorUnexpectedEOF)
+		return lexeme, token.KindErrorUnexpectedEOF
 	}
-	return token.New(lexeme, token.KindString)
+	return lexeme, token.KindString
 }
 
 // numeric scans a numeric literal.
-func (l *Lexer) numeric() *token.Token {
+func (l *Lexer) numeric() ([]byte, token.Kind) {
 	offsetStart := l.r.getOffset()
 	rs, _ := l.r.peekNRunes(2)
-	if (l.isNumeric(rs[0]) && rs[0] != '0') || (l.isNumeric(rs[0]) && rs[1] != 'x' && rs[1] != 'X') {
+	if (l.isNumeric(rs[0]) && rs[0] != '0') || (l.isNumeric(rs[0]) && (len(rs) < 2 || rs[1] != 'x' && rs[1] != 'X')) {
 		if l.numericDigits() {
-			return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+			return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 		}
 
 		r, _ := l.r.peekRune()
@@ -338,28 +478,28 @@ func (l *Lexer) numeric() *token.Token {
 			l.r.readRune()
 			r, eof := l.r.peekRune()
 			if eof || !l.isNumeric(r) {
-				return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+				return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 			}
 			if l.numericDigits() {
-				return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+				return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 			}
 		}
 
 		l.numericExponentialPart()
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 	} else if rs[0] == '.' {
 		l.r.readRune()
 		if l.numericDigits() {
-			return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+			return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 		}
 
 		l.numericExponentialPart()
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 	} else { // len(rs) == 2 && rs[0] == '0' && (rs[1] == 'x' || rs[1] == 'X') {
 		l.r.readRune()
 		l.r.readRune()
 		l.numericHexDigits()
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindNumeric
 	}
 }
 
@@ -438,7 +578,7 @@ func (l *Lexer) isStartOfNumericExponentialPart() (is bool) {
 }
 
 // sqlComment scans a SQL-style comment.
-func (l *Lexer) sqlComment() *token.Token {
+func (l *Lexer) sqlComment() ([]byte, token.Kind) {
 	offsetStart := l.r.getOffset()
 	l.r.readRune()
 	l.r.readRune()
@@ -448,11 +588,11 @@ func (l *Lexer) sqlComment() *token.Token {
 			break
 		}
 	}
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindSQLComment)
+	return l.r.slice(offsetStart, l.r.getOffset()), token.KindSQLComment
 }
 
 // cComment scans a C-style comment.
-func (l *Lexer) cComment() *token.Token {
+func (l *Lexer) cComment() ([]byte, token.Kind) {
 	offsetStart := l.r.getOffset()
 	l.r.readRune()
 	l.r.readRune()
@@ -470,11 +610,11 @@ func (l *Lexer) cComment() *token.Token {
 			break
 		}
 	}
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindCComment)
+	return l.r.slice(offsetStart, l.r.getOffset()), token.KindCComment
 }
 
 // questionVariable scans a question variable.
-func (l *Lexer) questionVariable() *token.Token {
+func (l *Lexer) questionVariable() ([]byte, token.Kind) {
 	var r rune
 	var eof bool
 	offsetStart := l.r.getOffset()
@@ -487,58 +627,58 @@ func (l *Lexer) questionVariable() *token.Token {
 	if !eof {
 		l.r.unreadRune()
 	}
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindQuestionVariable)
+	return l.r.slice(offsetStart, l.r.getOffset()), token.KindQuestionVariable
 }
 
 // colonVariable scans a colon variable.
-func (l *Lexer) colonVariable() *token.Token {
+func (l *Lexer) colonVariable() ([]byte, token.Kind) {
 	var r rune
 	var eof bool
 	offsetStart := l.r.getOffset()
 	l.r.readRune()
 	if r, eof = l.r.peekRune(); eof {
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF
 	} else if !l.isAlphanumeric(r) && r != '$' {
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter
 	}
 
 	kind := l.parameterName(token.KindColonVariable)
 
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), kind)
+	return l.r.slice(offsetStart, l.r.getOffset()), kind
 }
 
 // atVariable scans an at variable.
-func (l *Lexer) atVariable() *token.Token {
+func (l *Lexer) atVariable() ([]byte, token.Kind) {
 	var r rune
 	var eof bool
 	offsetStart := l.r.getOffset()
 	l.r.readRune()
 	if r, eof = l.r.peekRune(); eof {
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF
 	} else if !l.isAlphanumeric(r) && r != '$' {
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter
 	}
 
 	kind := l.parameterName(token.KindAtVariable)
 
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), kind)
+	return l.r.slice(offsetStart, l.r.getOffset()), kind
 }
 
 // dollarVariable scans a dollar variable.
-func (l *Lexer) dollarVariable() *token.Token {
+func (l *Lexer) dollarVariable() ([]byte, token.Kind) {
 	var r rune
 	var eof bool
 	offsetStart := l.r.getOffset()
 	l.r.readRune()
 	if r, eof = l.r.peekRune(); eof {
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF
 	} else if !l.isAlphanumeric(r) && r != '$' {
-		return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter)
+		return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter
 	}
 
 	kind := l.parameterName(token.KindDollarVariable)
 
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), kind)
+	return l.r.slice(offsetStart, l.r.getOffset()), kind
 }
 
 func (l *Lexer) parameterName(k token.Kind) token.Kind {
@@ -572,7 +712,7 @@ func (l *Lexer) parameterName(k token.Kind) token.Kind {
 }
 
 // operator scans an operator.
-func (l *Lexer) operator() *token.Token {
+func (l *Lexer) operator() ([]byte, token.Kind) {
 	offsetStart := l.r.getOffset()
 	r, _ := l.r.readRune()
 	rs, _ := l.r.peekNRunes(2)
@@ -649,13 +789,13 @@ func (l *Lexer) operator() *token.Token {
 	case '!':
 		r, eof := l.r.readRune()
 		if eof {
-			return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF)
+			return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorUnexpectedEOF
 		}
 		if r == '=' {
 			kind = token.KindExclamationEqual
 		} else {
 			l.r.unreadRune()
-			return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter)
+			return l.r.slice(offsetStart, l.r.getOffset()), token.KindErrorInvalidCharacterAfter
 		}
 	default: // r == '|'
 		r, eof := l.r.peekRune()
@@ -667,11 +807,11 @@ func (l *Lexer) operator() *token.Token {
 		}
 	}
 	lexeme := l.r.slice(offsetStart, l.r.getOffset())
-	return token.New(lexeme, kind)
+	return lexeme, kind
 }
 
 // whiteSpace scans white spaces.
-func (l *Lexer) whiteSpace() *token.Token {
+func (l *Lexer) whiteSpace() ([]byte, token.Kind) {
 	var (
 		eof bool
 		r   rune
@@ -682,14 +822,24 @@ func (l *Lexer) whiteSpace() *token.Token {
 	if !eof {
 		l.r.unreadRune()
 	}
-	return token.New(l.r.slice(offsetStart, l.r.getOffset()), token.KindWhiteSpace)
+	return l.r.slice(offsetStart, l.r.getOffset()), token.KindWhiteSpace
+}
+
+// byteOrderMark is the rune of the byte order mark.
+const byteOrderMark = '\uFEFF'
+
+// byteOrderMark scans a byte order mark.
+func (l *Lexer) byteOrderMark() ([]byte, token.Kind) {
+	startOffset := l.r.getOffset()
+	l.r.readRune()
+	return l.r.slice(startOffset, l.r.getOffset()), token.KindByteOrderMark
 }
 
 // invalidCharacter scans an invalid character.
-func (l *Lexer) invalidCharacter() *token.Token {
+func (l *Lexer) invalidCharacter() ([]byte, token.Kind) {
 	startOffset := l.r.getOffset()
 	l.r.readRune()
-	return token.New(l.r.slice(startOffset, l.r.getOffset()), token.KindErrorInvalidCharacter)
+	return l.r.slice(startOffset, l.r.getOffset()), token.KindErrorInvalidCharacter
 }
 
 // isWhiteSpace reports whether the rune is white space (with respect to the SQLite SQL dialect).
@@ -699,6 +849,13 @@ func (l *Lexer) isWhiteSpace(r rune) bool {
 
 // isAlphabetic reports whether the rune is alphabetic (with respect to the SQLite SQL dialect).
 func (l *Lexer) isAlphabetic(r rune) bool {
+	return IsAlphabetic(r)
+}
+
+// IsAlphabetic reports whether the rune is alphabetic (with respect to the SQLite SQL dialect), that is, whether it may
+// start a bare identifier. Like SQLite, every rune that isn't ASCII is alphabetic, including utf8.RuneError, that the
+// reader returns for invalid UTF-8.
+func IsAlphabetic(r rune) bool {
 	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r > 0x7F
 }
 
@@ -717,12 +874,25 @@ func (l *Lexer) isHexadecimal(r rune) bool {
 	return l.isNumeric(r) || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
 }
 
-// reader reads from the code.
+// reader reads from the code. The code can be entirely in memory or be read from an io.Reader. In the later case
+// only a part of the code is kept in memory.
 type reader struct {
-	// code is the code to be read.
+	// code is the code to be read. If src is not nil it is the part of the code that is buffered.
 	code []byte
 	// offset is the current offset on code.
 	offset int64
+	// src is where the code is read from. It is nil if the code is entirely in memory.
+	src io.Reader
+	// base is the offset on the code read from src of code[0].
+	base int64
+	// start is the offset on code of the start of the current token. The bytes before start can be discarded.
+	start int64
+	// srcEOF reports whether src has no more bytes.
+	srcEOF bool
+	// err is the first error returned by src, except io.EOF.
+	err error
+	// peeked is the buffer of the runes returned by peekNRunes.
+	peeked [3]rune
 }
 
 // newReader creates a new reader that reads from code.
@@ -732,16 +902,72 @@ func newReader(code []byte) *reader {
 	}
 }
 
-// readRune reads the next rune from the code. It panics on error.
-func (r *reader) readRune() (rn rune, eof bool) {
-	rn, size := utf8.DecodeRune(r.code[r.offset:])
-	if rn == utf8.RuneError {
-		if size == 0 {
-			return 0, true
-		} else {
-			panic(errors.New("utf-8 encoding invalid"))
+// newStreamReader creates a new reader that reads from src using a buffer of bufSize bytes. The buffer grows if a
+// token is larger than it.
+func newStreamReader(src io.Reader, bufSize int) *reader {
+	return &reader{
+		code: make([]byte, 0, bufSize),
+		src:  src,
+	}
+}
+
+// mark marks the current offset as the start of a token. The bytes before it will not be read again.
+func (r *reader) mark() {
+	r.start = r.offset
+}
+
+// maxConsecutiveEmptyReads is the number of reads in a row that return no bytes and no error after which fill gives up
+// with io.ErrNoProgress, like bufio does.
+const maxConsecutiveEmptyReads = 100
+
+// fill reads from src until there are n bytes after the current offset, or src has no more bytes. It does nothing if
+// the code is entirely in memory.
+func (r *reader) fill(n int) {
+	empty := 0
+	for r.src != nil && !r.srcEOF && int64(len(r.code))-r.offset < int64(n) {
+		if len(r.code) == cap(r.code) {
+			if r.start > 0 {
+				// discards the bytes before the current token.
+				m := copy(r.code, r.code[r.start:])
+				r.code = r.code[:m]
+				r.base += r.start
+				r.offset -= r.start
+				r.start = 0
+			} else {
+				r.code = slices.Grow(r.code, max(cap(r.code), utf8.UTFMax))
+			}
+		}
+		m, err := r.src.Read(r.code[len(r.code):cap(r.code)])
+		r.code = r.code[:len(r.code)+m]
+		if m > 0 || err != nil {
+			empty = 0
+		} else if empty++; empty == maxConsecutiveEmptyReads {
+			err = io.ErrNoProgress
+		}
+		if err == io.EOF {
+			r.srcEOF = true
+		} else if err != nil {
+			r.srcEOF = true
+			r.err = err
 		}
 	}
+}
+
+// decode decodes the rune that starts i bytes after the current offset.
+func (r *reader) decode(i int64) (rn rune, size int) {
+	if j := r.offset + i; j < int64(len(r.code)) && r.code[j] < utf8.RuneSelf {
+		return rune(r.code[j]), 1
+	}
+	r.fill(int(i) + utf8.UTFMax)
+	return utf8.DecodeRune(r.code[r.offset+i:])
+}
+
+// readRune reads the next rune from the code. An invalid UTF-8 byte is read as utf8.RuneError.
+func (r *reader) readRune() (rn rune, eof bool) {
+	rn, size := r.decode(0)
+	if size == 0 {
+		return 0, true
+	}
 	r.offset += int64(size)
 	return
 }
@@ -749,58 +975,52 @@ func (r *reader) readRune() (rn rune, eof bool) {
 // peekRune returns the next rune but dont advances the lexer, this means that if readRune is called it will return the same rune.
 // Similarly for the EOF.
 func (r *reader) peekRune() (rn rune, eof bool) {
-	rn, size := utf8.DecodeRune(r.code[r.offset:])
-	if rn == utf8.RuneError {
-		if size == 0 {
-			return 0, true
-		} else {
-			panic(errors.New("utf-8 encoding invalid"))
-		}
+	rn, size := r.decode(0)
+	if size == 0 {
+		return 0, true
 	}
 	return
 }
 
 // peekNRunes returns the next n runes but dont advances the lexer. It can returns less than n runes if EOF is found before
-// n runes are read.
+// n runes are read. n must not be greater than len(r.peeked). The result is valid until the next call.
 func (r *reader) peekNRunes(n int) (rs []rune, eof bool) {
-	offset := r.getOffset()
-	for range n {
-		rn, size := utf8.DecodeRune(r.code[offset:])
-		if rn == utf8.RuneError {
-			if size == 0 {
-				return rs, true
-			} else {
-				panic(errors.New("utf-8 encoding invalid"))
-			}
+	var i int64
+	for j := range n {
+		rn, size := r.decode(i)
+		if size == 0 {
+			return r.peeked[:j], true
 		}
-		rs = append(rs, rn)
-		offset += int64(size)
+		r.peeked[j] = rn
+		i += int64(size)
 	}
 
-	return
+	return r.peeked[:n], false
 }
 
 // unreadRune seek to the start of the rune before the current offset. If the current or resulting offset is at
-// the start of the code then onStart will be true.
+// the start of the code then onStart will be true. It must not seek to before the start of the current token. An
+// invalid UTF-8 byte is unread alone, as it was read by readRune.
 func (r *reader) unreadRune() (onStart bool) {
 	if r.offset == 0 {
 		return true
 	}
-	for i := r.offset - 1; i >= 0; i-- {
-		if utf8.RuneStart(r.code[i]) {
-			r.offset = i
-			return r.offset == 0
-		}
-	}
-	panic(errors.New("utf-8 encoding invalid"))
+	_, size := utf8.DecodeLastRune(r.code[r.start:r.offset])
+	r.offset -= int64(size)
+	return r.offset == 0
 }
 
 // getOffset returns the current offset.
 func (r *reader) getOffset() int64 {
-	return r.offset
+	return r.base + r.offset
 }
 
-// slice returns r.code[offsetStart:offsetEnd].
+// slice returns the code between the offsets offsetStart and offsetEnd, that must be offsets of the current token. If
+// the code is read from an io.Reader the result is a copy, since the buffer is reused.
 func (r *reader) slice(offsetStart, offsetEnd int64) []byte {
-	return r.code[offsetStart:offsetEnd]
+	b := r.code[offsetStart-r.base : offsetEnd-r.base]
+	if r.src != nil {
+		return bytes.Clone(b)
+	}
+	return b
 }
@@ -3,12 +3,17 @@ package lexer
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"text/tabwriter"
+	"unicode/utf8"
 
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
 )
@@ -41,6 +46,7 @@ func TestLexer(t *testing.T) {
 		{code: "x'CAR'", tokens: parseTokens("<\"x'CAR\", ErrorBlobNotHexadecimal> <\"'\", ErrorUnexpectedEOF> <EOF>")},
 		{code: "1", tokens: parseTokens(`<"1", Numeric> <EOF>`)},
 		{code: "1234", tokens: parseTokens(`<"1234", Numeric> <EOF>`)},
+		{code: "0", tokens: parseTokens(`<"0", Numeric> <EOF>`)},
 		{code: "1_2", tokens: parseTokens(`<"1_2", Numeric> <EOF>`)},
 		{code: "_1_2", tokens: parseTokens(`<"_1_2", Identifier> <EOF>`)},
 		{code: "1_2_", tokens: parseTokens(`<"1_2", Numeric> <"_", Identifier> <EOF>`)},
@@ -141,36 +147,158 @@ func TestLexer(t *testing.T) {
 		{code: ".", tokens: parseTokens(`<".", Dot> <EOF>`)},
 		{code: "\x00", tokens: parseTokens(`<"\x00", ErrorInvalidCharacter> <EOF>`)},
 		{code: "^", tokens: parseTokens(`<"^", ErrorInvalidCharacter> <EOF>`)},
+		// like SQLite, every byte that isn't ASCII is a character of an identifier.
+		{code: "😀a", tokens: parseTokens(`<"😀a", Identifier> <EOF>`)},
+		{code: "e\u0301 ", tokens: parseTokens(`<"e\u0301", Identifier> <" ", WhiteSpace> <EOF>`)},
+		{code: "\u00A0", tokens: parseTokens(`<"\u00A0", Identifier> <EOF>`)},
+		{code: "a\xFF\xE2\x82b+", tokens: parseTokens(`<"a\xFF\xE2\x82b", Identifier> <"+", Plus> <EOF>`)},
+		{code: "\xC0\x80", tokens: parseTokens(`<"\xC0\x80", Identifier> <EOF>`)},
+		{code: ":a\xFF", tokens: parseTokens(`<":a\xFF", ColonVariable> <EOF>`)},
+		{code: "'\xFF'", tokens: parseTokens(`<"'\xFF'", String> <EOF>`)},
+		{code: "\uFEFFSELECT", tokens: parseTokens(`<"\uFEFF", ByteOrderMark> <"SELECT", Select> <EOF>`)},
+		{code: "a\uFEFF", tokens: parseTokens(`<"a\uFEFF", Identifier> <EOF>`)},
 	}
 
 	for _, c := range cases {
-		l := New([]byte(c.code))
+		// the streaming lexer reads one byte at a time with the smallest buffer, the worst case for it.
+		lexers := []*Lexer{New([]byte(c.code)), NewReader(iotest.OneByteReader(strings.NewReader(c.code)), 1)}
+		for i, l := range lexers {
+			var scanned []*token.Token
+			for {
+				tok := l.Next()
+				scanned = append(scanned, tok)
+				if tok.Kind == token.KindEOF {
+					break
+				}
+			}
+
+			equals := slices.EqualFunc(c.tokens, scanned, func(a, b *token.Token) bool {
+				if a.Kind != b.Kind {
+					return false
+				}
+				return bytes.Equal(a.Lexeme, b.Lexeme)
+			})
+
+			if !equals {
+				t.Errorf("code=\"%s\", lexer %d: tokens differ", c.code, i)
+				var b strings.Builder
+				printTokens(&b, c.tokens, scanned)
+				t.Log(b.String())
+			}
+		}
+	}
+}
 
+// TestStrictUTF8 tests that the lexer created with WithStrictUTF8 scans the tokens with invalid UTF-8 as errors.
+func TestStrictUTF8(t *testing.T) {
+	code := "\uFEFFSELECT 'a\xFF', b\xC0\x80 FROM \"\xFF\" -- \xFF\n"
+	expected := parseTokens(`<"\uFEFF", ByteOrderMark> <"SELECT", Select> <" ", WhiteSpace> <"'a\xFF'", ErrorInvalidUTF8>` +
+		`<",", Comma> <" ", WhiteSpace> <"b\xC0\x80", ErrorInvalidUTF8> <" ", WhiteSpace> <"FROM", From> <" ", WhiteSpace>` +
+		`<"\"\xFF\"", ErrorInvalidUTF8> <" ", WhiteSpace> <"-- \xFF", ErrorInvalidUTF8> <"\n", WhiteSpace> <EOF>`)
+
+	lexers := []*Lexer{New([]byte(code), WithStrictUTF8()), NewReader(iotest.OneByteReader(strings.NewReader(code)), 1, WithStrictUTF8())}
+	for i, l := range lexers {
 		var scanned []*token.Token
-		for {
-			tok := l.Next()
+		for tok := l.Next(); ; tok = l.Next() {
 			scanned = append(scanned, tok)
 			if tok.Kind == token.KindEOF {
 				break
 			}
 		}
 
-		equals := slices.EqualFunc(c.tokens, scanned, func(a, b *token.Token) bool {
-			if a.Kind != b.Kind {
-				return false
-			}
-			return bytes.Equal(a.Lexeme, b.Lexeme)
+		equals := slices.EqualFunc(expected, scanned, func(a, b *token.Token) bool {
+			return a.Kind == b.Kind && bytes.Equal(a.Lexeme, b.Lexeme)
 		})
-
 		if !equals {
-			t.Errorf("code=\"%s\": tokens differ", c.code)
+			t.Errorf("lexer %d: tokens differ", i)
 			var b strings.Builder
-			printTokens(&b, c.tokens, scanned)
+			printTokens(&b, expected, scanned)
 			t.Log(b.String())
 		}
 	}
 }
 
+// TestNewReader tests that the streaming lexer keeps its buffer bounded and that the lexemes remain valid.
+func TestNewReader(t *testing.T) {
+	statement := "INSERT INTO table_a VALUES (1, 'ação', x'CAFE', 2.5e10); -- comment\n"
+	code := strings.Repeat(statement, 10000)
+	l := NewReader(strings.NewReader(code), 64)
+
+	var b bytes.Buffer
+	var toks []*token.Token
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		toks = append(toks, tok)
+	}
+	for _, tok := range toks {
+		b.Write(tok.Lexeme)
+	}
+
+	if b.String() != code {
+		t.Errorf("the lexemes differ from the code")
+	}
+	if cap(l.r.code) != 64 {
+		t.Errorf("the buffer grew to %d bytes", cap(l.r.code))
+	}
+	if l.Err() != nil {
+		t.Errorf("unexpected error: %s", l.Err())
+	}
+
+	// a token larger than the buffer.
+	long := "'" + strings.Repeat("a", 1000) + "'"
+	l = NewReader(strings.NewReader(long+" "+long), 16)
+	for _, want := range []string{long, " ", long, ""} {
+		if tok := l.Next(); string(tok.Lexeme) != want {
+			t.Errorf("want %q, got %q", want, tok.Lexeme)
+		}
+	}
+}
+
+// TestNewReaderError tests that an error of the io.Reader ends the code and is returned by Err.
+func TestNewReaderError(t *testing.T) {
+	l := NewReader(iotest.TimeoutReader(strings.NewReader("SELECT 1")), 4)
+	var lexemes []string
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		lexemes = append(lexemes, string(tok.Lexeme))
+	}
+
+	if !slices.Equal(lexemes, []string{"SELE"}) {
+		t.Errorf("got %q", lexemes)
+	}
+	if l.Err() != iotest.ErrTimeout {
+		t.Errorf("want %s, got %v", iotest.ErrTimeout, l.Err())
+	}
+}
+
+// emptyReader is an io.Reader that returns the bytes of r and then no bytes and no error forever.
+type emptyReader struct {
+	r io.Reader
+}
+
+// Read implements io.Reader.
+func (e *emptyReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	if err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+// TestNewReaderNoProgress tests that an io.Reader that returns no bytes and no error many times in a row ends the code.
+func TestNewReaderNoProgress(t *testing.T) {
+	l := NewReader(&emptyReader{strings.NewReader("SELECT 1")}, 4)
+	var lexemes []string
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		lexemes = append(lexemes, string(tok.Lexeme))
+	}
+
+	if !slices.Equal(lexemes, []string{"SELECT", " ", "1"}) {
+		t.Errorf("got %q", lexemes)
+	}
+	if l.Err() != io.ErrNoProgress {
+		t.Errorf("want %s, got %v", io.ErrNoProgress, l.Err())
+	}
+}
+
 // printTokens writes the tokens to b in tabular form.
 func printTokens(b *strings.Builder, expected, scanned []*token.Token) {
 	// we use a strings.Builder because it dont returns errors like the more general io.Writer.
@@ -190,58 +318,25 @@ func printTokens(b *strings.Builder, expected, scanned []*token.Token) {
 	}
 }
 
-// TestReaderReadPanic tests the case where the reader reads from a invalid UTF-8 encoded byte slice.
-func TestReaderReadPanic(t *testing.T) {
-	defer func() {
-		resultPanic := recover()
-		if resultPanic == nil {
-			t.Errorf("not panic")
-			return
-		}
-		err := resultPanic.(error)
-		if err.Error() != "utf-8 encoding invalid" {
-			t.Errorf("invalid error message: %s", err)
-		}
-	}()
-	data := []byte{0xFF, 0xFF, 0xFF, 0xFF}
-	r := newReader(data)
+// TestReaderInvalidUTF8 tests that the reader reads, peeks and unreads an invalid UTF-8 byte as utf8.RuneError.
+func TestReaderInvalidUTF8(t *testing.T) {
+	r := newReader([]byte{'a', 0xFF, 0xE2, 0x82, 'b'})
 	r.readRune()
-}
-
-// TestReaderPeekPanic tests the case where the reader peeks from a invalid UTF-8 encoded byte slice.
-func TestReaderPeekPanic(t *testing.T) {
-	defer func() {
-		resultPanic := recover()
-		if resultPanic == nil {
-			t.Errorf("not panic")
-			return
-		}
-		err := resultPanic.(error)
-		if err.Error() != "utf-8 encoding invalid" {
-			t.Errorf("invalid error message: %s", err)
-		}
-	}()
-	data := []byte{0xFF, 0xFF, 0xFF, 0xFF}
-	r := newReader(data)
-	r.peekRune()
-}
-
-// TestReaderPeekPanic tests the case where the reader peekNRunes reads from a invalid UTF-8 encoded byte slice.
-func TestReaderPeekNPanic(t *testing.T) {
-	defer func() {
-		resultPanic := recover()
-		if resultPanic == nil {
-			t.Errorf("not panic")
-			return
-		}
-		err := resultPanic.(error)
-		if err.Error() != "utf-8 encoding invalid" {
-			t.Errorf("invalid error message: %s", err)
+	if rs, _ := r.peekNRunes(3); !slices.Equal(rs, []rune{utf8.RuneError, utf8.RuneError, utf8.RuneError}) {
+		t.Errorf("peekNRunes: got %q", rs)
+	}
+	if rn, _ := r.peekRune(); rn != utf8.RuneError {
+		t.Errorf("peekRune: got %q", rn)
+	}
+	for range 2 {
+		if rn, eof := r.readRune(); rn != utf8.RuneError || eof {
+			t.Errorf("readRune: got %q, %t", rn, eof)
 		}
-	}()
-	data := []byte{0xFF, 0xFF, 0xFF, 0xFF}
-	r := newReader(data)
-	r.peekNRunes(1)
+	}
+	r.unreadRune()
+	if r.offset != 2 {
+		t.Errorf("unreadRune: offset %d", r.offset)
+	}
 }
 
 // TestReaderUnreadOnStart tests the case where the read is at the start and we try a unreadRune.
@@ -281,29 +376,9 @@ func TestReaderMoreThanOneUnread(t *testing.T) {
 	}
 }
 
-// TestReaderUnreadPanic tests the case where a unreadRune cannot find a valid start of rune.
-func TestReaderUnreadPanic(t *testing.T) {
-	defer func() {
-		resultPanic := recover()
-		if resultPanic == nil {
-			t.Errorf("not panic")
-			return
-		}
-		err := resultPanic.(error)
-		if err.Error() != "utf-8 encoding invalid" {
-			t.Errorf("invalid error message: %s", err)
-		}
-	}()
-	data := []byte{0x00, 0xFF, 0xFF, 0xFF}
-	r := newReader(data)
-	r.readRune()
-	data[0] = 0b10111111
-	r.unreadRune()
-}
-
 // parseTokens unmarshalls tokens from code.
 func parseTokens(code string) (result []*token.Token) {
-	re := regexp.MustCompile(`<(?:("(?:[^\\]|\\"|\\[^"])*?"),\s?)?([a-zA-Z]+)>`)
+	re := regexp.MustCompile(`<(?:("(?:[^\\]|\\"|\\[^"])*?"),\s?)?([a-zA-Z0-9]+)>`)
 	matches := re.FindAllStringSubmatch(code, -1)
 	for i := range matches {
 		kind, ok := kinds[matches[i][2]]
@@ -512,9 +587,117 @@ var kinds = map[string]token.Kind{
 	"PipePipe":                    token.KindPipePipe,
 	"Dot":                         token.KindDot,
 	"WhiteSpace":                  token.KindWhiteSpace,
+	"ByteOrderMark":               token.KindByteOrderMark,
 	"ErrorUnexpectedEOF":          token.KindErrorUnexpectedEOF,
 	"ErrorBlobNotHexadecimal":     token.KindErrorBlobNotHexadecimal,
 	"ErrorInvalidCharacter":       token.KindErrorInvalidCharacter,
 	"ErrorInvalidCharacterAfter":  token.KindErrorInvalidCharacterAfter,
+	"ErrorInvalidUTF8":            token.KindErrorInvalidUTF8,
 	"EOF":                         token.KindEOF,
 }
+
+// TestNextIntoAllocs tests that NextInto doesn't allocate.
+func TestNextIntoAllocs(t *testing.T) {
+	code, err := os.ReadFile(filepath.Join("testdata", "queries.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tok token.Token
+	allocs := testing.AllocsPerRun(10, func() {
+		l := New(code)
+		for l.NextInto(&tok); tok.Kind != token.KindEOF; l.NextInto(&tok) {
+		}
+	})
+	// the only allocations are of the Lexer and of its reader.
+	if allocs > 2 {
+		t.Errorf("%v allocations", allocs)
+	}
+}
+
+// TestLookupKeyword tests the lookup of keywords.
+func TestLookupKeyword(t *testing.T) {
+	cases := []struct {
+		word      string
+		kind      token.Kind
+		isKeyword bool
+	}{
+		{"select", token.KindSelect, true},
+		{"SeLeCt", token.KindSelect, true},
+		{"current_timestamp", token.KindCurrentTimestamp, true},
+		{"current_timestamps", nil, false},
+		{"selectá", nil, false},
+		{"ſelect", nil, false},
+		{"", nil, false},
+	}
+
+	for _, c := range cases {
+		kind, isKeyword := LookupKeyword([]byte(c.word))
+		if kind != c.kind || isKeyword != c.isKeyword {
+			t.Errorf("%q: want %v, %v, got %v, %v", c.word, c.kind, c.isKeyword, kind, isKeyword)
+		}
+	}
+}
+
+// benchmarkFiles runs bench for each SQL script of testdata.
+func benchmarkFiles(b *testing.B, bench func(b *testing.B, code []byte)) {
+	names, err := filepath.Glob(filepath.Join("testdata", "*.sql"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, name := range names {
+		code, err := os.ReadFile(name)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(filepath.Base(name), func(b *testing.B) {
+			b.SetBytes(int64(len(code)))
+			b.ReportAllocs()
+			bench(b, code)
+		})
+	}
+}
+
+func BenchmarkNext(b *testing.B) {
+	benchmarkFiles(b, func(b *testing.B, code []byte) {
+		for b.Loop() {
+			l := New(code)
+			for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+			}
+		}
+	})
+}
+
+func BenchmarkNextInto(b *testing.B) {
+	benchmarkFiles(b, func(b *testing.B, code []byte) {
+		var tok token.Token
+		for b.Loop() {
+			l := New(code)
+			for l.NextInto(&tok); tok.Kind != token.KindEOF; l.NextInto(&tok) {
+			}
+		}
+	})
+}
+
+func BenchmarkNewReader(b *testing.B) {
+	benchmarkFiles(b, func(b *testing.B, code []byte) {
+		var tok token.Token
+		for b.Loop() {
+			l := NewReader(bytes.NewReader(code), 0)
+			for l.NextInto(&tok); tok.Kind != token.KindEOF; l.NextInto(&tok) {
+			}
+		}
+	})
+}
+
+func TestIsAlphabetic(t *testing.T) {
+	for _, r := range "_aZ€ã�" {
+		if !IsAlphabetic(r) {
+			t.Errorf("IsAlphabetic(%q) = false, want true", r)
+		}
+	}
+	for _, r := range "0$ \"[`" {
+		if IsAlphabetic(r) {
+			t.Errorf("IsAlphabetic(%q) = true, want false", r)
+		}
+	}
+}
@@ -0,0 +1,2103 @@
+PRAGMA foreign_keys=OFF;
+BEGIN TRANSACTION;
+INSERT INTO users VALUES(1,'user1@example.com','User 1','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(2,'user2@example.com','User 2','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(3,'user3@example.com','User 3','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(4,'user4@example.com','User 4','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(5,'user5@example.com','User 5','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(6,'user6@example.com','User 6','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(7,'user7@example.com','User 7','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(8,'user8@example.com','User 8','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(9,'user9@example.com','User 9','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(10,'user10@example.com','User 10','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(11,'user11@example.com','User 11','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(12,'user12@example.com','User 12','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(13,'user13@example.com','User 13','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(14,'user14@example.com','User 14','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(15,'user15@example.com','User 15','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(16,'user16@example.com','User 16','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(17,'user17@example.com','User 17','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(18,'user18@example.com','User 18','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(19,'user19@example.com','User 19','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(20,'user20@example.com','User 20','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(21,'user21@example.com','User 21','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(22,'user22@example.com','User 22','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(23,'user23@example.com','User 23','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(24,'user24@example.com','User 24','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(25,'user25@example.com','User 25','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(26,'user26@example.com','User 26','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(27,'user27@example.com','User 27','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(28,'user28@example.com','User 28','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(29,'user29@example.com','User 29','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(30,'user30@example.com','User 30','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(31,'user31@example.com','User 31','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(32,'user32@example.com','User 32','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(33,'user33@example.com','User 33','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(34,'user34@example.com','User 34','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(35,'user35@example.com','User 35','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(36,'user36@example.com','User 36','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(37,'user37@example.com','User 37','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(38,'user38@example.com','User 38','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(39,'user39@example.com','User 39','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(40,'user40@example.com','User 40','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(41,'user41@example.com','User 41','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(42,'user42@example.com','User 42','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(43,'user43@example.com','User 43','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(44,'user44@example.com','User 44','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(45,'user45@example.com','User 45','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(46,'user46@example.com','User 46','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(47,'user47@example.com','User 47','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(48,'user48@example.com','User 48','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(49,'user49@example.com','User 49','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(50,'user50@example.com','User 50','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(51,'user51@example.com','User 51','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(52,'user52@example.com','User 52','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(53,'user53@example.com','User 53','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(54,'user54@example.com','User 54','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(55,'user55@example.com','User 55','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(56,'user56@example.com','User 56','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(57,'user57@example.com','User 57','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(58,'user58@example.com','User 58','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(59,'user59@example.com','User 59','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(60,'user60@example.com','User 60','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(61,'user61@example.com','User 61','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(62,'user62@example.com','User 62','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(63,'user63@example.com','User 63','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(64,'user64@example.com','User 64','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(65,'user65@example.com','User 65','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(66,'user66@example.com','User 66','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(67,'user67@example.com','User 67','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(68,'user68@example.com','User 68','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(69,'user69@example.com','User 69','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(70,'user70@example.com','User 70','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(71,'user71@example.com','User 71','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(72,'user72@example.com','User 72','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(73,'user73@example.com','User 73','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(74,'user74@example.com','User 74','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(75,'user75@example.com','User 75','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(76,'user76@example.com','User 76','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(77,'user77@example.com','User 77','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(78,'user78@example.com','User 78','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(79,'user79@example.com','User 79','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(80,'user80@example.com','User 80','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(81,'user81@example.com','User 81','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(82,'user82@example.com','User 82','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(83,'user83@example.com','User 83','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(84,'user84@example.com','User 84','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(85,'user85@example.com','User 85','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(86,'user86@example.com','User 86','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(87,'user87@example.com','User 87','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(88,'user88@example.com','User 88','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(89,'user89@example.com','User 89','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(90,'user90@example.com','User 90','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(91,'user91@example.com','User 91','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(92,'user92@example.com','User 92','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(93,'user93@example.com','User 93','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(94,'user94@example.com','User 94','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(95,'user95@example.com','User 95','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(96,'user96@example.com','User 96','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(97,'user97@example.com','User 97','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(98,'user98@example.com','User 98','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(99,'user99@example.com','User 99','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(100,'user100@example.com','User 100','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(101,'user101@example.com','User 101','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(102,'user102@example.com','User 102','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(103,'user103@example.com','User 103','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(104,'user104@example.com','User 104','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(105,'user105@example.com','User 105','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(106,'user106@example.com','User 106','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(107,'user107@example.com','User 107','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(108,'user108@example.com','User 108','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(109,'user109@example.com','User 109','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(110,'user110@example.com','User 110','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(111,'user111@example.com','User 111','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(112,'user112@example.com','User 112','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(113,'user113@example.com','User 113','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(114,'user114@example.com','User 114','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(115,'user115@example.com','User 115','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(116,'user116@example.com','User 116','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(117,'user117@example.com','User 117','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(118,'user118@example.com','User 118','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(119,'user119@example.com','User 119','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(120,'user120@example.com','User 120','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(121,'user121@example.com','User 121','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(122,'user122@example.com','User 122','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(123,'user123@example.com','User 123','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(124,'user124@example.com','User 124','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(125,'user125@example.com','User 125','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(126,'user126@example.com','User 126','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(127,'user127@example.com','User 127','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(128,'user128@example.com','User 128','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(129,'user129@example.com','User 129','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(130,'user130@example.com','User 130','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(131,'user131@example.com','User 131','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(132,'user132@example.com','User 132','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(133,'user133@example.com','User 133','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(134,'user134@example.com','User 134','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(135,'user135@example.com','User 135','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(136,'user136@example.com','User 136','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(137,'user137@example.com','User 137','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(138,'user138@example.com','User 138','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(139,'user139@example.com','User 139','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(140,'user140@example.com','User 140','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(141,'user141@example.com','User 141','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(142,'user142@example.com','User 142','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(143,'user143@example.com','User 143','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(144,'user144@example.com','User 144','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(145,'user145@example.com','User 145','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(146,'user146@example.com','User 146','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(147,'user147@example.com','User 147','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(148,'user148@example.com','User 148','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(149,'user149@example.com','User 149','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(150,'user150@example.com','User 150','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(151,'user151@example.com','User 151','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(152,'user152@example.com','User 152','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(153,'user153@example.com','User 153','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(154,'user154@example.com','User 154','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(155,'user155@example.com','User 155','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(156,'user156@example.com','User 156','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(157,'user157@example.com','User 157','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(158,'user158@example.com','User 158','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(159,'user159@example.com','User 159','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(160,'user160@example.com','User 160','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(161,'user161@example.com','User 161','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(162,'user162@example.com','User 162','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(163,'user163@example.com','User 163','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(164,'user164@example.com','User 164','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(165,'user165@example.com','User 165','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(166,'user166@example.com','User 166','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(167,'user167@example.com','User 167','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(168,'user168@example.com','User 168','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(169,'user169@example.com','User 169','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(170,'user170@example.com','User 170','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(171,'user171@example.com','User 171','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(172,'user172@example.com','User 172','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(173,'user173@example.com','User 173','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(174,'user174@example.com','User 174','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(175,'user175@example.com','User 175','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(176,'user176@example.com','User 176','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(177,'user177@example.com','User 177','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(178,'user178@example.com','User 178','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(179,'user179@example.com','User 179','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(180,'user180@example.com','User 180','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(181,'user181@example.com','User 181','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(182,'user182@example.com','User 182','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(183,'user183@example.com','User 183','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(184,'user184@example.com','User 184','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(185,'user185@example.com','User 185','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(186,'user186@example.com','User 186','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(187,'user187@example.com','User 187','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(188,'user188@example.com','User 188','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(189,'user189@example.com','User 189','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(190,'user190@example.com','User 190','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(191,'user191@example.com','User 191','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(192,'user192@example.com','User 192','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(193,'user193@example.com','User 193','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(194,'user194@example.com','User 194','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(195,'user195@example.com','User 195','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(196,'user196@example.com','User 196','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(197,'user197@example.com','User 197','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(198,'user198@example.com','User 198','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(199,'user199@example.com','User 199','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(200,'user200@example.com','User 200','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(201,'user201@example.com','User 201','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(202,'user202@example.com','User 202','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(203,'user203@example.com','User 203','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(204,'user204@example.com','User 204','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(205,'user205@example.com','User 205','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(206,'user206@example.com','User 206','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(207,'user207@example.com','User 207','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(208,'user208@example.com','User 208','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(209,'user209@example.com','User 209','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(210,'user210@example.com','User 210','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(211,'user211@example.com','User 211','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(212,'user212@example.com','User 212','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(213,'user213@example.com','User 213','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(214,'user214@example.com','User 214','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(215,'user215@example.com','User 215','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(216,'user216@example.com','User 216','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(217,'user217@example.com','User 217','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(218,'user218@example.com','User 218','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(219,'user219@example.com','User 219','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(220,'user220@example.com','User 220','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(221,'user221@example.com','User 221','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(222,'user222@example.com','User 222','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(223,'user223@example.com','User 223','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(224,'user224@example.com','User 224','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(225,'user225@example.com','User 225','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(226,'user226@example.com','User 226','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(227,'user227@example.com','User 227','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(228,'user228@example.com','User 228','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(229,'user229@example.com','User 229','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(230,'user230@example.com','User 230','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(231,'user231@example.com','User 231','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(232,'user232@example.com','User 232','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(233,'user233@example.com','User 233','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(234,'user234@example.com','User 234','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(235,'user235@example.com','User 235','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(236,'user236@example.com','User 236','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(237,'user237@example.com','User 237','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(238,'user238@example.com','User 238','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(239,'user239@example.com','User 239','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(240,'user240@example.com','User 240','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(241,'user241@example.com','User 241','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(242,'user242@example.com','User 242','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(243,'user243@example.com','User 243','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(244,'user244@example.com','User 244','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(245,'user245@example.com','User 245','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(246,'user246@example.com','User 246','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(247,'user247@example.com','User 247','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(248,'user248@example.com','User 248','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(249,'user249@example.com','User 249','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(250,'user250@example.com','User 250','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(251,'user251@example.com','User 251','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(252,'user252@example.com','User 252','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(253,'user253@example.com','User 253','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(254,'user254@example.com','User 254','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(255,'user255@example.com','User 255','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(256,'user256@example.com','User 256','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(257,'user257@example.com','User 257','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(258,'user258@example.com','User 258','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(259,'user259@example.com','User 259','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(260,'user260@example.com','User 260','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(261,'user261@example.com','User 261','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(262,'user262@example.com','User 262','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(263,'user263@example.com','User 263','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(264,'user264@example.com','User 264','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(265,'user265@example.com','User 265','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(266,'user266@example.com','User 266','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(267,'user267@example.com','User 267','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(268,'user268@example.com','User 268','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(269,'user269@example.com','User 269','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(270,'user270@example.com','User 270','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(271,'user271@example.com','User 271','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(272,'user272@example.com','User 272','2024-01-21 10:00:00',0);
+INSERT INTO users VALUES(273,'user273@example.com','User 273','2024-01-22 10:00:00',1);
+INSERT INTO users VALUES(274,'user274@example.com','User 274','2024-01-23 10:00:00',0);
+INSERT INTO users VALUES(275,'user275@example.com','User 275','2024-01-24 10:00:00',0);
+INSERT INTO users VALUES(276,'user276@example.com','User 276','2024-01-25 10:00:00',0);
+INSERT INTO users VALUES(277,'user277@example.com','User 277','2024-01-26 10:00:00',0);
+INSERT INTO users VALUES(278,'user278@example.com','User 278','2024-01-27 10:00:00',0);
+INSERT INTO users VALUES(279,'user279@example.com','User 279','2024-01-28 10:00:00',0);
+INSERT INTO users VALUES(280,'user280@example.com','User 280','2024-01-01 10:00:00',1);
+INSERT INTO users VALUES(281,'user281@example.com','User 281','2024-01-02 10:00:00',0);
+INSERT INTO users VALUES(282,'user282@example.com','User 282','2024-01-03 10:00:00',0);
+INSERT INTO users VALUES(283,'user283@example.com','User 283','2024-01-04 10:00:00',0);
+INSERT INTO users VALUES(284,'user284@example.com','User 284','2024-01-05 10:00:00',0);
+INSERT INTO users VALUES(285,'user285@example.com','User 285','2024-01-06 10:00:00',0);
+INSERT INTO users VALUES(286,'user286@example.com','User 286','2024-01-07 10:00:00',0);
+INSERT INTO users VALUES(287,'user287@example.com','User 287','2024-01-08 10:00:00',1);
+INSERT INTO users VALUES(288,'user288@example.com','User 288','2024-01-09 10:00:00',0);
+INSERT INTO users VALUES(289,'user289@example.com','User 289','2024-01-10 10:00:00',0);
+INSERT INTO users VALUES(290,'user290@example.com','User 290','2024-01-11 10:00:00',0);
+INSERT INTO users VALUES(291,'user291@example.com','User 291','2024-01-12 10:00:00',0);
+INSERT INTO users VALUES(292,'user292@example.com','User 292','2024-01-13 10:00:00',0);
+INSERT INTO users VALUES(293,'user293@example.com','User 293','2024-01-14 10:00:00',0);
+INSERT INTO users VALUES(294,'user294@example.com','User 294','2024-01-15 10:00:00',1);
+INSERT INTO users VALUES(295,'user295@example.com','User 295','2024-01-16 10:00:00',0);
+INSERT INTO users VALUES(296,'user296@example.com','User 296','2024-01-17 10:00:00',0);
+INSERT INTO users VALUES(297,'user297@example.com','User 297','2024-01-18 10:00:00',0);
+INSERT INTO users VALUES(298,'user298@example.com','User 298','2024-01-19 10:00:00',0);
+INSERT INTO users VALUES(299,'user299@example.com','User 299','2024-01-20 10:00:00',0);
+INSERT INTO users VALUES(300,'user300@example.com','User 300','2024-01-21 10:00:00',0);
+INSERT INTO issues VALUES(1,2,2,2,'page sync login error','It''s page sync login error when I click it','open',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(2,3,3,3,'export export export typo','It''s export export export typo when I click it','open',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(3,4,4,NULL,'login export crash typo','It''s login export crash typo when I click it','in_progress',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(4,5,5,5,'sync crash export error','It''s sync crash export error when I click it','closed',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(5,6,6,6,'slow sync login button','It''s slow sync login button when I click it','open',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(6,7,7,NULL,'crash crash import crash','It''s crash crash import crash when I click it','in_progress',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(7,8,8,8,'slow typo crash import','It''s slow typo crash import when I click it','open',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(8,9,9,9,'export export import slow','It''s export export import slow when I click it','in_progress',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(9,10,10,NULL,'slow slow export error','It''s slow slow export error when I click it','open',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(10,11,11,11,'typo import login page','It''s typo import login page when I click it','closed',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(11,12,12,12,'error login button import','It''s error login button import when I click it','in_progress',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(12,13,13,NULL,'import slow error error','It''s import slow error error when I click it','closed',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(13,14,14,14,'export import typo sync','It''s export import typo sync when I click it','open',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(14,15,15,15,'export slow typo typo','It''s export slow typo typo when I click it','closed',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(15,16,16,NULL,'page button import button','It''s page button import button when I click it','open',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(16,17,17,17,'export import login page','It''s export import login page when I click it','closed',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(17,18,18,18,'typo button export crash','It''s typo button export crash when I click it','in_progress',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(18,19,19,NULL,'crash error sync sync','It''s crash error sync sync when I click it','closed',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(19,20,20,20,'typo page page import','It''s typo page page import when I click it','open',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(20,21,21,21,'crash slow import import','It''s crash slow import import when I click it','open',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(21,22,22,NULL,'typo import button sync','It''s typo import button sync when I click it','in_progress',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(22,23,23,23,'export error import sync','It''s export error import sync when I click it','closed',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(23,24,24,24,'crash typo import page','It''s crash typo import page when I click it','closed',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(24,25,25,NULL,'import slow typo crash','It''s import slow typo crash when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(25,26,26,26,'button sync import slow','It''s button sync import slow when I click it','closed',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(26,27,27,27,'typo export button typo','It''s typo export button typo when I click it','in_progress',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(27,28,28,NULL,'crash import import sync','It''s crash import import sync when I click it','closed',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(28,29,29,29,'button export sync crash','It''s button export sync crash when I click it','open',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(29,30,30,30,'page import sync page','It''s page import sync page when I click it','open',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(30,31,31,NULL,'import error crash login','It''s import error crash login when I click it','open',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(31,32,32,32,'crash export crash error','It''s crash export crash error when I click it','open',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(32,33,33,33,'error login sync page','It''s error login sync page when I click it','in_progress',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(33,34,34,NULL,'error login page page','It''s error login page page when I click it','in_progress',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(34,35,35,35,'import page error error','It''s import page error error when I click it','in_progress',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(35,36,36,36,'button export export login','It''s button export export login when I click it','open',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(36,37,37,NULL,'error typo button typo','It''s error typo button typo when I click it','open',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(37,38,38,38,'error login error import','It''s error login error import when I click it','open',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(38,39,39,39,'sync typo crash slow','It''s sync typo crash slow when I click it','open',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(39,40,40,NULL,'typo page crash page','It''s typo page crash page when I click it','in_progress',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(40,1,41,41,'import typo import slow','It''s import typo import slow when I click it','closed',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(41,2,42,42,'import export slow import','It''s import export slow import when I click it','closed',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(42,3,43,NULL,'crash typo sync button','It''s crash typo sync button when I click it','closed',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(43,4,44,44,'typo crash error page','It''s typo crash error page when I click it','open',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(44,5,45,45,'crash error login login','It''s crash error login login when I click it','in_progress',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(45,6,46,NULL,'error page typo sync','It''s error page typo sync when I click it','in_progress',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(46,7,47,47,'page crash import crash','It''s page crash import crash when I click it','closed',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(47,8,48,48,'slow sync export page','It''s slow sync export page when I click it','closed',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(48,9,49,NULL,'sync import crash typo','It''s sync import crash typo when I click it','open',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(49,10,50,50,'button login slow sync','It''s button login slow sync when I click it','closed',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(50,11,51,51,'typo sync slow export','It''s typo sync slow export when I click it','open',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(51,12,52,NULL,'typo error import export','It''s typo error import export when I click it','open',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(52,13,53,53,'button sync typo error','It''s button sync typo error when I click it','open',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(53,14,54,54,'page slow button sync','It''s page slow button sync when I click it','open',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(54,15,55,NULL,'button typo slow error','It''s button typo slow error when I click it','closed',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(55,16,56,56,'login typo import button','It''s login typo import button when I click it','closed',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(56,17,57,57,'import export import slow','It''s import export import slow when I click it','open',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(57,18,58,NULL,'crash login page page','It''s crash login page page when I click it','open',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(58,19,59,59,'import slow error button','It''s import slow error button when I click it','closed',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(59,20,60,60,'import error button button','It''s import error button button when I click it','in_progress',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(60,21,61,NULL,'login error slow sync','It''s login error slow sync when I click it','closed',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(61,22,62,62,'export page sync import','It''s export page sync import when I click it','open',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(62,23,63,63,'button crash typo login','It''s button crash typo login when I click it','in_progress',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(63,24,64,NULL,'page page button login','It''s page page button login when I click it','closed',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(64,25,65,65,'sync typo login sync','It''s sync typo login sync when I click it','closed',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(65,26,66,66,'slow sync login error','It''s slow sync login error when I click it','in_progress',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(66,27,67,NULL,'error sync import login','It''s error sync import login when I click it','in_progress',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(67,28,68,68,'error login crash error','It''s error login crash error when I click it','open',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(68,29,69,69,'sync crash login typo','It''s sync crash login typo when I click it','open',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(69,30,70,NULL,'crash slow slow sync','It''s crash slow slow sync when I click it','in_progress',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(70,31,71,71,'page login export page','It''s page login export page when I click it','closed',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(71,32,72,72,'slow page login typo','It''s slow page login typo when I click it','in_progress',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(72,33,73,NULL,'import error import error','It''s import error import error when I click it','closed',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(73,34,74,74,'export button login slow','It''s export button login slow when I click it','closed',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(74,35,75,75,'button crash crash crash','It''s button crash crash crash when I click it','in_progress',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(75,36,76,NULL,'sync button export typo','It''s sync button export typo when I click it','in_progress',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(76,37,77,77,'typo login login button','It''s typo login login button when I click it','closed',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(77,38,78,78,'export login error slow','It''s export login error slow when I click it','closed',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(78,39,79,NULL,'import export button error','It''s import export button error when I click it','open',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(79,40,80,80,'import slow error slow','It''s import slow error slow when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(80,1,81,81,'button login error login','It''s button login error login when I click it','in_progress',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(81,2,82,NULL,'login sync button slow','It''s login sync button slow when I click it','in_progress',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(82,3,83,83,'error crash button page','It''s error crash button page when I click it','in_progress',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(83,4,84,84,'sync error slow button','It''s sync error slow button when I click it','open',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(84,5,85,NULL,'import sync sync sync','It''s import sync sync sync when I click it','open',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(85,6,86,86,'slow slow crash slow','It''s slow slow crash slow when I click it','in_progress',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(86,7,87,87,'login error import login','It''s login error import login when I click it','closed',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(87,8,88,NULL,'login crash crash error','It''s login crash crash error when I click it','in_progress',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(88,9,89,89,'export export page login','It''s export export page login when I click it','closed',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(89,10,90,90,'button login import page','It''s button login import page when I click it','open',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(90,11,91,NULL,'page page button error','It''s page page button error when I click it','open',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(91,12,92,92,'import sync error page','It''s import sync error page when I click it','open',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(92,13,93,93,'page import crash button','It''s page import crash button when I click it','closed',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(93,14,94,NULL,'import slow page error','It''s import slow page error when I click it','in_progress',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(94,15,95,95,'import page crash slow','It''s import page crash slow when I click it','in_progress',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(95,16,96,96,'login export typo import','It''s login export typo import when I click it','in_progress',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(96,17,97,NULL,'import export import export','It''s import export import export when I click it','open',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(97,18,98,98,'typo button page error','It''s typo button page error when I click it','in_progress',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(98,19,99,99,'crash typo sync crash','It''s crash typo sync crash when I click it','open',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(99,20,100,NULL,'button sync page sync','It''s button sync page sync when I click it','open',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(100,21,101,101,'page error error typo','It''s page error error typo when I click it','closed',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(101,22,102,102,'typo page sync login','It''s typo page sync login when I click it','open',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(102,23,103,NULL,'export crash page import','It''s export crash page import when I click it','in_progress',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(103,24,104,104,'import export slow slow','It''s import export slow slow when I click it','in_progress',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(104,25,105,105,'export export slow typo','It''s export export slow typo when I click it','in_progress',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(105,26,106,NULL,'import sync error slow','It''s import sync error slow when I click it','open',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(106,27,107,107,'login import button page','It''s login import button page when I click it','closed',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(107,28,108,108,'slow error error error','It''s slow error error error when I click it','closed',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(108,29,109,NULL,'button page export sync','It''s button page export sync when I click it','open',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(109,30,110,110,'login sync import sync','It''s login sync import sync when I click it','in_progress',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(110,31,111,111,'page page error typo','It''s page page error typo when I click it','open',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(111,32,112,NULL,'sync crash export typo','It''s sync crash export typo when I click it','closed',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(112,33,113,113,'button typo import page','It''s button typo import page when I click it','closed',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(113,34,114,114,'crash import login error','It''s crash import login error when I click it','closed',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(114,35,115,NULL,'login error login page','It''s login error login page when I click it','closed',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(115,36,116,116,'login export slow typo','It''s login export slow typo when I click it','in_progress',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(116,37,117,117,'typo page button export','It''s typo page button export when I click it','open',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(117,38,118,NULL,'sync export slow login','It''s sync export slow login when I click it','in_progress',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(118,39,119,119,'sync import typo login','It''s sync import typo login when I click it','closed',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(119,40,120,120,'error error slow typo','It''s error error slow typo when I click it','closed',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(120,1,121,NULL,'import crash slow import','It''s import crash slow import when I click it','in_progress',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(121,2,122,122,'sync crash crash sync','It''s sync crash crash sync when I click it','open',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(122,3,123,123,'error slow page error','It''s error slow page error when I click it','open',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(123,4,124,NULL,'import slow error error','It''s import slow error error when I click it','closed',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(124,5,125,125,'error export page import','It''s error export page import when I click it','in_progress',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(125,6,126,126,'export typo login slow','It''s export typo login slow when I click it','closed',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(126,7,127,NULL,'typo slow error login','It''s typo slow error login when I click it','open',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(127,8,128,128,'login sync crash import','It''s login sync crash import when I click it','in_progress',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(128,9,129,129,'page login import button','It''s page login import button when I click it','closed',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(129,10,130,NULL,'error typo import button','It''s error typo import button when I click it','closed',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(130,11,131,131,'button crash login export','It''s button crash login export when I click it','closed',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(131,12,132,132,'export button error import','It''s export button error import when I click it','in_progress',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(132,13,133,NULL,'button sync export login','It''s button sync export login when I click it','closed',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(133,14,134,134,'typo typo slow import','It''s typo typo slow import when I click it','open',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(134,15,135,135,'error sync import slow','It''s error sync import slow when I click it','in_progress',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(135,16,136,NULL,'sync import typo error','It''s sync import typo error when I click it','closed',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(136,17,137,137,'page export sync import','It''s page export sync import when I click it','open',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(137,18,138,138,'button import crash typo','It''s button import crash typo when I click it','closed',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(138,19,139,NULL,'typo typo button sync','It''s typo typo button sync when I click it','closed',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(139,20,140,140,'login export slow error','It''s login export slow error when I click it','closed',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(140,21,141,141,'crash typo page typo','It''s crash typo page typo when I click it','in_progress',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(141,22,142,NULL,'page login sync crash','It''s page login sync crash when I click it','in_progress',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(142,23,143,143,'error typo import error','It''s error typo import error when I click it','open',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(143,24,144,144,'export error export page','It''s export error export page when I click it','in_progress',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(144,25,145,NULL,'import crash error import','It''s import crash error import when I click it','open',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(145,26,146,146,'sync typo login button','It''s sync typo login button when I click it','open',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(146,27,147,147,'export crash page import','It''s export crash page import when I click it','closed',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(147,28,148,NULL,'page login typo error','It''s page login typo error when I click it','closed',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(148,29,149,149,'error slow import slow','It''s error slow import slow when I click it','open',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(149,30,150,150,'button error login login','It''s button error login login when I click it','closed',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(150,31,151,NULL,'import button export import','It''s import button export import when I click it','closed',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(151,32,152,152,'crash page error import','It''s crash page error import when I click it','in_progress',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(152,33,153,153,'button sync slow typo','It''s button sync slow typo when I click it','closed',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(153,34,154,NULL,'typo page export error','It''s typo page export error when I click it','closed',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(154,35,155,155,'button slow error sync','It''s button slow error sync when I click it','closed',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(155,36,156,156,'slow crash sync typo','It''s slow crash sync typo when I click it','in_progress',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(156,37,157,NULL,'typo slow error slow','It''s typo slow error slow when I click it','open',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(157,38,158,158,'page sync export sync','It''s page sync export sync when I click it','closed',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(158,39,159,159,'page sync error export','It''s page sync error export when I click it','closed',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(159,40,160,NULL,'page page page export','It''s page page page export when I click it','in_progress',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(160,1,161,161,'error typo slow login','It''s error typo slow login when I click it','closed',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(161,2,162,162,'slow error login login','It''s slow error login login when I click it','open',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(162,3,163,NULL,'typo button export login','It''s typo button export login when I click it','open',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(163,4,164,164,'crash crash sync crash','It''s crash crash sync crash when I click it','open',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(164,5,165,165,'crash export import sync','It''s crash export import sync when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(165,6,166,NULL,'button error login sync','It''s button error login sync when I click it','closed',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(166,7,167,167,'page login slow typo','It''s page login slow typo when I click it','open',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(167,8,168,168,'export export typo page','It''s export export typo page when I click it','open',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(168,9,169,NULL,'slow error export import','It''s slow error export import when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(169,10,170,170,'typo slow export error','It''s typo slow export error when I click it','in_progress',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(170,11,171,171,'export sync login slow','It''s export sync login slow when I click it','open',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(171,12,172,NULL,'crash crash crash export','It''s crash crash crash export when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(172,13,173,173,'typo sync error slow','It''s typo sync error slow when I click it','in_progress',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(173,14,174,174,'page page crash crash','It''s page page crash crash when I click it','in_progress',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(174,15,175,NULL,'page import crash sync','It''s page import crash sync when I click it','in_progress',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(175,16,176,176,'error page login export','It''s error page login export when I click it','closed',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(176,17,177,177,'error crash crash import','It''s error crash crash import when I click it','open',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(177,18,178,NULL,'import page crash error','It''s import page crash error when I click it','open',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(178,19,179,179,'typo login slow crash','It''s typo login slow crash when I click it','in_progress',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(179,20,180,180,'page error slow export','It''s page error slow export when I click it','in_progress',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(180,21,181,NULL,'button error error slow','It''s button error error slow when I click it','open',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(181,22,182,182,'crash sync sync page','It''s crash sync sync page when I click it','in_progress',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(182,23,183,183,'typo sync import import','It''s typo sync import import when I click it','open',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(183,24,184,NULL,'button import typo import','It''s button import typo import when I click it','open',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(184,25,185,185,'import typo login error','It''s import typo login error when I click it','closed',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(185,26,186,186,'sync login error page','It''s sync login error page when I click it','open',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(186,27,187,NULL,'page crash slow typo','It''s page crash slow typo when I click it','open',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(187,28,188,188,'crash login import export','It''s crash login import export when I click it','closed',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(188,29,189,189,'button login button crash','It''s button login button crash when I click it','open',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(189,30,190,NULL,'import crash export page','It''s import crash export page when I click it','in_progress',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(190,31,191,191,'export crash import error','It''s export crash import error when I click it','open',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(191,32,192,192,'error button login error','It''s error button login error when I click it','open',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(192,33,193,NULL,'typo crash error button','It''s typo crash error button when I click it','closed',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(193,34,194,194,'page error typo login','It''s page error typo login when I click it','closed',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(194,35,195,195,'error login typo slow','It''s error login typo slow when I click it','closed',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(195,36,196,NULL,'import slow button button','It''s import slow button button when I click it','closed',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(196,37,197,197,'typo sync export login','It''s typo sync export login when I click it','open',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(197,38,198,198,'export import import sync','It''s export import import sync when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(198,39,199,NULL,'import import crash error','It''s import import crash error when I click it','closed',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(199,40,200,200,'page slow button typo','It''s page slow button typo when I click it','closed',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(200,1,201,201,'button login typo button','It''s button login typo button when I click it','open',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(201,2,202,NULL,'sync login crash error','It''s sync login crash error when I click it','closed',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(202,3,203,203,'import button typo error','It''s import button typo error when I click it','in_progress',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(203,4,204,204,'button error button import','It''s button error button import when I click it','closed',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(204,5,205,NULL,'crash import login page','It''s crash import login page when I click it','in_progress',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(205,6,206,206,'button button sync login','It''s button button sync login when I click it','in_progress',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(206,7,207,207,'error export export button','It''s error export export button when I click it','closed',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(207,8,208,NULL,'typo login sync crash','It''s typo login sync crash when I click it','open',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(208,9,209,209,'crash import export sync','It''s crash import export sync when I click it','in_progress',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(209,10,210,210,'slow sync button button','It''s slow sync button button when I click it','closed',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(210,11,211,NULL,'button typo error export','It''s button typo error export when I click it','closed',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(211,12,212,212,'button import import page','It''s button import import page when I click it','open',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(212,13,213,213,'page error slow sync','It''s page error slow sync when I click it','open',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(213,14,214,NULL,'login page typo sync','It''s login page typo sync when I click it','open',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(214,15,215,215,'login import error login','It''s login import error login when I click it','open',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(215,16,216,216,'error login sync import','It''s error login sync import when I click it','closed',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(216,17,217,NULL,'login login slow page','It''s login login slow page when I click it','closed',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(217,18,218,218,'typo crash sync button','It''s typo crash sync button when I click it','in_progress',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(218,19,219,219,'error slow slow sync','It''s error slow slow sync when I click it','in_progress',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(219,20,220,NULL,'slow typo export button','It''s slow typo export button when I click it','closed',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(220,21,221,221,'slow export login error','It''s slow export login error when I click it','in_progress',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(221,22,222,222,'slow crash import typo','It''s slow crash import typo when I click it','closed',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(222,23,223,NULL,'export login typo sync','It''s export login typo sync when I click it','closed',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(223,24,224,224,'sync sync typo crash','It''s sync sync typo crash when I click it','in_progress',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(224,25,225,225,'export crash slow error','It''s export crash slow error when I click it','closed',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(225,26,226,NULL,'crash import login error','It''s crash import login error when I click it','closed',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(226,27,227,227,'button import sync import','It''s button import sync import when I click it','in_progress',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(227,28,228,228,'import typo import import','It''s import typo import import when I click it','in_progress',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(228,29,229,NULL,'sync sync error export','It''s sync sync error export when I click it','in_progress',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(229,30,230,230,'page import export sync','It''s page import export sync when I click it','open',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(230,31,231,231,'import page error crash','It''s import page error crash when I click it','in_progress',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(231,32,232,NULL,'sync crash button typo','It''s sync crash button typo when I click it','in_progress',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(232,33,233,233,'error crash login login','It''s error crash login login when I click it','open',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(233,34,234,234,'typo error export error','It''s typo error export error when I click it','in_progress',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(234,35,235,NULL,'export button typo export','It''s export button typo export when I click it','open',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(235,36,236,236,'export button page typo','It''s export button page typo when I click it','open',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(236,37,237,237,'crash page error button','It''s crash page error button when I click it','open',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(237,38,238,NULL,'sync error typo error','It''s sync error typo error when I click it','closed',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(238,39,239,239,'error typo error typo','It''s error typo error typo when I click it','in_progress',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(239,40,240,240,'export slow export typo','It''s export slow export typo when I click it','closed',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(240,1,241,NULL,'typo login login page','It''s typo login login page when I click it','open',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(241,2,242,242,'page slow crash login','It''s page slow crash login when I click it','in_progress',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(242,3,243,243,'page export login typo','It''s page export login typo when I click it','closed',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(243,4,244,NULL,'page crash login typo','It''s page crash login typo when I click it','closed',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(244,5,245,245,'crash import slow import','It''s crash import slow import when I click it','in_progress',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(245,6,246,246,'button crash login import','It''s button crash login import when I click it','closed',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(246,7,247,NULL,'typo login error error','It''s typo login error error when I click it','open',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(247,8,248,248,'export crash slow login','It''s export crash slow login when I click it','in_progress',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(248,9,249,249,'login export error import','It''s login export error import when I click it','in_progress',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(249,10,250,NULL,'typo login sync export','It''s typo login sync export when I click it','open',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(250,11,251,251,'page typo sync slow','It''s page typo sync slow when I click it','open',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(251,12,252,252,'import error typo import','It''s import error typo import when I click it','in_progress',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(252,13,253,NULL,'export import slow sync','It''s export import slow sync when I click it','in_progress',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(253,14,254,254,'export login crash button','It''s export login crash button when I click it','closed',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(254,15,255,255,'error crash import export','It''s error crash import export when I click it','in_progress',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(255,16,256,NULL,'login slow import error','It''s login slow import error when I click it','in_progress',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(256,17,257,257,'slow typo page page','It''s slow typo page page when I click it','in_progress',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(257,18,258,258,'slow typo import sync','It''s slow typo import sync when I click it','open',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(258,19,259,NULL,'import sync import page','It''s import sync import page when I click it','in_progress',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(259,20,260,260,'error error export error','It''s error error export error when I click it','in_progress',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(260,21,261,261,'export slow export button','It''s export slow export button when I click it','closed',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(261,22,262,NULL,'export slow button page','It''s export slow button page when I click it','closed',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(262,23,263,263,'page sync export import','It''s page sync export import when I click it','open',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(263,24,264,264,'crash import button import','It''s crash import button import when I click it','closed',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(264,25,265,NULL,'page slow button sync','It''s page slow button sync when I click it','in_progress',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(265,26,266,266,'export button login page','It''s export button login page when I click it','open',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(266,27,267,267,'error slow login import','It''s error slow login import when I click it','closed',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(267,28,268,NULL,'crash sync page login','It''s crash sync page login when I click it','open',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(268,29,269,269,'sync slow import sync','It''s sync slow import sync when I click it','closed',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(269,30,270,270,'error typo button crash','It''s error typo button crash when I click it','open',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(270,31,271,NULL,'error sync slow login','It''s error sync slow login when I click it','closed',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(271,32,272,272,'slow error button error','It''s slow error button error when I click it','closed',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(272,33,273,273,'import typo crash login','It''s import typo crash login when I click it','in_progress',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(273,34,274,NULL,'button page login error','It''s button page login error when I click it','open',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(274,35,275,275,'sync crash button login','It''s sync crash button login when I click it','open',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(275,36,276,276,'login error button slow','It''s login error button slow when I click it','in_progress',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(276,37,277,NULL,'import crash button crash','It''s import crash button crash when I click it','open',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(277,38,278,278,'page typo button slow','It''s page typo button slow when I click it','open',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(278,39,279,279,'button error crash import','It''s button error crash import when I click it','in_progress',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(279,40,280,NULL,'login button page sync','It''s login button page sync when I click it','in_progress',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(280,1,281,281,'typo login sync sync','It''s typo login sync sync when I click it','closed',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(281,2,282,282,'import export sync typo','It''s import export sync typo when I click it','closed',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(282,3,283,NULL,'typo error slow error','It''s typo error slow error when I click it','closed',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(283,4,284,284,'page crash sync import','It''s page crash sync import when I click it','open',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(284,5,285,285,'page slow slow typo','It''s page slow slow typo when I click it','in_progress',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(285,6,286,NULL,'import crash error import','It''s import crash error import when I click it','in_progress',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(286,7,287,287,'import error export page','It''s import error export page when I click it','in_progress',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(287,8,288,288,'login button login import','It''s login button login import when I click it','in_progress',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(288,9,289,NULL,'import import import sync','It''s import import import sync when I click it','open',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(289,10,290,290,'sync error export page','It''s sync error export page when I click it','open',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(290,11,291,291,'login sync page slow','It''s login sync page slow when I click it','in_progress',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(291,12,292,NULL,'button button error page','It''s button button error page when I click it','open',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(292,13,293,293,'typo export typo login','It''s typo export typo login when I click it','closed',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(293,14,294,294,'page error error sync','It''s page error error sync when I click it','open',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(294,15,295,NULL,'import crash page typo','It''s import crash page typo when I click it','closed',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(295,16,296,296,'import login export crash','It''s import login export crash when I click it','in_progress',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(296,17,297,297,'sync typo error button','It''s sync typo error button when I click it','in_progress',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(297,18,298,NULL,'typo sync export crash','It''s typo sync export crash when I click it','open',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(298,19,299,299,'export crash crash crash','It''s export crash crash crash when I click it','open',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(299,20,300,300,'sync page import import','It''s sync page import import when I click it','in_progress',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(300,21,1,NULL,'import error sync button','It''s import error sync button when I click it','in_progress',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(301,22,2,2,'slow sync slow login','It''s slow sync slow login when I click it','closed',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(302,23,3,3,'button page login crash','It''s button page login crash when I click it','closed',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(303,24,4,NULL,'button typo button error','It''s button typo button error when I click it','closed',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(304,25,5,5,'crash sync typo typo','It''s crash sync typo typo when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(305,26,6,6,'button error button export','It''s button error button export when I click it','closed',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(306,27,7,NULL,'slow sync import page','It''s slow sync import page when I click it','open',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(307,28,8,8,'button login import page','It''s button login import page when I click it','closed',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(308,29,9,9,'export button login sync','It''s export button login sync when I click it','open',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(309,30,10,NULL,'export slow typo page','It''s export slow typo page when I click it','in_progress',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(310,31,11,11,'slow login slow button','It''s slow login slow button when I click it','in_progress',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(311,32,12,12,'slow export export button','It''s slow export export button when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(312,33,13,NULL,'slow typo export typo','It''s slow typo export typo when I click it','closed',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(313,34,14,14,'login sync export error','It''s login sync export error when I click it','open',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(314,35,15,15,'page crash typo typo','It''s page crash typo typo when I click it','open',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(315,36,16,NULL,'crash login page export','It''s crash login page export when I click it','in_progress',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(316,37,17,17,'import error page page','It''s import error page page when I click it','closed',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(317,38,18,18,'login error crash export','It''s login error crash export when I click it','in_progress',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(318,39,19,NULL,'slow import typo crash','It''s slow import typo crash when I click it','closed',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(319,40,20,20,'slow typo page page','It''s slow typo page page when I click it','in_progress',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(320,1,21,21,'slow login import import','It''s slow login import import when I click it','open',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(321,2,22,NULL,'page typo sync crash','It''s page typo sync crash when I click it','closed',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(322,3,23,23,'slow typo slow crash','It''s slow typo slow crash when I click it','closed',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(323,4,24,24,'slow import sync import','It''s slow import sync import when I click it','open',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(324,5,25,NULL,'slow typo export login','It''s slow typo export login when I click it','closed',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(325,6,26,26,'crash typo login import','It''s crash typo login import when I click it','open',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(326,7,27,27,'export crash import slow','It''s export crash import slow when I click it','open',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(327,8,28,NULL,'crash error export error','It''s crash error export error when I click it','closed',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(328,9,29,29,'typo page sync page','It''s typo page sync page when I click it','closed',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(329,10,30,30,'button import export import','It''s button import export import when I click it','in_progress',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(330,11,31,NULL,'import page typo typo','It''s import page typo typo when I click it','open',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(331,12,32,32,'export error button page','It''s export error button page when I click it','in_progress',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(332,13,33,33,'sync error page sync','It''s sync error page sync when I click it','open',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(333,14,34,NULL,'button button page error','It''s button button page error when I click it','in_progress',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(334,15,35,35,'error button typo error','It''s error button typo error when I click it','closed',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(335,16,36,36,'export crash page page','It''s export crash page page when I click it','in_progress',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(336,17,37,NULL,'slow slow login sync','It''s slow slow login sync when I click it','closed',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(337,18,38,38,'sync slow import typo','It''s sync slow import typo when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(338,19,39,39,'slow sync page import','It''s slow sync page import when I click it','in_progress',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(339,20,40,NULL,'typo slow login login','It''s typo slow login login when I click it','open',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(340,21,41,41,'crash crash typo typo','It''s crash crash typo typo when I click it','in_progress',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(341,22,42,42,'page sync sync page','It''s page sync sync page when I click it','closed',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(342,23,43,NULL,'import import login slow','It''s import import login slow when I click it','in_progress',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(343,24,44,44,'page error slow typo','It''s page error slow typo when I click it','in_progress',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(344,25,45,45,'page slow error page','It''s page slow error page when I click it','in_progress',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(345,26,46,NULL,'export import error login','It''s export import error login when I click it','closed',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(346,27,47,47,'error slow export crash','It''s error slow export crash when I click it','in_progress',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(347,28,48,48,'sync sync login sync','It''s sync sync login sync when I click it','in_progress',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(348,29,49,NULL,'export error sync crash','It''s export error sync crash when I click it','open',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(349,30,50,50,'button page page login','It''s button page page login when I click it','open',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(350,31,51,51,'typo sync slow slow','It''s typo sync slow slow when I click it','closed',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(351,32,52,NULL,'import typo login slow','It''s import typo login slow when I click it','in_progress',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(352,33,53,53,'import page sync error','It''s import page sync error when I click it','closed',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(353,34,54,54,'crash login slow sync','It''s crash login slow sync when I click it','in_progress',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(354,35,55,NULL,'export import sync slow','It''s export import sync slow when I click it','in_progress',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(355,36,56,56,'crash page import import','It''s crash page import import when I click it','open',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(356,37,57,57,'typo error typo typo','It''s typo error typo typo when I click it','in_progress',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(357,38,58,NULL,'export login page page','It''s export login page page when I click it','closed',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(358,39,59,59,'crash export crash export','It''s crash export crash export when I click it','open',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(359,40,60,60,'typo import button slow','It''s typo import button slow when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(360,1,61,NULL,'login crash typo export','It''s login crash typo export when I click it','open',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(361,2,62,62,'page sync import slow','It''s page sync import slow when I click it','closed',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(362,3,63,63,'typo import button slow','It''s typo import button slow when I click it','open',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(363,4,64,NULL,'button sync login button','It''s button sync login button when I click it','open',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(364,5,65,65,'export crash sync page','It''s export crash sync page when I click it','open',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(365,6,66,66,'error export crash sync','It''s error export crash sync when I click it','closed',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(366,7,67,NULL,'login sync typo login','It''s login sync typo login when I click it','in_progress',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(367,8,68,68,'import sync error typo','It''s import sync error typo when I click it','in_progress',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(368,9,69,69,'button export crash import','It''s button export crash import when I click it','in_progress',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(369,10,70,NULL,'crash typo error sync','It''s crash typo error sync when I click it','closed',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(370,11,71,71,'button page sync sync','It''s button page sync sync when I click it','closed',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(371,12,72,72,'error login sync button','It''s error login sync button when I click it','in_progress',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(372,13,73,NULL,'typo import crash sync','It''s typo import crash sync when I click it','closed',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(373,14,74,74,'login crash sync import','It''s login crash sync import when I click it','open',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(374,15,75,75,'login button button button','It''s login button button button when I click it','closed',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(375,16,76,NULL,'crash button sync login','It''s crash button sync login when I click it','in_progress',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(376,17,77,77,'login import export button','It''s login import export button when I click it','closed',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(377,18,78,78,'import crash page button','It''s import crash page button when I click it','in_progress',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(378,19,79,NULL,'slow page sync page','It''s slow page sync page when I click it','closed',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(379,20,80,80,'login typo button import','It''s login typo button import when I click it','in_progress',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(380,21,81,81,'button button error sync','It''s button button error sync when I click it','in_progress',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(381,22,82,NULL,'crash login slow error','It''s crash login slow error when I click it','in_progress',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(382,23,83,83,'import error sync sync','It''s import error sync sync when I click it','open',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(383,24,84,84,'login page error typo','It''s login page error typo when I click it','open',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(384,25,85,NULL,'page error import error','It''s page error import error when I click it','open',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(385,26,86,86,'slow login error export','It''s slow login error export when I click it','open',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(386,27,87,87,'import error slow import','It''s import error slow import when I click it','open',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(387,28,88,NULL,'import button button error','It''s import button button error when I click it','closed',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(388,29,89,89,'page crash export button','It''s page crash export button when I click it','closed',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(389,30,90,90,'crash crash button typo','It''s crash crash button typo when I click it','closed',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(390,31,91,NULL,'page import crash sync','It''s page import crash sync when I click it','closed',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(391,32,92,92,'import typo page slow','It''s import typo page slow when I click it','open',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(392,33,93,93,'login sync page sync','It''s login sync page sync when I click it','closed',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(393,34,94,NULL,'login error export slow','It''s login error export slow when I click it','open',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(394,35,95,95,'button export button sync','It''s button export button sync when I click it','closed',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(395,36,96,96,'button slow crash crash','It''s button slow crash crash when I click it','in_progress',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(396,37,97,NULL,'crash page error import','It''s crash page error import when I click it','open',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(397,38,98,98,'crash slow login import','It''s crash slow login import when I click it','open',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(398,39,99,99,'crash import slow slow','It''s crash import slow slow when I click it','in_progress',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(399,40,100,NULL,'error slow export import','It''s error slow export import when I click it','in_progress',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(400,1,101,101,'button typo login slow','It''s button typo login slow when I click it','closed',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(401,2,102,102,'page slow sync error','It''s page slow sync error when I click it','closed',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(402,3,103,NULL,'typo sync export button','It''s typo sync export button when I click it','open',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(403,4,104,104,'export crash login sync','It''s export crash login sync when I click it','closed',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(404,5,105,105,'sync typo sync button','It''s sync typo sync button when I click it','in_progress',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(405,6,106,NULL,'login typo slow import','It''s login typo slow import when I click it','in_progress',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(406,7,107,107,'sync sync import import','It''s sync sync import import when I click it','in_progress',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(407,8,108,108,'sync sync export sync','It''s sync sync export sync when I click it','in_progress',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(408,9,109,NULL,'page error import error','It''s page error import error when I click it','closed',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(409,10,110,110,'typo sync import error','It''s typo sync import error when I click it','in_progress',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(410,11,111,111,'error crash sync crash','It''s error crash sync crash when I click it','in_progress',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(411,12,112,NULL,'export button slow import','It''s export button slow import when I click it','in_progress',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(412,13,113,113,'slow export button page','It''s slow export button page when I click it','in_progress',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(413,14,114,114,'typo crash login button','It''s typo crash login button when I click it','open',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(414,15,115,NULL,'error import crash error','It''s error import crash error when I click it','in_progress',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(415,16,116,116,'crash button button error','It''s crash button button error when I click it','closed',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(416,17,117,117,'crash slow login button','It''s crash slow login button when I click it','open',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(417,18,118,NULL,'login page error typo','It''s login page error typo when I click it','closed',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(418,19,119,119,'button slow crash page','It''s button slow crash page when I click it','closed',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(419,20,120,120,'sync button error error','It''s sync button error error when I click it','in_progress',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(420,21,121,NULL,'typo import export login','It''s typo import export login when I click it','open',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(421,22,122,122,'typo slow sync crash','It''s typo slow sync crash when I click it','closed',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(422,23,123,123,'slow slow slow typo','It''s slow slow slow typo when I click it','in_progress',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(423,24,124,NULL,'slow sync page error','It''s slow sync page error when I click it','closed',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(424,25,125,125,'button crash error export','It''s button crash error export when I click it','in_progress',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(425,26,126,126,'page page crash button','It''s page page crash button when I click it','in_progress',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(426,27,127,NULL,'import button import export','It''s import button import export when I click it','in_progress',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(427,28,128,128,'sync login sync error','It''s sync login sync error when I click it','closed',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(428,29,129,129,'error typo crash error','It''s error typo crash error when I click it','open',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(429,30,130,NULL,'export login import slow','It''s export login import slow when I click it','closed',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(430,31,131,131,'error typo button slow','It''s error typo button slow when I click it','open',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(431,32,132,132,'login sync import import','It''s login sync import import when I click it','closed',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(432,33,133,NULL,'page page error crash','It''s page page error crash when I click it','open',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(433,34,134,134,'slow crash crash typo','It''s slow crash crash typo when I click it','closed',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(434,35,135,135,'crash login crash crash','It''s crash login crash crash when I click it','open',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(435,36,136,NULL,'import button button crash','It''s import button button crash when I click it','closed',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(436,37,137,137,'crash import slow export','It''s crash import slow export when I click it','open',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(437,38,138,138,'error error sync import','It''s error error sync import when I click it','closed',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(438,39,139,NULL,'error slow page slow','It''s error slow page slow when I click it','in_progress',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(439,40,140,140,'crash slow import export','It''s crash slow import export when I click it','open',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(440,1,141,141,'button button typo login','It''s button button typo login when I click it','open',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(441,2,142,NULL,'sync page import login','It''s sync page import login when I click it','open',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(442,3,143,143,'slow slow page error','It''s slow slow page error when I click it','open',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(443,4,144,144,'crash button page login','It''s crash button page login when I click it','in_progress',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(444,5,145,NULL,'page slow crash error','It''s page slow crash error when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(445,6,146,146,'crash sync login export','It''s crash sync login export when I click it','open',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(446,7,147,147,'slow page login crash','It''s slow page login crash when I click it','open',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(447,8,148,NULL,'crash login login slow','It''s crash login login slow when I click it','in_progress',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(448,9,149,149,'error import typo slow','It''s error import typo slow when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(449,10,150,150,'crash error slow button','It''s crash error slow button when I click it','in_progress',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(450,11,151,NULL,'button export sync typo','It''s button export sync typo when I click it','closed',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(451,12,152,152,'typo login typo slow','It''s typo login typo slow when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(452,13,153,153,'button page sync login','It''s button page sync login when I click it','open',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(453,14,154,NULL,'login typo error import','It''s login typo error import when I click it','in_progress',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(454,15,155,155,'button button typo export','It''s button button typo export when I click it','in_progress',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(455,16,156,156,'button button typo export','It''s button button typo export when I click it','closed',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(456,17,157,NULL,'crash button page error','It''s crash button page error when I click it','open',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(457,18,158,158,'error sync page import','It''s error sync page import when I click it','closed',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(458,19,159,159,'page page export page','It''s page page export page when I click it','open',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(459,20,160,NULL,'page login sync error','It''s page login sync error when I click it','open',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(460,21,161,161,'button button page error','It''s button button page error when I click it','in_progress',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(461,22,162,162,'error login typo page','It''s error login typo page when I click it','closed',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(462,23,163,NULL,'button export login page','It''s button export login page when I click it','closed',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(463,24,164,164,'button login page export','It''s button login page export when I click it','closed',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(464,25,165,165,'crash crash slow button','It''s crash crash slow button when I click it','closed',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(465,26,166,NULL,'button import button import','It''s button import button import when I click it','closed',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(466,27,167,167,'button button login page','It''s button button login page when I click it','in_progress',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(467,28,168,168,'crash error sync slow','It''s crash error sync slow when I click it','open',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(468,29,169,NULL,'slow error button sync','It''s slow error button sync when I click it','in_progress',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(469,30,170,170,'slow button crash slow','It''s slow button crash slow when I click it','in_progress',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(470,31,171,171,'sync crash slow login','It''s sync crash slow login when I click it','open',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(471,32,172,NULL,'slow button import error','It''s slow button import error when I click it','open',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(472,33,173,173,'page slow login error','It''s page slow login error when I click it','closed',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(473,34,174,174,'import import import sync','It''s import import import sync when I click it','closed',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(474,35,175,NULL,'typo export sync import','It''s typo export sync import when I click it','in_progress',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(475,36,176,176,'page import button slow','It''s page import button slow when I click it','in_progress',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(476,37,177,177,'login error slow slow','It''s login error slow slow when I click it','open',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(477,38,178,NULL,'page slow crash page','It''s page slow crash page when I click it','in_progress',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(478,39,179,179,'button page crash button','It''s button page crash button when I click it','open',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(479,40,180,180,'sync slow slow login','It''s sync slow slow login when I click it','in_progress',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(480,1,181,NULL,'slow sync button page','It''s slow sync button page when I click it','closed',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(481,2,182,182,'crash slow button export','It''s crash slow button export when I click it','closed',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(482,3,183,183,'crash crash button export','It''s crash crash button export when I click it','closed',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(483,4,184,NULL,'button page export login','It''s button page export login when I click it','closed',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(484,5,185,185,'button sync error sync','It''s button sync error sync when I click it','in_progress',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(485,6,186,186,'sync login export button','It''s sync login export button when I click it','in_progress',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(486,7,187,NULL,'login error login button','It''s login error login button when I click it','open',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(487,8,188,188,'page button slow button','It''s page button slow button when I click it','in_progress',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(488,9,189,189,'error error export typo','It''s error error export typo when I click it','open',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(489,10,190,NULL,'error page error crash','It''s error page error crash when I click it','open',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(490,11,191,191,'typo typo sync slow','It''s typo typo sync slow when I click it','in_progress',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(491,12,192,192,'button sync export sync','It''s button sync export sync when I click it','in_progress',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(492,13,193,NULL,'sync error page button','It''s sync error page button when I click it','open',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(493,14,194,194,'button login typo button','It''s button login typo button when I click it','closed',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(494,15,195,195,'sync slow typo export','It''s sync slow typo export when I click it','open',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(495,16,196,NULL,'export slow crash slow','It''s export slow crash slow when I click it','open',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(496,17,197,197,'login crash import import','It''s login crash import import when I click it','in_progress',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(497,18,198,198,'sync export button import','It''s sync export button import when I click it','open',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(498,19,199,NULL,'sync export typo crash','It''s sync export typo crash when I click it','in_progress',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(499,20,200,200,'import import export page','It''s import import export page when I click it','closed',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(500,21,201,201,'sync button crash button','It''s sync button crash button when I click it','in_progress',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(501,22,202,NULL,'export slow import error','It''s export slow import error when I click it','open',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(502,23,203,203,'export button slow page','It''s export button slow page when I click it','open',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(503,24,204,204,'export crash button sync','It''s export crash button sync when I click it','in_progress',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(504,25,205,NULL,'page sync export export','It''s page sync export export when I click it','open',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(505,26,206,206,'sync slow sync crash','It''s sync slow sync crash when I click it','in_progress',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(506,27,207,207,'page import slow typo','It''s page import slow typo when I click it','in_progress',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(507,28,208,NULL,'login button error page','It''s login button error page when I click it','open',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(508,29,209,209,'button page page sync','It''s button page page sync when I click it','closed',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(509,30,210,210,'error slow import typo','It''s error slow import typo when I click it','in_progress',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(510,31,211,NULL,'export import import error','It''s export import import error when I click it','open',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(511,32,212,212,'import sync import error','It''s import sync import error when I click it','closed',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(512,33,213,213,'slow error page crash','It''s slow error page crash when I click it','in_progress',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(513,34,214,NULL,'login typo typo import','It''s login typo typo import when I click it','closed',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(514,35,215,215,'page sync export export','It''s page sync export export when I click it','closed',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(515,36,216,216,'export button slow crash','It''s export button slow crash when I click it','open',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(516,37,217,NULL,'login login import typo','It''s login login import typo when I click it','open',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(517,38,218,218,'export typo page export','It''s export typo page export when I click it','in_progress',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(518,39,219,219,'import sync crash sync','It''s import sync crash sync when I click it','open',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(519,40,220,NULL,'sync export export typo','It''s sync export export typo when I click it','in_progress',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(520,1,221,221,'button page sync error','It''s button page sync error when I click it','open',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(521,2,222,222,'crash import crash login','It''s crash import crash login when I click it','closed',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(522,3,223,NULL,'slow export button export','It''s slow export button export when I click it','in_progress',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(523,4,224,224,'login typo crash export','It''s login typo crash export when I click it','in_progress',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(524,5,225,225,'typo export button import','It''s typo export button import when I click it','open',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(525,6,226,NULL,'page typo import typo','It''s page typo import typo when I click it','closed',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(526,7,227,227,'export import page button','It''s export import page button when I click it','open',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(527,8,228,228,'button page sync slow','It''s button page sync slow when I click it','open',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(528,9,229,NULL,'slow export page login','It''s slow export page login when I click it','closed',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(529,10,230,230,'login typo crash export','It''s login typo crash export when I click it','open',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(530,11,231,231,'button import button error','It''s button import button error when I click it','in_progress',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(531,12,232,NULL,'crash typo export export','It''s crash typo export export when I click it','in_progress',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(532,13,233,233,'error sync typo button','It''s error sync typo button when I click it','in_progress',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(533,14,234,234,'page login export page','It''s page login export page when I click it','in_progress',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(534,15,235,NULL,'page export login import','It''s page export login import when I click it','open',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(535,16,236,236,'import button button export','It''s import button button export when I click it','closed',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(536,17,237,237,'import button sync button','It''s import button sync button when I click it','closed',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(537,18,238,NULL,'sync export button export','It''s sync export button export when I click it','closed',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(538,19,239,239,'typo import slow page','It''s typo import slow page when I click it','open',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(539,20,240,240,'import slow sync slow','It''s import slow sync slow when I click it','open',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(540,21,241,NULL,'button sync crash button','It''s button sync crash button when I click it','in_progress',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(541,22,242,242,'crash button button button','It''s crash button button button when I click it','closed',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(542,23,243,243,'sync typo slow error','It''s sync typo slow error when I click it','open',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(543,24,244,NULL,'button typo typo page','It''s button typo typo page when I click it','open',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(544,25,245,245,'typo button sync sync','It''s typo button sync sync when I click it','open',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(545,26,246,246,'slow login sync button','It''s slow login sync button when I click it','in_progress',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(546,27,247,NULL,'slow error login typo','It''s slow error login typo when I click it','open',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(547,28,248,248,'button login typo page','It''s button login typo page when I click it','open',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(548,29,249,249,'import page button page','It''s import page button page when I click it','in_progress',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(549,30,250,NULL,'typo button import import','It''s typo button import import when I click it','in_progress',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(550,31,251,251,'slow slow page page','It''s slow slow page page when I click it','closed',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(551,32,252,252,'page page login export','It''s page page login export when I click it','closed',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(552,33,253,NULL,'import page typo page','It''s import page typo page when I click it','in_progress',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(553,34,254,254,'sync button sync page','It''s sync button sync page when I click it','open',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(554,35,255,255,'button page slow slow','It''s button page slow slow when I click it','closed',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(555,36,256,NULL,'export sync export crash','It''s export sync export crash when I click it','closed',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(556,37,257,257,'login page import export','It''s login page import export when I click it','closed',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(557,38,258,258,'page slow button page','It''s page slow button page when I click it','in_progress',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(558,39,259,NULL,'button login typo export','It''s button login typo export when I click it','open',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(559,40,260,260,'import export slow slow','It''s import export slow slow when I click it','open',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(560,1,261,261,'crash error crash error','It''s crash error crash error when I click it','closed',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(561,2,262,NULL,'slow login login login','It''s slow login login login when I click it','in_progress',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(562,3,263,263,'button login export sync','It''s button login export sync when I click it','closed',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(563,4,264,264,'export error page typo','It''s export error page typo when I click it','in_progress',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(564,5,265,NULL,'button typo typo typo','It''s button typo typo typo when I click it','in_progress',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(565,6,266,266,'import slow slow login','It''s import slow slow login when I click it','open',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(566,7,267,267,'slow slow crash slow','It''s slow slow crash slow when I click it','closed',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(567,8,268,NULL,'typo export sync export','It''s typo export sync export when I click it','closed',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(568,9,269,269,'login crash page import','It''s login crash page import when I click it','open',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(569,10,270,270,'crash typo error typo','It''s crash typo error typo when I click it','open',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(570,11,271,NULL,'slow button typo button','It''s slow button typo button when I click it','closed',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(571,12,272,272,'crash import export page','It''s crash import export page when I click it','closed',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(572,13,273,273,'import button sync crash','It''s import button sync crash when I click it','in_progress',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(573,14,274,NULL,'login slow login typo','It''s login slow login typo when I click it','open',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(574,15,275,275,'crash button page page','It''s crash button page page when I click it','in_progress',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(575,16,276,276,'crash export crash export','It''s crash export crash export when I click it','open',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(576,17,277,NULL,'sync typo login export','It''s sync typo login export when I click it','closed',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(577,18,278,278,'sync import login page','It''s sync import login page when I click it','closed',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(578,19,279,279,'typo sync import typo','It''s typo sync import typo when I click it','open',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(579,20,280,NULL,'import typo export button','It''s import typo export button when I click it','in_progress',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(580,21,281,281,'login login slow sync','It''s login login slow sync when I click it','closed',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(581,22,282,282,'button login login button','It''s button login login button when I click it','open',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(582,23,283,NULL,'slow login sync login','It''s slow login sync login when I click it','open',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(583,24,284,284,'import typo slow login','It''s import typo slow login when I click it','in_progress',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(584,25,285,285,'export sync crash sync','It''s export sync crash sync when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(585,26,286,NULL,'import error typo crash','It''s import error typo crash when I click it','closed',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(586,27,287,287,'sync crash error sync','It''s sync crash error sync when I click it','in_progress',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(587,28,288,288,'export slow error button','It''s export slow error button when I click it','in_progress',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(588,29,289,NULL,'export import crash error','It''s export import crash error when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(589,30,290,290,'page export export error','It''s page export export error when I click it','closed',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(590,31,291,291,'sync page button import','It''s sync page button import when I click it','closed',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(591,32,292,NULL,'typo typo import sync','It''s typo typo import sync when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(592,33,293,293,'export slow error crash','It''s export slow error crash when I click it','open',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(593,34,294,294,'page export login button','It''s page export login button when I click it','in_progress',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(594,35,295,NULL,'error import error page','It''s error import error page when I click it','open',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(595,36,296,296,'import page export crash','It''s import page export crash when I click it','in_progress',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(596,37,297,297,'export sync button import','It''s export sync button import when I click it','in_progress',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(597,38,298,NULL,'page crash import slow','It''s page crash import slow when I click it','in_progress',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(598,39,299,299,'sync login export error','It''s sync login export error when I click it','open',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(599,40,300,300,'error import crash sync','It''s error import crash sync when I click it','in_progress',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(600,1,1,NULL,'login login button sync','It''s login login button sync when I click it','closed',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(601,2,2,2,'sync export login sync','It''s sync export login sync when I click it','in_progress',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(602,3,3,3,'import button sync crash','It''s import button sync crash when I click it','open',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(603,4,4,NULL,'page crash sync login','It''s page crash sync login when I click it','open',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(604,5,5,5,'login import import error','It''s login import import error when I click it','open',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(605,6,6,6,'page import page slow','It''s page import page slow when I click it','open',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(606,7,7,NULL,'login import button sync','It''s login import button sync when I click it','in_progress',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(607,8,8,8,'error sync page error','It''s error sync page error when I click it','closed',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(608,9,9,9,'slow login sync error','It''s slow login sync error when I click it','open',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(609,10,10,NULL,'crash typo sync error','It''s crash typo sync error when I click it','in_progress',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(610,11,11,11,'typo typo login page','It''s typo typo login page when I click it','open',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(611,12,12,12,'crash typo typo button','It''s crash typo typo button when I click it','in_progress',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(612,13,13,NULL,'import page page slow','It''s import page page slow when I click it','open',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(613,14,14,14,'crash button login export','It''s crash button login export when I click it','in_progress',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(614,15,15,15,'slow slow error page','It''s slow slow error page when I click it','closed',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(615,16,16,NULL,'import typo login export','It''s import typo login export when I click it','closed',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(616,17,17,17,'sync crash export error','It''s sync crash export error when I click it','in_progress',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(617,18,18,18,'error slow page typo','It''s error slow page typo when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(618,19,19,NULL,'crash typo export import','It''s crash typo export import when I click it','open',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(619,20,20,20,'page slow export login','It''s page slow export login when I click it','in_progress',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(620,21,21,21,'sync typo slow import','It''s sync typo slow import when I click it','in_progress',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(621,22,22,NULL,'login slow slow export','It''s login slow slow export when I click it','closed',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(622,23,23,23,'login page export button','It''s login page export button when I click it','closed',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(623,24,24,24,'sync sync typo typo','It''s sync sync typo typo when I click it','closed',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(624,25,25,NULL,'typo crash typo page','It''s typo crash typo page when I click it','in_progress',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(625,26,26,26,'page crash error typo','It''s page crash error typo when I click it','closed',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(626,27,27,27,'typo login slow sync','It''s typo login slow sync when I click it','in_progress',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(627,28,28,NULL,'export sync typo error','It''s export sync typo error when I click it','closed',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(628,29,29,29,'login button page import','It''s login button page import when I click it','closed',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(629,30,30,30,'import error crash import','It''s import error crash import when I click it','closed',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(630,31,31,NULL,'login button export error','It''s login button export error when I click it','open',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(631,32,32,32,'error page login typo','It''s error page login typo when I click it','closed',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(632,33,33,33,'typo crash export sync','It''s typo crash export sync when I click it','closed',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(633,34,34,NULL,'page import typo export','It''s page import typo export when I click it','open',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(634,35,35,35,'import crash typo crash','It''s import crash typo crash when I click it','in_progress',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(635,36,36,36,'sync login slow crash','It''s sync login slow crash when I click it','in_progress',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(636,37,37,NULL,'login error sync crash','It''s login error sync crash when I click it','in_progress',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(637,38,38,38,'crash login login crash','It''s crash login login crash when I click it','closed',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(638,39,39,39,'error button error login','It''s error button error login when I click it','closed',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(639,40,40,NULL,'export sync button button','It''s export sync button button when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(640,1,41,41,'button import slow button','It''s button import slow button when I click it','closed',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(641,2,42,42,'slow slow slow error','It''s slow slow slow error when I click it','in_progress',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(642,3,43,NULL,'import button error sync','It''s import button error sync when I click it','open',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(643,4,44,44,'export error slow page','It''s export error slow page when I click it','open',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(644,5,45,45,'page login error typo','It''s page login error typo when I click it','open',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(645,6,46,NULL,'page page import sync','It''s page page import sync when I click it','open',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(646,7,47,47,'button typo slow page','It''s button typo slow page when I click it','open',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(647,8,48,48,'export slow typo login','It''s export slow typo login when I click it','closed',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(648,9,49,NULL,'error slow error import','It''s error slow error import when I click it','closed',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(649,10,50,50,'export button login login','It''s export button login login when I click it','open',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(650,11,51,51,'slow login import export','It''s slow login import export when I click it','closed',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(651,12,52,NULL,'import export crash sync','It''s import export crash sync when I click it','open',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(652,13,53,53,'export typo import login','It''s export typo import login when I click it','open',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(653,14,54,54,'crash slow error slow','It''s crash slow error slow when I click it','closed',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(654,15,55,NULL,'sync error error error','It''s sync error error error when I click it','in_progress',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(655,16,56,56,'error error crash crash','It''s error error crash crash when I click it','open',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(656,17,57,57,'export crash slow login','It''s export crash slow login when I click it','in_progress',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(657,18,58,NULL,'export error login slow','It''s export error login slow when I click it','closed',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(658,19,59,59,'login slow crash slow','It''s login slow crash slow when I click it','closed',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(659,20,60,60,'page sync sync crash','It''s page sync sync crash when I click it','in_progress',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(660,21,61,NULL,'crash import slow export','It''s crash import slow export when I click it','open',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(661,22,62,62,'import crash slow page','It''s import crash slow page when I click it','open',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(662,23,63,63,'crash page button sync','It''s crash page button sync when I click it','open',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(663,24,64,NULL,'import import error slow','It''s import import error slow when I click it','in_progress',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(664,25,65,65,'crash import error button','It''s crash import error button when I click it','in_progress',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(665,26,66,66,'import typo typo import','It''s import typo typo import when I click it','closed',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(666,27,67,NULL,'import export error login','It''s import export error login when I click it','open',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(667,28,68,68,'export sync typo page','It''s export sync typo page when I click it','closed',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(668,29,69,69,'slow import crash import','It''s slow import crash import when I click it','open',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(669,30,70,NULL,'button page slow button','It''s button page slow button when I click it','in_progress',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(670,31,71,71,'crash typo sync export','It''s crash typo sync export when I click it','closed',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(671,32,72,72,'login crash page import','It''s login crash page import when I click it','in_progress',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(672,33,73,NULL,'import typo import error','It''s import typo import error when I click it','closed',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(673,34,74,74,'crash slow slow error','It''s crash slow slow error when I click it','closed',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(674,35,75,75,'typo error import crash','It''s typo error import crash when I click it','closed',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(675,36,76,NULL,'error slow import import','It''s error slow import import when I click it','closed',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(676,37,77,77,'import page slow login','It''s import page slow login when I click it','open',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(677,38,78,78,'export page crash typo','It''s export page crash typo when I click it','in_progress',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(678,39,79,NULL,'crash page login crash','It''s crash page login crash when I click it','closed',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(679,40,80,80,'sync typo export page','It''s sync typo export page when I click it','open',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(680,1,81,81,'sync export login typo','It''s sync export login typo when I click it','open',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(681,2,82,NULL,'login page button import','It''s login page button import when I click it','in_progress',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(682,3,83,83,'export import button typo','It''s export import button typo when I click it','closed',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(683,4,84,84,'slow export error typo','It''s slow export error typo when I click it','in_progress',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(684,5,85,NULL,'typo sync slow typo','It''s typo sync slow typo when I click it','closed',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(685,6,86,86,'login page sync button','It''s login page sync button when I click it','open',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(686,7,87,87,'crash typo sync export','It''s crash typo sync export when I click it','open',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(687,8,88,NULL,'export login slow export','It''s export login slow export when I click it','in_progress',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(688,9,89,89,'import login button crash','It''s import login button crash when I click it','in_progress',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(689,10,90,90,'sync import sync button','It''s sync import sync button when I click it','open',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(690,11,91,NULL,'sync page typo error','It''s sync page typo error when I click it','closed',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(691,12,92,92,'export slow export typo','It''s export slow export typo when I click it','open',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(692,13,93,93,'import error login error','It''s import error login error when I click it','in_progress',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(693,14,94,NULL,'crash sync login button','It''s crash sync login button when I click it','closed',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(694,15,95,95,'import page slow error','It''s import page slow error when I click it','closed',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(695,16,96,96,'login page export button','It''s login page export button when I click it','in_progress',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(696,17,97,NULL,'export export login sync','It''s export export login sync when I click it','in_progress',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(697,18,98,98,'sync login crash crash','It''s sync login crash crash when I click it','open',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(698,19,99,99,'error crash error error','It''s error crash error error when I click it','open',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(699,20,100,NULL,'import export sync button','It''s import export sync button when I click it','open',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(700,21,101,101,'export button slow slow','It''s export button slow slow when I click it','in_progress',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(701,22,102,102,'crash crash export import','It''s crash crash export import when I click it','open',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(702,23,103,NULL,'typo page page slow','It''s typo page page slow when I click it','open',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(703,24,104,104,'typo crash page button','It''s typo crash page button when I click it','open',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(704,25,105,105,'export import sync import','It''s export import sync import when I click it','open',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(705,26,106,NULL,'crash typo slow error','It''s crash typo slow error when I click it','closed',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(706,27,107,107,'import export slow crash','It''s import export slow crash when I click it','closed',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(707,28,108,108,'typo typo typo import','It''s typo typo typo import when I click it','in_progress',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(708,29,109,NULL,'error export button sync','It''s error export button sync when I click it','open',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(709,30,110,110,'login export typo page','It''s login export typo page when I click it','in_progress',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(710,31,111,111,'page import import import','It''s page import import import when I click it','closed',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(711,32,112,NULL,'import sync page error','It''s import sync page error when I click it','in_progress',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(712,33,113,113,'export error button export','It''s export error button export when I click it','in_progress',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(713,34,114,114,'import typo error slow','It''s import typo error slow when I click it','in_progress',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(714,35,115,NULL,'import import import slow','It''s import import import slow when I click it','in_progress',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(715,36,116,116,'crash login error typo','It''s crash login error typo when I click it','open',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(716,37,117,117,'error sync error export','It''s error sync error export when I click it','open',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(717,38,118,NULL,'page export login slow','It''s page export login slow when I click it','open',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(718,39,119,119,'login typo crash page','It''s login typo crash page when I click it','open',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(719,40,120,120,'login export import export','It''s login export import export when I click it','open',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(720,1,121,NULL,'crash error crash import','It''s crash error crash import when I click it','in_progress',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(721,2,122,122,'slow button sync export','It''s slow button sync export when I click it','open',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(722,3,123,123,'button button typo typo','It''s button button typo typo when I click it','in_progress',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(723,4,124,NULL,'login slow export import','It''s login slow export import when I click it','in_progress',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(724,5,125,125,'typo typo typo sync','It''s typo typo typo sync when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(725,6,126,126,'page page crash button','It''s page page crash button when I click it','in_progress',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(726,7,127,NULL,'typo login sync button','It''s typo login sync button when I click it','closed',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(727,8,128,128,'page page login import','It''s page page login import when I click it','open',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(728,9,129,129,'export slow button sync','It''s export slow button sync when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(729,10,130,NULL,'page slow error page','It''s page slow error page when I click it','closed',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(730,11,131,131,'page typo typo export','It''s page typo typo export when I click it','in_progress',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(731,12,132,132,'crash import login crash','It''s crash import login crash when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(732,13,133,NULL,'slow page slow typo','It''s slow page slow typo when I click it','in_progress',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(733,14,134,134,'import sync error typo','It''s import sync error typo when I click it','closed',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(734,15,135,135,'button export button login','It''s button export button login when I click it','closed',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(735,16,136,NULL,'sync crash page import','It''s sync crash page import when I click it','closed',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(736,17,137,137,'export page login crash','It''s export page login crash when I click it','open',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(737,18,138,138,'crash page error slow','It''s crash page error slow when I click it','closed',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(738,19,139,NULL,'export typo import import','It''s export typo import import when I click it','in_progress',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(739,20,140,140,'error import typo login','It''s error import typo login when I click it','closed',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(740,21,141,141,'typo export slow login','It''s typo export slow login when I click it','closed',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(741,22,142,NULL,'button page sync crash','It''s button page sync crash when I click it','closed',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(742,23,143,143,'typo crash error button','It''s typo crash error button when I click it','closed',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(743,24,144,144,'crash sync export button','It''s crash sync export button when I click it','closed',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(744,25,145,NULL,'crash import button typo','It''s crash import button typo when I click it','closed',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(745,26,146,146,'crash sync export login','It''s crash sync export login when I click it','in_progress',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(746,27,147,147,'typo login sync crash','It''s typo login sync crash when I click it','open',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(747,28,148,NULL,'import sync typo button','It''s import sync typo button when I click it','open',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(748,29,149,149,'typo crash page error','It''s typo crash page error when I click it','closed',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(749,30,150,150,'sync typo page sync','It''s sync typo page sync when I click it','in_progress',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(750,31,151,NULL,'error sync sync error','It''s error sync sync error when I click it','closed',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(751,32,152,152,'crash typo import sync','It''s crash typo import sync when I click it','in_progress',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(752,33,153,153,'page button page export','It''s page button page export when I click it','in_progress',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(753,34,154,NULL,'sync import page import','It''s sync import page import when I click it','closed',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(754,35,155,155,'login sync sync sync','It''s login sync sync sync when I click it','in_progress',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(755,36,156,156,'error typo export crash','It''s error typo export crash when I click it','closed',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(756,37,157,NULL,'error page error typo','It''s error page error typo when I click it','in_progress',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(757,38,158,158,'login error crash login','It''s login error crash login when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(758,39,159,159,'login export page export','It''s login export page export when I click it','open',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(759,40,160,NULL,'slow crash slow login','It''s slow crash slow login when I click it','open',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(760,1,161,161,'login crash sync login','It''s login crash sync login when I click it','open',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(761,2,162,162,'error typo page button','It''s error typo page button when I click it','open',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(762,3,163,NULL,'crash typo sync sync','It''s crash typo sync sync when I click it','open',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(763,4,164,164,'page import sync export','It''s page import sync export when I click it','open',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(764,5,165,165,'button sync typo import','It''s button sync typo import when I click it','closed',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(765,6,166,NULL,'page button import login','It''s page button import login when I click it','closed',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(766,7,167,167,'crash crash sync error','It''s crash crash sync error when I click it','open',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(767,8,168,168,'export login crash crash','It''s export login crash crash when I click it','closed',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(768,9,169,NULL,'error import error sync','It''s error import error sync when I click it','closed',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(769,10,170,170,'error export typo login','It''s error export typo login when I click it','closed',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(770,11,171,171,'slow error page import','It''s slow error page import when I click it','closed',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(771,12,172,NULL,'crash button export login','It''s crash button export login when I click it','in_progress',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(772,13,173,173,'page error login button','It''s page error login button when I click it','in_progress',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(773,14,174,174,'slow button sync page','It''s slow button sync page when I click it','closed',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(774,15,175,NULL,'slow sync crash slow','It''s slow sync crash slow when I click it','closed',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(775,16,176,176,'export button page typo','It''s export button page typo when I click it','closed',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(776,17,177,177,'button typo sync export','It''s button typo sync export when I click it','open',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(777,18,178,NULL,'error crash import error','It''s error crash import error when I click it','closed',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(778,19,179,179,'import button slow slow','It''s import button slow slow when I click it','open',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(779,20,180,180,'slow typo button error','It''s slow typo button error when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(780,21,181,NULL,'export import page typo','It''s export import page typo when I click it','in_progress',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(781,22,182,182,'login import error login','It''s login import error login when I click it','open',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(782,23,183,183,'login typo typo page','It''s login typo typo page when I click it','open',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(783,24,184,NULL,'export import slow page','It''s export import slow page when I click it','open',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(784,25,185,185,'error button button button','It''s error button button button when I click it','closed',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(785,26,186,186,'error sync page crash','It''s error sync page crash when I click it','open',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(786,27,187,NULL,'error export sync import','It''s error export sync import when I click it','open',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(787,28,188,188,'button crash page slow','It''s button crash page slow when I click it','in_progress',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(788,29,189,189,'slow login typo button','It''s slow login typo button when I click it','closed',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(789,30,190,NULL,'button slow login crash','It''s button slow login crash when I click it','in_progress',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(790,31,191,191,'button sync button typo','It''s button sync button typo when I click it','in_progress',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(791,32,192,192,'sync error typo sync','It''s sync error typo sync when I click it','in_progress',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(792,33,193,NULL,'button sync login typo','It''s button sync login typo when I click it','open',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(793,34,194,194,'sync export button error','It''s sync export button error when I click it','closed',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(794,35,195,195,'crash login sync import','It''s crash login sync import when I click it','open',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(795,36,196,NULL,'page sync slow import','It''s page sync slow import when I click it','in_progress',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(796,37,197,197,'error typo typo sync','It''s error typo typo sync when I click it','open',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(797,38,198,198,'login typo page sync','It''s login typo page sync when I click it','open',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(798,39,199,NULL,'export typo export login','It''s export typo export login when I click it','in_progress',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(799,40,200,200,'page export slow error','It''s page export slow error when I click it','closed',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(800,1,201,201,'crash error error page','It''s crash error error page when I click it','in_progress',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(801,2,202,NULL,'import error export page','It''s import error export page when I click it','in_progress',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(802,3,203,203,'button import button slow','It''s button import button slow when I click it','in_progress',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(803,4,204,204,'crash error import sync','It''s crash error import sync when I click it','in_progress',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(804,5,205,NULL,'export error error page','It''s export error error page when I click it','in_progress',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(805,6,206,206,'error button page error','It''s error button page error when I click it','in_progress',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(806,7,207,207,'export export page typo','It''s export export page typo when I click it','open',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(807,8,208,NULL,'login sync slow button','It''s login sync slow button when I click it','open',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(808,9,209,209,'import error crash typo','It''s import error crash typo when I click it','open',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(809,10,210,210,'sync button page crash','It''s sync button page crash when I click it','in_progress',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(810,11,211,NULL,'slow sync button import','It''s slow sync button import when I click it','in_progress',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(811,12,212,212,'slow import login crash','It''s slow import login crash when I click it','in_progress',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(812,13,213,213,'crash export crash page','It''s crash export crash page when I click it','closed',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(813,14,214,NULL,'error sync slow typo','It''s error sync slow typo when I click it','in_progress',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(814,15,215,215,'page crash crash export','It''s page crash crash export when I click it','in_progress',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(815,16,216,216,'import login typo error','It''s import login typo error when I click it','in_progress',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(816,17,217,NULL,'crash slow button typo','It''s crash slow button typo when I click it','closed',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(817,18,218,218,'sync export sync slow','It''s sync export sync slow when I click it','closed',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(818,19,219,219,'import login button typo','It''s import login button typo when I click it','closed',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(819,20,220,NULL,'page slow import export','It''s page slow import export when I click it','open',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(820,21,221,221,'typo typo slow error','It''s typo typo slow error when I click it','open',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(821,22,222,222,'error crash error login','It''s error crash error login when I click it','open',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(822,23,223,NULL,'sync error export typo','It''s sync error export typo when I click it','in_progress',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(823,24,224,224,'login error crash export','It''s login error crash export when I click it','open',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(824,25,225,225,'error import slow page','It''s error import slow page when I click it','open',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(825,26,226,NULL,'typo import crash sync','It''s typo import crash sync when I click it','closed',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(826,27,227,227,'error crash typo button','It''s error crash typo button when I click it','open',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(827,28,228,228,'error page sync slow','It''s error page sync slow when I click it','open',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(828,29,229,NULL,'page sync typo import','It''s page sync typo import when I click it','closed',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(829,30,230,230,'crash slow typo crash','It''s crash slow typo crash when I click it','closed',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(830,31,231,231,'crash import typo sync','It''s crash import typo sync when I click it','open',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(831,32,232,NULL,'crash typo typo slow','It''s crash typo typo slow when I click it','open',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(832,33,233,233,'slow login sync export','It''s slow login sync export when I click it','closed',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(833,34,234,234,'import button error slow','It''s import button error slow when I click it','closed',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(834,35,235,NULL,'sync error typo slow','It''s sync error typo slow when I click it','closed',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(835,36,236,236,'error sync error page','It''s error sync error page when I click it','closed',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(836,37,237,237,'error button sync error','It''s error button sync error when I click it','closed',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(837,38,238,NULL,'slow slow import crash','It''s slow slow import crash when I click it','open',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(838,39,239,239,'slow error page button','It''s slow error page button when I click it','open',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(839,40,240,240,'page crash sync slow','It''s page crash sync slow when I click it','in_progress',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(840,1,241,NULL,'error error slow error','It''s error error slow error when I click it','in_progress',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(841,2,242,242,'crash crash page export','It''s crash crash page export when I click it','in_progress',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(842,3,243,243,'error button typo button','It''s error button typo button when I click it','closed',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(843,4,244,NULL,'slow error error error','It''s slow error error error when I click it','in_progress',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(844,5,245,245,'sync page sync button','It''s sync page sync button when I click it','open',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(845,6,246,246,'typo crash login error','It''s typo crash login error when I click it','open',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(846,7,247,NULL,'export slow export error','It''s export slow export error when I click it','open',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(847,8,248,248,'error button crash sync','It''s error button crash sync when I click it','in_progress',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(848,9,249,249,'typo typo typo button','It''s typo typo typo button when I click it','closed',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(849,10,250,NULL,'export slow typo typo','It''s export slow typo typo when I click it','in_progress',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(850,11,251,251,'login login page button','It''s login login page button when I click it','in_progress',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(851,12,252,252,'sync typo typo login','It''s sync typo typo login when I click it','in_progress',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(852,13,253,NULL,'crash typo sync slow','It''s crash typo sync slow when I click it','open',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(853,14,254,254,'slow export typo page','It''s slow export typo page when I click it','closed',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(854,15,255,255,'page slow sync login','It''s page slow sync login when I click it','closed',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(855,16,256,NULL,'button button import export','It''s button button import export when I click it','open',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(856,17,257,257,'typo export sync page','It''s typo export sync page when I click it','open',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(857,18,258,258,'import slow slow page','It''s import slow slow page when I click it','open',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(858,19,259,NULL,'error import crash crash','It''s error import crash crash when I click it','in_progress',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(859,20,260,260,'error slow crash error','It''s error slow crash error when I click it','closed',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(860,21,261,261,'page page login page','It''s page page login page when I click it','closed',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(861,22,262,NULL,'typo error page login','It''s typo error page login when I click it','open',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(862,23,263,263,'page sync typo slow','It''s page sync typo slow when I click it','in_progress',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(863,24,264,264,'import export page sync','It''s import export page sync when I click it','open',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(864,25,265,NULL,'export slow slow login','It''s export slow slow login when I click it','closed',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(865,26,266,266,'page slow slow sync','It''s page slow slow sync when I click it','closed',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(866,27,267,267,'page import error login','It''s page import error login when I click it','closed',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(867,28,268,NULL,'button login button import','It''s button login button import when I click it','in_progress',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(868,29,269,269,'page sync export typo','It''s page sync export typo when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(869,30,270,270,'sync import slow page','It''s sync import slow page when I click it','closed',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(870,31,271,NULL,'sync login typo typo','It''s sync login typo typo when I click it','in_progress',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(871,32,272,272,'slow export import typo','It''s slow export import typo when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(872,33,273,273,'sync page crash crash','It''s sync page crash crash when I click it','in_progress',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(873,34,274,NULL,'sync export page export','It''s sync export page export when I click it','closed',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(874,35,275,275,'button button page export','It''s button button page export when I click it','open',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(875,36,276,276,'import export import error','It''s import export import error when I click it','closed',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(876,37,277,NULL,'slow page sync slow','It''s slow page sync slow when I click it','in_progress',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(877,38,278,278,'login login slow typo','It''s login login slow typo when I click it','closed',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(878,39,279,279,'slow error export crash','It''s slow error export crash when I click it','in_progress',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(879,40,280,NULL,'slow crash error error','It''s slow crash error error when I click it','closed',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(880,1,281,281,'slow typo crash export','It''s slow typo crash export when I click it','in_progress',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(881,2,282,282,'typo slow login page','It''s typo slow login page when I click it','closed',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(882,3,283,NULL,'crash typo page crash','It''s crash typo page crash when I click it','closed',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(883,4,284,284,'export export button import','It''s export export button import when I click it','in_progress',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(884,5,285,285,'crash sync typo typo','It''s crash sync typo typo when I click it','open',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(885,6,286,NULL,'import crash import crash','It''s import crash import crash when I click it','closed',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(886,7,287,287,'slow button crash page','It''s slow button crash page when I click it','in_progress',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(887,8,288,288,'page export page page','It''s page export page page when I click it','in_progress',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(888,9,289,NULL,'import typo login import','It''s import typo login import when I click it','open',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(889,10,290,290,'login typo export button','It''s login typo export button when I click it','open',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(890,11,291,291,'crash import typo slow','It''s crash import typo slow when I click it','in_progress',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(891,12,292,NULL,'slow page slow slow','It''s slow page slow slow when I click it','closed',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(892,13,293,293,'button error export sync','It''s button error export sync when I click it','closed',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(893,14,294,294,'import slow slow error','It''s import slow slow error when I click it','open',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(894,15,295,NULL,'sync crash crash sync','It''s sync crash crash sync when I click it','in_progress',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(895,16,296,296,'login import page export','It''s login import page export when I click it','closed',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(896,17,297,297,'login typo page import','It''s login typo page import when I click it','open',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(897,18,298,NULL,'page button button export','It''s page button button export when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(898,19,299,299,'slow typo export login','It''s slow typo export login when I click it','in_progress',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(899,20,300,300,'button crash error slow','It''s button crash error slow when I click it','closed',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(900,21,1,NULL,'button button crash login','It''s button button crash login when I click it','in_progress',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(901,22,2,2,'typo error page error','It''s typo error page error when I click it','closed',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(902,23,3,3,'slow button button slow','It''s slow button button slow when I click it','open',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(903,24,4,NULL,'crash crash page sync','It''s crash crash page sync when I click it','in_progress',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(904,25,5,5,'button crash error sync','It''s button crash error sync when I click it','closed',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(905,26,6,6,'error login slow crash','It''s error login slow crash when I click it','open',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(906,27,7,NULL,'typo button error export','It''s typo button error export when I click it','open',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(907,28,8,8,'typo login error typo','It''s typo login error typo when I click it','open',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(908,29,9,9,'slow import sync typo','It''s slow import sync typo when I click it','closed',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(909,30,10,NULL,'error crash crash page','It''s error crash crash page when I click it','in_progress',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(910,31,11,11,'page button login sync','It''s page button login sync when I click it','open',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(911,32,12,12,'import import page page','It''s import import page page when I click it','in_progress',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(912,33,13,NULL,'page sync button slow','It''s page sync button slow when I click it','open',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(913,34,14,14,'page sync login page','It''s page sync login page when I click it','open',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(914,35,15,15,'sync error error button','It''s sync error error button when I click it','open',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(915,36,16,NULL,'page crash login export','It''s page crash login export when I click it','closed',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(916,37,17,17,'typo typo error page','It''s typo typo error page when I click it','in_progress',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(917,38,18,18,'typo button export button','It''s typo button export button when I click it','in_progress',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(918,39,19,NULL,'export page error crash','It''s export page error crash when I click it','in_progress',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(919,40,20,20,'slow crash export crash','It''s slow crash export crash when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(920,1,21,21,'login sync export crash','It''s login sync export crash when I click it','closed',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(921,2,22,NULL,'slow page typo sync','It''s slow page typo sync when I click it','in_progress',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(922,3,23,23,'slow sync sync error','It''s slow sync sync error when I click it','open',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(923,4,24,24,'slow page login button','It''s slow page login button when I click it','in_progress',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(924,5,25,NULL,'login login import slow','It''s login login import slow when I click it','open',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(925,6,26,26,'button import import export','It''s button import import export when I click it','closed',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(926,7,27,27,'login typo sync button','It''s login typo sync button when I click it','open',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(927,8,28,NULL,'page sync login button','It''s page sync login button when I click it','open',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(928,9,29,29,'export sync login export','It''s export sync login export when I click it','in_progress',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(929,10,30,30,'slow login login error','It''s slow login login error when I click it','in_progress',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(930,11,31,NULL,'typo button sync button','It''s typo button sync button when I click it','in_progress',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(931,12,32,32,'import sync sync login','It''s import sync sync login when I click it','open',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(932,13,33,33,'typo button import export','It''s typo button import export when I click it','in_progress',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(933,14,34,NULL,'login export button crash','It''s login export button crash when I click it','open',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(934,15,35,35,'error typo page slow','It''s error typo page slow when I click it','in_progress',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(935,16,36,36,'import sync crash page','It''s import sync crash page when I click it','closed',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(936,17,37,NULL,'error crash login error','It''s error crash login error when I click it','closed',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(937,18,38,38,'sync login page import','It''s sync login page import when I click it','in_progress',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(938,19,39,39,'sync slow export typo','It''s sync slow export typo when I click it','open',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(939,20,40,NULL,'page export button import','It''s page export button import when I click it','in_progress',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(940,21,41,41,'typo login sync typo','It''s typo login sync typo when I click it','open',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(941,22,42,42,'import slow typo export','It''s import slow typo export when I click it','open',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(942,23,43,NULL,'sync button import import','It''s sync button import import when I click it','open',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(943,24,44,44,'crash slow slow crash','It''s crash slow slow crash when I click it','in_progress',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(944,25,45,45,'slow slow import import','It''s slow slow import import when I click it','closed',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(945,26,46,NULL,'typo import typo page','It''s typo import typo page when I click it','open',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(946,27,47,47,'crash slow import import','It''s crash slow import import when I click it','open',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(947,28,48,48,'page import login crash','It''s page import login crash when I click it','open',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(948,29,49,NULL,'import error slow button','It''s import error slow button when I click it','in_progress',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(949,30,50,50,'page login error typo','It''s page login error typo when I click it','in_progress',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(950,31,51,51,'sync crash import crash','It''s sync crash import crash when I click it','closed',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(951,32,52,NULL,'export crash button error','It''s export crash button error when I click it','in_progress',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(952,33,53,53,'typo error typo export','It''s typo error typo export when I click it','in_progress',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(953,34,54,54,'login sync crash login','It''s login sync crash login when I click it','in_progress',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(954,35,55,NULL,'login slow login crash','It''s login slow login crash when I click it','open',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(955,36,56,56,'export login slow button','It''s export login slow button when I click it','open',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(956,37,57,57,'error error export export','It''s error error export export when I click it','closed',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(957,38,58,NULL,'import sync import slow','It''s import sync import slow when I click it','closed',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(958,39,59,59,'export typo login crash','It''s export typo login crash when I click it','open',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(959,40,60,60,'error sync export slow','It''s error sync export slow when I click it','closed',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(960,1,61,NULL,'error typo page sync','It''s error typo page sync when I click it','closed',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(961,2,62,62,'typo typo export slow','It''s typo typo export slow when I click it','open',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(962,3,63,63,'export crash error error','It''s export crash error error when I click it','in_progress',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(963,4,64,NULL,'export button login sync','It''s export button login sync when I click it','closed',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(964,5,65,65,'login slow export typo','It''s login slow export typo when I click it','open',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(965,6,66,66,'typo crash page typo','It''s typo crash page typo when I click it','in_progress',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(966,7,67,NULL,'button import page login','It''s button import page login when I click it','closed',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(967,8,68,68,'page crash sync slow','It''s page crash sync slow when I click it','closed',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(968,9,69,69,'import export error error','It''s import export error error when I click it','in_progress',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(969,10,70,NULL,'page crash export typo','It''s page crash export typo when I click it','closed',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(970,11,71,71,'sync button typo button','It''s sync button typo button when I click it','open',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(971,12,72,72,'error slow export sync','It''s error slow export sync when I click it','in_progress',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(972,13,73,NULL,'error sync typo error','It''s error sync typo error when I click it','in_progress',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(973,14,74,74,'export login login button','It''s export login login button when I click it','in_progress',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(974,15,75,75,'error import slow import','It''s error import slow import when I click it','in_progress',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(975,16,76,NULL,'slow page page error','It''s slow page page error when I click it','closed',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(976,17,77,77,'slow typo crash typo','It''s slow typo crash typo when I click it','in_progress',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(977,18,78,78,'slow page login login','It''s slow page login login when I click it','open',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(978,19,79,NULL,'export sync typo slow','It''s export sync typo slow when I click it','in_progress',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(979,20,80,80,'sync typo error crash','It''s sync typo error crash when I click it','in_progress',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(980,21,81,81,'page login typo error','It''s page login typo error when I click it','closed',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(981,22,82,NULL,'error typo import crash','It''s error typo import crash when I click it','closed',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(982,23,83,83,'page login page import','It''s page login page import when I click it','in_progress',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(983,24,84,84,'typo login crash button','It''s typo login crash button when I click it','closed',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(984,25,85,NULL,'button error crash error','It''s button error crash error when I click it','in_progress',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(985,26,86,86,'crash button error import','It''s crash button error import when I click it','closed',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(986,27,87,87,'slow error error page','It''s slow error error page when I click it','in_progress',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(987,28,88,NULL,'import button import login','It''s import button import login when I click it','closed',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(988,29,89,89,'crash page page typo','It''s crash page page typo when I click it','in_progress',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(989,30,90,90,'button export page error','It''s button export page error when I click it','open',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(990,31,91,NULL,'error crash import sync','It''s error crash import sync when I click it','closed',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(991,32,92,92,'crash typo import export','It''s crash typo import export when I click it','open',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(992,33,93,93,'sync import typo login','It''s sync import typo login when I click it','open',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(993,34,94,NULL,'sync sync crash typo','It''s sync sync crash typo when I click it','open',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(994,35,95,95,'export slow button sync','It''s export slow button sync when I click it','open',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(995,36,96,96,'typo export import button','It''s typo export import button when I click it','open',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(996,37,97,NULL,'crash page export export','It''s crash page export export when I click it','in_progress',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(997,38,98,98,'typo import login typo','It''s typo import login typo when I click it','in_progress',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(998,39,99,99,'import import error login','It''s import import error login when I click it','open',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(999,40,100,NULL,'typo page button slow','It''s typo page button slow when I click it','open',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1000,1,101,101,'export button login sync','It''s export button login sync when I click it','closed',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1001,2,102,102,'button login slow sync','It''s button login slow sync when I click it','in_progress',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1002,3,103,NULL,'page page button login','It''s page page button login when I click it','open',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1003,4,104,104,'error import sync login','It''s error import sync login when I click it','in_progress',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1004,5,105,105,'sync export import export','It''s sync export import export when I click it','in_progress',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1005,6,106,NULL,'button import import export','It''s button import import export when I click it','open',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1006,7,107,107,'page crash page error','It''s page crash page error when I click it','open',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1007,8,108,108,'page slow page slow','It''s page slow page slow when I click it','in_progress',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1008,9,109,NULL,'page login export import','It''s page login export import when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1009,10,110,110,'typo typo sync typo','It''s typo typo sync typo when I click it','closed',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1010,11,111,111,'import typo export error','It''s import typo export error when I click it','in_progress',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1011,12,112,NULL,'page slow typo crash','It''s page slow typo crash when I click it','in_progress',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1012,13,113,113,'sync page export slow','It''s sync page export slow when I click it','open',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1013,14,114,114,'typo export crash button','It''s typo export crash button when I click it','closed',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1014,15,115,NULL,'slow page error sync','It''s slow page error sync when I click it','closed',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1015,16,116,116,'sync export button page','It''s sync export button page when I click it','closed',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1016,17,117,117,'login sync typo login','It''s login sync typo login when I click it','open',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1017,18,118,NULL,'crash crash login login','It''s crash crash login login when I click it','open',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1018,19,119,119,'import error crash slow','It''s import error crash slow when I click it','in_progress',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1019,20,120,120,'button error button slow','It''s button error button slow when I click it','closed',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1020,21,121,NULL,'page typo login button','It''s page typo login button when I click it','open',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1021,22,122,122,'typo export button import','It''s typo export button import when I click it','open',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1022,23,123,123,'crash crash page typo','It''s crash crash page typo when I click it','closed',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1023,24,124,NULL,'slow slow login page','It''s slow slow login page when I click it','in_progress',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1024,25,125,125,'import crash button sync','It''s import crash button sync when I click it','in_progress',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1025,26,126,126,'error page export crash','It''s error page export crash when I click it','open',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1026,27,127,NULL,'error page crash sync','It''s error page crash sync when I click it','in_progress',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1027,28,128,128,'crash page error login','It''s crash page error login when I click it','open',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1028,29,129,129,'error sync export export','It''s error sync export export when I click it','open',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1029,30,130,NULL,'login page error crash','It''s login page error crash when I click it','closed',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1030,31,131,131,'slow page page slow','It''s slow page page slow when I click it','closed',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1031,32,132,132,'sync export login crash','It''s sync export login crash when I click it','open',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1032,33,133,NULL,'sync button page error','It''s sync button page error when I click it','open',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1033,34,134,134,'login error slow typo','It''s login error slow typo when I click it','in_progress',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1034,35,135,135,'import page error page','It''s import page error page when I click it','in_progress',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1035,36,136,NULL,'import login error import','It''s import login error import when I click it','open',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1036,37,137,137,'slow export typo login','It''s slow export typo login when I click it','open',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1037,38,138,138,'typo export crash error','It''s typo export crash error when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1038,39,139,NULL,'export export button page','It''s export export button page when I click it','open',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1039,40,140,140,'export import slow import','It''s export import slow import when I click it','closed',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1040,1,141,141,'import slow page typo','It''s import slow page typo when I click it','closed',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1041,2,142,NULL,'slow button export slow','It''s slow button export slow when I click it','open',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1042,3,143,143,'slow login button login','It''s slow login button login when I click it','closed',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1043,4,144,144,'crash slow typo button','It''s crash slow typo button when I click it','closed',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1044,5,145,NULL,'typo export export export','It''s typo export export export when I click it','closed',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1045,6,146,146,'sync sync export button','It''s sync sync export button when I click it','open',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1046,7,147,147,'slow error page import','It''s slow error page import when I click it','closed',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1047,8,148,NULL,'login typo slow button','It''s login typo slow button when I click it','open',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1048,9,149,149,'crash slow slow typo','It''s crash slow slow typo when I click it','open',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1049,10,150,150,'error error button slow','It''s error error button slow when I click it','open',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1050,11,151,NULL,'slow sync error error','It''s slow sync error error when I click it','open',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1051,12,152,152,'login crash button page','It''s login crash button page when I click it','closed',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1052,13,153,153,'sync typo export export','It''s sync typo export export when I click it','closed',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1053,14,154,NULL,'login slow sync button','It''s login slow sync button when I click it','open',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1054,15,155,155,'login slow page slow','It''s login slow page slow when I click it','in_progress',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1055,16,156,156,'page typo typo sync','It''s page typo typo sync when I click it','in_progress',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1056,17,157,NULL,'login crash import export','It''s login crash import export when I click it','closed',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1057,18,158,158,'button error button button','It''s button error button button when I click it','in_progress',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1058,19,159,159,'crash login typo error','It''s crash login typo error when I click it','in_progress',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1059,20,160,NULL,'crash import export error','It''s crash import export error when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1060,21,161,161,'export button typo export','It''s export button typo export when I click it','closed',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1061,22,162,162,'import import slow page','It''s import import slow page when I click it','closed',2,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1062,23,163,NULL,'crash typo error slow','It''s crash typo error slow when I click it','in_progress',3,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1063,24,164,164,'slow login sync page','It''s slow login sync page when I click it','in_progress',4,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1064,25,165,165,'typo sync error sync','It''s typo sync error sync when I click it','closed',5,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1065,26,166,NULL,'page login error slow','It''s page login error slow when I click it','closed',1,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1066,27,167,167,'error sync page export','It''s error sync page export when I click it','in_progress',2,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1067,28,168,168,'crash page error import','It''s crash page error import when I click it','open',3,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1068,29,169,NULL,'page typo crash export','It''s page typo crash export when I click it','closed',4,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1069,30,170,170,'export import crash typo','It''s export import crash typo when I click it','open',5,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1070,31,171,171,'error typo export login','It''s error typo export login when I click it','closed',1,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1071,32,172,NULL,'import typo typo crash','It''s import typo typo crash when I click it','in_progress',2,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1072,33,173,173,'sync crash error error','It''s sync crash error error when I click it','in_progress',3,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1073,34,174,174,'import crash page import','It''s import crash page import when I click it','open',4,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1074,35,175,NULL,'slow button login page','It''s slow button login page when I click it','in_progress',5,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1075,36,176,176,'typo import page login','It''s typo import page login when I click it','closed',1,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1076,37,177,177,'export import login typo','It''s export import login typo when I click it','in_progress',2,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1077,38,178,NULL,'page export typo sync','It''s page export typo sync when I click it','in_progress',3,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1078,39,179,179,'error export import login','It''s error export import login when I click it','closed',4,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1079,40,180,180,'page crash login slow','It''s page crash login slow when I click it','closed',5,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1080,1,181,NULL,'sync button export import','It''s sync button export import when I click it','open',1,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1081,2,182,182,'page page typo crash','It''s page page typo crash when I click it','open',2,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1082,3,183,183,'button error error button','It''s button error error button when I click it','in_progress',3,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1083,4,184,NULL,'error typo typo export','It''s error typo typo export when I click it','in_progress',4,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1084,5,185,185,'button button sync typo','It''s button button sync typo when I click it','open',5,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1085,6,186,186,'page export error error','It''s page export error error when I click it','in_progress',1,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1086,7,187,NULL,'sync export sync crash','It''s sync export sync crash when I click it','in_progress',2,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1087,8,188,188,'import export button export','It''s import export button export when I click it','open',3,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1088,9,189,189,'export page export page','It''s export page export page when I click it','open',4,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1089,10,190,NULL,'button login import sync','It''s button login import sync when I click it','closed',5,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1090,11,191,191,'button page typo typo','It''s button page typo typo when I click it','in_progress',1,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1091,12,192,192,'error slow sync crash','It''s error slow sync crash when I click it','closed',2,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1092,13,193,NULL,'export button login error','It''s export button login error when I click it','in_progress',3,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1093,14,194,194,'typo export crash typo','It''s typo export crash typo when I click it','in_progress',4,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1094,15,195,195,'export import page button','It''s export import page button when I click it','open',5,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1095,16,196,NULL,'slow typo login login','It''s slow typo login login when I click it','in_progress',1,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1096,17,197,197,'page export import export','It''s page export import export when I click it','closed',2,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1097,18,198,198,'page export page crash','It''s page export page crash when I click it','open',3,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1098,19,199,NULL,'typo button error export','It''s typo button error export when I click it','in_progress',4,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1099,20,200,200,'crash typo login slow','It''s crash typo login slow when I click it','open',5,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1100,21,201,201,'sync button typo error','It''s sync button typo error when I click it','open',1,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1101,22,202,NULL,'error typo button export','It''s error typo button export when I click it','in_progress',2,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1102,23,203,203,'error login button crash','It''s error login button crash when I click it','in_progress',3,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1103,24,204,204,'slow sync login sync','It''s slow sync login sync when I click it','closed',4,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1104,25,205,NULL,'error crash error button','It''s error crash error button when I click it','open',5,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1105,26,206,206,'typo export error import','It''s typo export error import when I click it','in_progress',1,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1106,27,207,207,'button page export crash','It''s button page export crash when I click it','open',2,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1107,28,208,NULL,'typo export button crash','It''s typo export button crash when I click it','in_progress',3,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1108,29,209,209,'slow page button page','It''s slow page button page when I click it','open',4,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1109,30,210,210,'export page error button','It''s export page error button when I click it','open',5,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1110,31,211,NULL,'slow sync crash sync','It''s slow sync crash sync when I click it','in_progress',1,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1111,32,212,212,'error export export export','It''s error export export export when I click it','in_progress',2,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1112,33,213,213,'typo typo login slow','It''s typo typo login slow when I click it','in_progress',3,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1113,34,214,NULL,'crash button login typo','It''s crash button login typo when I click it','closed',4,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1114,35,215,215,'export button page slow','It''s export button page slow when I click it','open',5,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1115,36,216,216,'error export export export','It''s error export export export when I click it','in_progress',1,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1116,37,217,NULL,'export import login export','It''s export import login export when I click it','closed',2,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1117,38,218,218,'error import login sync','It''s error import login sync when I click it','open',3,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1118,39,219,219,'typo login page typo','It''s typo login page typo when I click it','open',4,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1119,40,220,NULL,'typo import slow page','It''s typo import slow page when I click it','open',5,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1120,1,221,221,'import sync login login','It''s import sync login login when I click it','open',1,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1121,2,222,222,'button login sync import','It''s button login sync import when I click it','closed',2,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1122,3,223,NULL,'button typo typo login','It''s button typo typo login when I click it','in_progress',3,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1123,4,224,224,'export error typo export','It''s export error typo export when I click it','in_progress',4,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1124,5,225,225,'sync import sync page','It''s sync import sync page when I click it','in_progress',5,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1125,6,226,NULL,'sync export typo login','It''s sync export typo login when I click it','in_progress',1,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1126,7,227,227,'error page error crash','It''s error page error crash when I click it','open',2,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1127,8,228,228,'page crash crash button','It''s page crash crash button when I click it','in_progress',3,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1128,9,229,NULL,'crash page typo export','It''s crash page typo export when I click it','closed',4,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1129,10,230,230,'crash typo import sync','It''s crash typo import sync when I click it','in_progress',5,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1130,11,231,231,'login typo crash crash','It''s login typo crash crash when I click it','open',1,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1131,12,232,NULL,'slow import export typo','It''s slow import export typo when I click it','in_progress',2,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1132,13,233,233,'page error sync page','It''s page error sync page when I click it','closed',3,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1133,14,234,234,'sync page import page','It''s sync page import page when I click it','open',4,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1134,15,235,NULL,'sync slow slow crash','It''s sync slow slow crash when I click it','open',5,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1135,16,236,236,'export import export button','It''s export import export button when I click it','in_progress',1,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1136,17,237,237,'typo import crash typo','It''s typo import crash typo when I click it','open',2,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1137,18,238,NULL,'typo error sync crash','It''s typo error sync crash when I click it','closed',3,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1138,19,239,239,'button login import slow','It''s button login import slow when I click it','in_progress',4,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1139,20,240,240,'error page import export','It''s error page import export when I click it','closed',5,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1140,21,241,NULL,'import button error login','It''s import button error login when I click it','closed',1,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1141,22,242,242,'login button typo export','It''s login button typo export when I click it','in_progress',2,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1142,23,243,243,'typo import export export','It''s typo import export export when I click it','in_progress',3,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1143,24,244,NULL,'import crash crash export','It''s import crash crash export when I click it','in_progress',4,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1144,25,245,245,'slow login crash button','It''s slow login crash button when I click it','open',5,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1145,26,246,246,'page export login slow','It''s page export login slow when I click it','open',1,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1146,27,247,NULL,'export login page error','It''s export login page error when I click it','in_progress',2,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1147,28,248,248,'button page slow crash','It''s button page slow crash when I click it','in_progress',3,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1148,29,249,249,'export import import export','It''s export import import export when I click it','closed',4,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1149,30,250,NULL,'error slow crash error','It''s error slow crash error when I click it','in_progress',5,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1150,31,251,251,'page slow slow page','It''s page slow slow page when I click it','in_progress',1,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1151,32,252,252,'login error page typo','It''s login error page typo when I click it','closed',2,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1152,33,253,NULL,'sync error page export','It''s sync error page export when I click it','in_progress',3,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1153,34,254,254,'login button sync button','It''s login button sync button when I click it','open',4,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1154,35,255,255,'crash import sync page','It''s crash import sync page when I click it','in_progress',5,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1155,36,256,NULL,'sync page error typo','It''s sync page error typo when I click it','open',1,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1156,37,257,257,'button button error crash','It''s button button error crash when I click it','open',2,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1157,38,258,258,'slow error slow crash','It''s slow error slow crash when I click it','open',3,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1158,39,259,NULL,'crash export error slow','It''s crash export error slow when I click it','closed',4,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1159,40,260,260,'login login sync page','It''s login login sync page when I click it','open',5,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1160,1,261,261,'page crash typo page','It''s page crash typo page when I click it','open',1,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1161,2,262,NULL,'export button crash export','It''s export button crash export when I click it','closed',2,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1162,3,263,263,'import button error sync','It''s import button error sync when I click it','open',3,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1163,4,264,264,'import sync import crash','It''s import sync import crash when I click it','open',4,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1164,5,265,NULL,'login export export slow','It''s login export export slow when I click it','closed',5,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1165,6,266,266,'button login error page','It''s button login error page when I click it','in_progress',1,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1166,7,267,267,'typo error login crash','It''s typo error login crash when I click it','open',2,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1167,8,268,NULL,'button import page crash','It''s button import page crash when I click it','in_progress',3,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1168,9,269,269,'page crash crash crash','It''s page crash crash crash when I click it','in_progress',4,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1169,10,270,270,'slow crash error export','It''s slow crash error export when I click it','closed',5,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1170,11,271,NULL,'sync export button sync','It''s sync export button sync when I click it','open',1,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1171,12,272,272,'slow page button login','It''s slow page button login when I click it','in_progress',2,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1172,13,273,273,'page button slow export','It''s page button slow export when I click it','in_progress',3,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1173,14,274,NULL,'export button sync sync','It''s export button sync sync when I click it','open',4,'2024-02-26T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1174,15,275,275,'slow slow login slow','It''s slow slow login slow when I click it','open',5,'2024-02-27T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1175,16,276,276,'error import button login','It''s error import button login when I click it','open',1,'2024-02-28T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1176,17,277,NULL,'sync sync button slow','It''s sync sync button slow when I click it','closed',2,'2024-02-01T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1177,18,278,278,'error error login page','It''s error error login page when I click it','closed',3,'2024-02-02T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1178,19,279,279,'typo slow export page','It''s typo slow export page when I click it','open',4,'2024-02-03T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1179,20,280,NULL,'login typo login typo','It''s login typo login typo when I click it','open',5,'2024-02-04T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1180,21,281,281,'sync slow error error','It''s sync slow error error when I click it','closed',1,'2024-02-05T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1181,22,282,282,'import sync export typo','It''s import sync export typo when I click it','open',2,'2024-02-06T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1182,23,283,NULL,'login button export export','It''s login button export export when I click it','closed',3,'2024-02-07T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1183,24,284,284,'typo slow sync button','It''s typo slow sync button when I click it','open',4,'2024-02-08T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1184,25,285,285,'error button slow sync','It''s error button slow sync when I click it','open',5,'2024-02-09T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1185,26,286,NULL,'typo error button login','It''s typo error button login when I click it','closed',1,'2024-02-10T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1186,27,287,287,'page slow button export','It''s page slow button export when I click it','closed',2,'2024-02-11T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1187,28,288,288,'button sync crash crash','It''s button sync crash crash when I click it','closed',3,'2024-02-12T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1188,29,289,NULL,'export page export export','It''s export page export export when I click it','closed',4,'2024-02-13T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1189,30,290,290,'slow error error sync','It''s slow error error sync when I click it','open',5,'2024-02-14T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1190,31,291,291,'error login button slow','It''s error login button slow when I click it','in_progress',1,'2024-02-15T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1191,32,292,NULL,'button slow login typo','It''s button slow login typo when I click it','open',2,'2024-02-16T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1192,33,293,293,'typo page import import','It''s typo page import import when I click it','open',3,'2024-02-17T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1193,34,294,294,'typo crash typo page','It''s typo crash typo page when I click it','in_progress',4,'2024-02-18T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1194,35,295,NULL,'button sync import typo','It''s button sync import typo when I click it','closed',5,'2024-02-19T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1195,36,296,296,'page login import slow','It''s page login import slow when I click it','open',1,'2024-02-20T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1196,37,297,297,'export export typo button','It''s export export typo button when I click it','in_progress',2,'2024-02-21T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1197,38,298,NULL,'import crash import import','It''s import crash import import when I click it','closed',3,'2024-02-22T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1198,39,299,299,'error crash typo import','It''s error crash typo import when I click it','in_progress',4,'2024-02-23T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1199,40,300,300,'page page sync error','It''s page page sync error when I click it','open',5,'2024-02-24T08:30:00.000Z',NULL);
+INSERT INTO issues VALUES(1200,1,1,NULL,'typo login export typo','It''s typo login export typo when I click it','open',1,'2024-02-25T08:30:00.000Z',NULL);
+INSERT INTO comments VALUES(1,2,2,'Thanks, looking into it (1)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(2,3,3,'Thanks, looking into it (2)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(3,4,4,'Thanks, looking into it (3)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(4,5,5,'Thanks, looking into it (4)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(5,6,6,'Thanks, looking into it (5)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(6,7,7,'Thanks, looking into it (6)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(7,8,8,'Thanks, looking into it (7)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(8,9,9,'Thanks, looking into it (8)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(9,10,10,'Thanks, looking into it (9)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(10,11,11,'Thanks, looking into it (10)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(11,12,12,'Thanks, looking into it (11)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(12,13,13,'Thanks, looking into it (12)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(13,14,14,'Thanks, looking into it (13)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(14,15,15,'Thanks, looking into it (14)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(15,16,16,'Thanks, looking into it (15)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(16,17,17,'Thanks, looking into it (16)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(17,18,18,'Thanks, looking into it (17)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(18,19,19,'Thanks, looking into it (18)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(19,20,20,'Thanks, looking into it (19)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(20,21,21,'Thanks, looking into it (20)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(21,22,22,'Thanks, looking into it (21)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(22,23,23,'Thanks, looking into it (22)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(23,24,24,'Thanks, looking into it (23)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(24,25,25,'Thanks, looking into it (24)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(25,26,26,'Thanks, looking into it (25)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(26,27,27,'Thanks, looking into it (26)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(27,28,28,'Thanks, looking into it (27)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(28,29,29,'Thanks, looking into it (28)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(29,30,30,'Thanks, looking into it (29)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(30,31,31,'Thanks, looking into it (30)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(31,32,32,'Thanks, looking into it (31)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(32,33,33,'Thanks, looking into it (32)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(33,34,34,'Thanks, looking into it (33)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(34,35,35,'Thanks, looking into it (34)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(35,36,36,'Thanks, looking into it (35)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(36,37,37,'Thanks, looking into it (36)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(37,38,38,'Thanks, looking into it (37)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(38,39,39,'Thanks, looking into it (38)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(39,40,40,'Thanks, looking into it (39)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(40,41,41,'Thanks, looking into it (40)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(41,42,42,'Thanks, looking into it (41)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(42,43,43,'Thanks, looking into it (42)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(43,44,44,'Thanks, looking into it (43)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(44,45,45,'Thanks, looking into it (44)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(45,46,46,'Thanks, looking into it (45)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(46,47,47,'Thanks, looking into it (46)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(47,48,48,'Thanks, looking into it (47)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(48,49,49,'Thanks, looking into it (48)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(49,50,50,'Thanks, looking into it (49)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(50,51,51,'Thanks, looking into it (50)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(51,52,52,'Thanks, looking into it (51)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(52,53,53,'Thanks, looking into it (52)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(53,54,54,'Thanks, looking into it (53)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(54,55,55,'Thanks, looking into it (54)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(55,56,56,'Thanks, looking into it (55)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(56,57,57,'Thanks, looking into it (56)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(57,58,58,'Thanks, looking into it (57)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(58,59,59,'Thanks, looking into it (58)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(59,60,60,'Thanks, looking into it (59)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(60,61,61,'Thanks, looking into it (60)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(61,62,62,'Thanks, looking into it (61)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(62,63,63,'Thanks, looking into it (62)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(63,64,64,'Thanks, looking into it (63)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(64,65,65,'Thanks, looking into it (64)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(65,66,66,'Thanks, looking into it (65)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(66,67,67,'Thanks, looking into it (66)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(67,68,68,'Thanks, looking into it (67)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(68,69,69,'Thanks, looking into it (68)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(69,70,70,'Thanks, looking into it (69)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(70,71,71,'Thanks, looking into it (70)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(71,72,72,'Thanks, looking into it (71)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(72,73,73,'Thanks, looking into it (72)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(73,74,74,'Thanks, looking into it (73)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(74,75,75,'Thanks, looking into it (74)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(75,76,76,'Thanks, looking into it (75)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(76,77,77,'Thanks, looking into it (76)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(77,78,78,'Thanks, looking into it (77)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(78,79,79,'Thanks, looking into it (78)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(79,80,80,'Thanks, looking into it (79)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(80,81,81,'Thanks, looking into it (80)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(81,82,82,'Thanks, looking into it (81)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(82,83,83,'Thanks, looking into it (82)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(83,84,84,'Thanks, looking into it (83)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(84,85,85,'Thanks, looking into it (84)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(85,86,86,'Thanks, looking into it (85)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(86,87,87,'Thanks, looking into it (86)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(87,88,88,'Thanks, looking into it (87)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(88,89,89,'Thanks, looking into it (88)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(89,90,90,'Thanks, looking into it (89)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(90,91,91,'Thanks, looking into it (90)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(91,92,92,'Thanks, looking into it (91)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(92,93,93,'Thanks, looking into it (92)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(93,94,94,'Thanks, looking into it (93)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(94,95,95,'Thanks, looking into it (94)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(95,96,96,'Thanks, looking into it (95)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(96,97,97,'Thanks, looking into it (96)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(97,98,98,'Thanks, looking into it (97)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(98,99,99,'Thanks, looking into it (98)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(99,100,100,'Thanks, looking into it (99)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(100,101,101,'Thanks, looking into it (100)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(101,102,102,'Thanks, looking into it (101)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(102,103,103,'Thanks, looking into it (102)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(103,104,104,'Thanks, looking into it (103)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(104,105,105,'Thanks, looking into it (104)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(105,106,106,'Thanks, looking into it (105)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(106,107,107,'Thanks, looking into it (106)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(107,108,108,'Thanks, looking into it (107)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(108,109,109,'Thanks, looking into it (108)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(109,110,110,'Thanks, looking into it (109)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(110,111,111,'Thanks, looking into it (110)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(111,112,112,'Thanks, looking into it (111)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(112,113,113,'Thanks, looking into it (112)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(113,114,114,'Thanks, looking into it (113)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(114,115,115,'Thanks, looking into it (114)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(115,116,116,'Thanks, looking into it (115)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(116,117,117,'Thanks, looking into it (116)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(117,118,118,'Thanks, looking into it (117)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(118,119,119,'Thanks, looking into it (118)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(119,120,120,'Thanks, looking into it (119)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(120,121,121,'Thanks, looking into it (120)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(121,122,122,'Thanks, looking into it (121)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(122,123,123,'Thanks, looking into it (122)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(123,124,124,'Thanks, looking into it (123)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(124,125,125,'Thanks, looking into it (124)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(125,126,126,'Thanks, looking into it (125)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(126,127,127,'Thanks, looking into it (126)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(127,128,128,'Thanks, looking into it (127)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(128,129,129,'Thanks, looking into it (128)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(129,130,130,'Thanks, looking into it (129)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(130,131,131,'Thanks, looking into it (130)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(131,132,132,'Thanks, looking into it (131)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(132,133,133,'Thanks, looking into it (132)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(133,134,134,'Thanks, looking into it (133)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(134,135,135,'Thanks, looking into it (134)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(135,136,136,'Thanks, looking into it (135)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(136,137,137,'Thanks, looking into it (136)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(137,138,138,'Thanks, looking into it (137)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(138,139,139,'Thanks, looking into it (138)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(139,140,140,'Thanks, looking into it (139)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(140,141,141,'Thanks, looking into it (140)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(141,142,142,'Thanks, looking into it (141)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(142,143,143,'Thanks, looking into it (142)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(143,144,144,'Thanks, looking into it (143)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(144,145,145,'Thanks, looking into it (144)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(145,146,146,'Thanks, looking into it (145)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(146,147,147,'Thanks, looking into it (146)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(147,148,148,'Thanks, looking into it (147)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(148,149,149,'Thanks, looking into it (148)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(149,150,150,'Thanks, looking into it (149)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(150,151,151,'Thanks, looking into it (150)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(151,152,152,'Thanks, looking into it (151)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(152,153,153,'Thanks, looking into it (152)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(153,154,154,'Thanks, looking into it (153)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(154,155,155,'Thanks, looking into it (154)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(155,156,156,'Thanks, looking into it (155)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(156,157,157,'Thanks, looking into it (156)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(157,158,158,'Thanks, looking into it (157)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(158,159,159,'Thanks, looking into it (158)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(159,160,160,'Thanks, looking into it (159)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(160,161,161,'Thanks, looking into it (160)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(161,162,162,'Thanks, looking into it (161)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(162,163,163,'Thanks, looking into it (162)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(163,164,164,'Thanks, looking into it (163)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(164,165,165,'Thanks, looking into it (164)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(165,166,166,'Thanks, looking into it (165)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(166,167,167,'Thanks, looking into it (166)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(167,168,168,'Thanks, looking into it (167)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(168,169,169,'Thanks, looking into it (168)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(169,170,170,'Thanks, looking into it (169)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(170,171,171,'Thanks, looking into it (170)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(171,172,172,'Thanks, looking into it (171)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(172,173,173,'Thanks, looking into it (172)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(173,174,174,'Thanks, looking into it (173)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(174,175,175,'Thanks, looking into it (174)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(175,176,176,'Thanks, looking into it (175)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(176,177,177,'Thanks, looking into it (176)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(177,178,178,'Thanks, looking into it (177)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(178,179,179,'Thanks, looking into it (178)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(179,180,180,'Thanks, looking into it (179)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(180,181,181,'Thanks, looking into it (180)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(181,182,182,'Thanks, looking into it (181)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(182,183,183,'Thanks, looking into it (182)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(183,184,184,'Thanks, looking into it (183)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(184,185,185,'Thanks, looking into it (184)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(185,186,186,'Thanks, looking into it (185)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(186,187,187,'Thanks, looking into it (186)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(187,188,188,'Thanks, looking into it (187)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(188,189,189,'Thanks, looking into it (188)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(189,190,190,'Thanks, looking into it (189)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(190,191,191,'Thanks, looking into it (190)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(191,192,192,'Thanks, looking into it (191)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(192,193,193,'Thanks, looking into it (192)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(193,194,194,'Thanks, looking into it (193)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(194,195,195,'Thanks, looking into it (194)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(195,196,196,'Thanks, looking into it (195)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(196,197,197,'Thanks, looking into it (196)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(197,198,198,'Thanks, looking into it (197)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(198,199,199,'Thanks, looking into it (198)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(199,200,200,'Thanks, looking into it (199)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(200,201,201,'Thanks, looking into it (200)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(201,202,202,'Thanks, looking into it (201)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(202,203,203,'Thanks, looking into it (202)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(203,204,204,'Thanks, looking into it (203)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(204,205,205,'Thanks, looking into it (204)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(205,206,206,'Thanks, looking into it (205)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(206,207,207,'Thanks, looking into it (206)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(207,208,208,'Thanks, looking into it (207)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(208,209,209,'Thanks, looking into it (208)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(209,210,210,'Thanks, looking into it (209)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(210,211,211,'Thanks, looking into it (210)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(211,212,212,'Thanks, looking into it (211)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(212,213,213,'Thanks, looking into it (212)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(213,214,214,'Thanks, looking into it (213)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(214,215,215,'Thanks, looking into it (214)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(215,216,216,'Thanks, looking into it (215)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(216,217,217,'Thanks, looking into it (216)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(217,218,218,'Thanks, looking into it (217)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(218,219,219,'Thanks, looking into it (218)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(219,220,220,'Thanks, looking into it (219)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(220,221,221,'Thanks, looking into it (220)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(221,222,222,'Thanks, looking into it (221)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(222,223,223,'Thanks, looking into it (222)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(223,224,224,'Thanks, looking into it (223)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(224,225,225,'Thanks, looking into it (224)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(225,226,226,'Thanks, looking into it (225)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(226,227,227,'Thanks, looking into it (226)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(227,228,228,'Thanks, looking into it (227)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(228,229,229,'Thanks, looking into it (228)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(229,230,230,'Thanks, looking into it (229)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(230,231,231,'Thanks, looking into it (230)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(231,232,232,'Thanks, looking into it (231)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(232,233,233,'Thanks, looking into it (232)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(233,234,234,'Thanks, looking into it (233)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(234,235,235,'Thanks, looking into it (234)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(235,236,236,'Thanks, looking into it (235)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(236,237,237,'Thanks, looking into it (236)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(237,238,238,'Thanks, looking into it (237)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(238,239,239,'Thanks, looking into it (238)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(239,240,240,'Thanks, looking into it (239)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(240,241,241,'Thanks, looking into it (240)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(241,242,242,'Thanks, looking into it (241)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(242,243,243,'Thanks, looking into it (242)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(243,244,244,'Thanks, looking into it (243)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(244,245,245,'Thanks, looking into it (244)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(245,246,246,'Thanks, looking into it (245)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(246,247,247,'Thanks, looking into it (246)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(247,248,248,'Thanks, looking into it (247)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(248,249,249,'Thanks, looking into it (248)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(249,250,250,'Thanks, looking into it (249)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(250,251,251,'Thanks, looking into it (250)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(251,252,252,'Thanks, looking into it (251)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(252,253,253,'Thanks, looking into it (252)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(253,254,254,'Thanks, looking into it (253)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(254,255,255,'Thanks, looking into it (254)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(255,256,256,'Thanks, looking into it (255)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(256,257,257,'Thanks, looking into it (256)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(257,258,258,'Thanks, looking into it (257)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(258,259,259,'Thanks, looking into it (258)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(259,260,260,'Thanks, looking into it (259)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(260,261,261,'Thanks, looking into it (260)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(261,262,262,'Thanks, looking into it (261)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(262,263,263,'Thanks, looking into it (262)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(263,264,264,'Thanks, looking into it (263)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(264,265,265,'Thanks, looking into it (264)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(265,266,266,'Thanks, looking into it (265)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(266,267,267,'Thanks, looking into it (266)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(267,268,268,'Thanks, looking into it (267)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(268,269,269,'Thanks, looking into it (268)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(269,270,270,'Thanks, looking into it (269)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(270,271,271,'Thanks, looking into it (270)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(271,272,272,'Thanks, looking into it (271)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(272,273,273,'Thanks, looking into it (272)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(273,274,274,'Thanks, looking into it (273)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(274,275,275,'Thanks, looking into it (274)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(275,276,276,'Thanks, looking into it (275)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(276,277,277,'Thanks, looking into it (276)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(277,278,278,'Thanks, looking into it (277)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(278,279,279,'Thanks, looking into it (278)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(279,280,280,'Thanks, looking into it (279)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(280,281,281,'Thanks, looking into it (280)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(281,282,282,'Thanks, looking into it (281)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(282,283,283,'Thanks, looking into it (282)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(283,284,284,'Thanks, looking into it (283)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(284,285,285,'Thanks, looking into it (284)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(285,286,286,'Thanks, looking into it (285)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(286,287,287,'Thanks, looking into it (286)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(287,288,288,'Thanks, looking into it (287)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(288,289,289,'Thanks, looking into it (288)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(289,290,290,'Thanks, looking into it (289)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(290,291,291,'Thanks, looking into it (290)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(291,292,292,'Thanks, looking into it (291)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(292,293,293,'Thanks, looking into it (292)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(293,294,294,'Thanks, looking into it (293)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(294,295,295,'Thanks, looking into it (294)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(295,296,296,'Thanks, looking into it (295)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(296,297,297,'Thanks, looking into it (296)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(297,298,298,'Thanks, looking into it (297)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(298,299,299,'Thanks, looking into it (298)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(299,300,300,'Thanks, looking into it (299)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(300,301,1,'Thanks, looking into it (300)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(301,302,2,'Thanks, looking into it (301)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(302,303,3,'Thanks, looking into it (302)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(303,304,4,'Thanks, looking into it (303)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(304,305,5,'Thanks, looking into it (304)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(305,306,6,'Thanks, looking into it (305)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(306,307,7,'Thanks, looking into it (306)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(307,308,8,'Thanks, looking into it (307)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(308,309,9,'Thanks, looking into it (308)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(309,310,10,'Thanks, looking into it (309)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(310,311,11,'Thanks, looking into it (310)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(311,312,12,'Thanks, looking into it (311)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(312,313,13,'Thanks, looking into it (312)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(313,314,14,'Thanks, looking into it (313)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(314,315,15,'Thanks, looking into it (314)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(315,316,16,'Thanks, looking into it (315)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(316,317,17,'Thanks, looking into it (316)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(317,318,18,'Thanks, looking into it (317)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(318,319,19,'Thanks, looking into it (318)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(319,320,20,'Thanks, looking into it (319)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(320,321,21,'Thanks, looking into it (320)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(321,322,22,'Thanks, looking into it (321)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(322,323,23,'Thanks, looking into it (322)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(323,324,24,'Thanks, looking into it (323)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(324,325,25,'Thanks, looking into it (324)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(325,326,26,'Thanks, looking into it (325)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(326,327,27,'Thanks, looking into it (326)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(327,328,28,'Thanks, looking into it (327)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(328,329,29,'Thanks, looking into it (328)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(329,330,30,'Thanks, looking into it (329)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(330,331,31,'Thanks, looking into it (330)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(331,332,32,'Thanks, looking into it (331)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(332,333,33,'Thanks, looking into it (332)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(333,334,34,'Thanks, looking into it (333)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(334,335,35,'Thanks, looking into it (334)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(335,336,36,'Thanks, looking into it (335)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(336,337,37,'Thanks, looking into it (336)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(337,338,38,'Thanks, looking into it (337)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(338,339,39,'Thanks, looking into it (338)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(339,340,40,'Thanks, looking into it (339)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(340,341,41,'Thanks, looking into it (340)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(341,342,42,'Thanks, looking into it (341)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(342,343,43,'Thanks, looking into it (342)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(343,344,44,'Thanks, looking into it (343)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(344,345,45,'Thanks, looking into it (344)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(345,346,46,'Thanks, looking into it (345)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(346,347,47,'Thanks, looking into it (346)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(347,348,48,'Thanks, looking into it (347)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(348,349,49,'Thanks, looking into it (348)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(349,350,50,'Thanks, looking into it (349)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(350,351,51,'Thanks, looking into it (350)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(351,352,52,'Thanks, looking into it (351)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(352,353,53,'Thanks, looking into it (352)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(353,354,54,'Thanks, looking into it (353)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(354,355,55,'Thanks, looking into it (354)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(355,356,56,'Thanks, looking into it (355)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(356,357,57,'Thanks, looking into it (356)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(357,358,58,'Thanks, looking into it (357)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(358,359,59,'Thanks, looking into it (358)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(359,360,60,'Thanks, looking into it (359)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(360,361,61,'Thanks, looking into it (360)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(361,362,62,'Thanks, looking into it (361)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(362,363,63,'Thanks, looking into it (362)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(363,364,64,'Thanks, looking into it (363)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(364,365,65,'Thanks, looking into it (364)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(365,366,66,'Thanks, looking into it (365)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(366,367,67,'Thanks, looking into it (366)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(367,368,68,'Thanks, looking into it (367)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(368,369,69,'Thanks, looking into it (368)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(369,370,70,'Thanks, looking into it (369)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(370,371,71,'Thanks, looking into it (370)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(371,372,72,'Thanks, looking into it (371)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(372,373,73,'Thanks, looking into it (372)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(373,374,74,'Thanks, looking into it (373)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(374,375,75,'Thanks, looking into it (374)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(375,376,76,'Thanks, looking into it (375)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(376,377,77,'Thanks, looking into it (376)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(377,378,78,'Thanks, looking into it (377)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(378,379,79,'Thanks, looking into it (378)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(379,380,80,'Thanks, looking into it (379)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(380,381,81,'Thanks, looking into it (380)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(381,382,82,'Thanks, looking into it (381)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(382,383,83,'Thanks, looking into it (382)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(383,384,84,'Thanks, looking into it (383)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(384,385,85,'Thanks, looking into it (384)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(385,386,86,'Thanks, looking into it (385)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(386,387,87,'Thanks, looking into it (386)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(387,388,88,'Thanks, looking into it (387)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(388,389,89,'Thanks, looking into it (388)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(389,390,90,'Thanks, looking into it (389)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(390,391,91,'Thanks, looking into it (390)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(391,392,92,'Thanks, looking into it (391)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(392,393,93,'Thanks, looking into it (392)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(393,394,94,'Thanks, looking into it (393)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(394,395,95,'Thanks, looking into it (394)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(395,396,96,'Thanks, looking into it (395)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(396,397,97,'Thanks, looking into it (396)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(397,398,98,'Thanks, looking into it (397)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(398,399,99,'Thanks, looking into it (398)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(399,400,100,'Thanks, looking into it (399)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(400,401,101,'Thanks, looking into it (400)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(401,402,102,'Thanks, looking into it (401)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(402,403,103,'Thanks, looking into it (402)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(403,404,104,'Thanks, looking into it (403)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(404,405,105,'Thanks, looking into it (404)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(405,406,106,'Thanks, looking into it (405)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(406,407,107,'Thanks, looking into it (406)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(407,408,108,'Thanks, looking into it (407)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(408,409,109,'Thanks, looking into it (408)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(409,410,110,'Thanks, looking into it (409)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(410,411,111,'Thanks, looking into it (410)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(411,412,112,'Thanks, looking into it (411)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(412,413,113,'Thanks, looking into it (412)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(413,414,114,'Thanks, looking into it (413)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(414,415,115,'Thanks, looking into it (414)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(415,416,116,'Thanks, looking into it (415)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(416,417,117,'Thanks, looking into it (416)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(417,418,118,'Thanks, looking into it (417)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(418,419,119,'Thanks, looking into it (418)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(419,420,120,'Thanks, looking into it (419)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(420,421,121,'Thanks, looking into it (420)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(421,422,122,'Thanks, looking into it (421)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(422,423,123,'Thanks, looking into it (422)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(423,424,124,'Thanks, looking into it (423)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(424,425,125,'Thanks, looking into it (424)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(425,426,126,'Thanks, looking into it (425)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(426,427,127,'Thanks, looking into it (426)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(427,428,128,'Thanks, looking into it (427)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(428,429,129,'Thanks, looking into it (428)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(429,430,130,'Thanks, looking into it (429)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(430,431,131,'Thanks, looking into it (430)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(431,432,132,'Thanks, looking into it (431)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(432,433,133,'Thanks, looking into it (432)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(433,434,134,'Thanks, looking into it (433)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(434,435,135,'Thanks, looking into it (434)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(435,436,136,'Thanks, looking into it (435)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(436,437,137,'Thanks, looking into it (436)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(437,438,138,'Thanks, looking into it (437)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(438,439,139,'Thanks, looking into it (438)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(439,440,140,'Thanks, looking into it (439)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(440,441,141,'Thanks, looking into it (440)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(441,442,142,'Thanks, looking into it (441)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(442,443,143,'Thanks, looking into it (442)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(443,444,144,'Thanks, looking into it (443)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(444,445,145,'Thanks, looking into it (444)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(445,446,146,'Thanks, looking into it (445)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(446,447,147,'Thanks, looking into it (446)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(447,448,148,'Thanks, looking into it (447)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(448,449,149,'Thanks, looking into it (448)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(449,450,150,'Thanks, looking into it (449)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(450,451,151,'Thanks, looking into it (450)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(451,452,152,'Thanks, looking into it (451)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(452,453,153,'Thanks, looking into it (452)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(453,454,154,'Thanks, looking into it (453)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(454,455,155,'Thanks, looking into it (454)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(455,456,156,'Thanks, looking into it (455)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(456,457,157,'Thanks, looking into it (456)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(457,458,158,'Thanks, looking into it (457)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(458,459,159,'Thanks, looking into it (458)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(459,460,160,'Thanks, looking into it (459)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(460,461,161,'Thanks, looking into it (460)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(461,462,162,'Thanks, looking into it (461)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(462,463,163,'Thanks, looking into it (462)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(463,464,164,'Thanks, looking into it (463)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(464,465,165,'Thanks, looking into it (464)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(465,466,166,'Thanks, looking into it (465)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(466,467,167,'Thanks, looking into it (466)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(467,468,168,'Thanks, looking into it (467)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(468,469,169,'Thanks, looking into it (468)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(469,470,170,'Thanks, looking into it (469)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(470,471,171,'Thanks, looking into it (470)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(471,472,172,'Thanks, looking into it (471)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(472,473,173,'Thanks, looking into it (472)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(473,474,174,'Thanks, looking into it (473)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(474,475,175,'Thanks, looking into it (474)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(475,476,176,'Thanks, looking into it (475)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(476,477,177,'Thanks, looking into it (476)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(477,478,178,'Thanks, looking into it (477)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(478,479,179,'Thanks, looking into it (478)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(479,480,180,'Thanks, looking into it (479)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(480,481,181,'Thanks, looking into it (480)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(481,482,182,'Thanks, looking into it (481)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(482,483,183,'Thanks, looking into it (482)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(483,484,184,'Thanks, looking into it (483)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(484,485,185,'Thanks, looking into it (484)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(485,486,186,'Thanks, looking into it (485)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(486,487,187,'Thanks, looking into it (486)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(487,488,188,'Thanks, looking into it (487)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(488,489,189,'Thanks, looking into it (488)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(489,490,190,'Thanks, looking into it (489)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(490,491,191,'Thanks, looking into it (490)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(491,492,192,'Thanks, looking into it (491)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(492,493,193,'Thanks, looking into it (492)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(493,494,194,'Thanks, looking into it (493)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(494,495,195,'Thanks, looking into it (494)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(495,496,196,'Thanks, looking into it (495)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(496,497,197,'Thanks, looking into it (496)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(497,498,198,'Thanks, looking into it (497)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(498,499,199,'Thanks, looking into it (498)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(499,500,200,'Thanks, looking into it (499)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(500,501,201,'Thanks, looking into it (500)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(501,502,202,'Thanks, looking into it (501)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(502,503,203,'Thanks, looking into it (502)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(503,504,204,'Thanks, looking into it (503)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(504,505,205,'Thanks, looking into it (504)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(505,506,206,'Thanks, looking into it (505)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(506,507,207,'Thanks, looking into it (506)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(507,508,208,'Thanks, looking into it (507)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(508,509,209,'Thanks, looking into it (508)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(509,510,210,'Thanks, looking into it (509)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(510,511,211,'Thanks, looking into it (510)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(511,512,212,'Thanks, looking into it (511)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(512,513,213,'Thanks, looking into it (512)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(513,514,214,'Thanks, looking into it (513)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(514,515,215,'Thanks, looking into it (514)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(515,516,216,'Thanks, looking into it (515)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(516,517,217,'Thanks, looking into it (516)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(517,518,218,'Thanks, looking into it (517)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(518,519,219,'Thanks, looking into it (518)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(519,520,220,'Thanks, looking into it (519)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(520,521,221,'Thanks, looking into it (520)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(521,522,222,'Thanks, looking into it (521)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(522,523,223,'Thanks, looking into it (522)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(523,524,224,'Thanks, looking into it (523)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(524,525,225,'Thanks, looking into it (524)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(525,526,226,'Thanks, looking into it (525)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(526,527,227,'Thanks, looking into it (526)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(527,528,228,'Thanks, looking into it (527)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(528,529,229,'Thanks, looking into it (528)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(529,530,230,'Thanks, looking into it (529)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(530,531,231,'Thanks, looking into it (530)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(531,532,232,'Thanks, looking into it (531)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(532,533,233,'Thanks, looking into it (532)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(533,534,234,'Thanks, looking into it (533)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(534,535,235,'Thanks, looking into it (534)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(535,536,236,'Thanks, looking into it (535)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(536,537,237,'Thanks, looking into it (536)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(537,538,238,'Thanks, looking into it (537)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(538,539,239,'Thanks, looking into it (538)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(539,540,240,'Thanks, looking into it (539)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(540,541,241,'Thanks, looking into it (540)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(541,542,242,'Thanks, looking into it (541)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(542,543,243,'Thanks, looking into it (542)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(543,544,244,'Thanks, looking into it (543)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(544,545,245,'Thanks, looking into it (544)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(545,546,246,'Thanks, looking into it (545)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(546,547,247,'Thanks, looking into it (546)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(547,548,248,'Thanks, looking into it (547)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(548,549,249,'Thanks, looking into it (548)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(549,550,250,'Thanks, looking into it (549)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(550,551,251,'Thanks, looking into it (550)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(551,552,252,'Thanks, looking into it (551)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(552,553,253,'Thanks, looking into it (552)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(553,554,254,'Thanks, looking into it (553)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(554,555,255,'Thanks, looking into it (554)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(555,556,256,'Thanks, looking into it (555)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(556,557,257,'Thanks, looking into it (556)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(557,558,258,'Thanks, looking into it (557)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(558,559,259,'Thanks, looking into it (558)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(559,560,260,'Thanks, looking into it (559)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(560,561,261,'Thanks, looking into it (560)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(561,562,262,'Thanks, looking into it (561)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(562,563,263,'Thanks, looking into it (562)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(563,564,264,'Thanks, looking into it (563)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(564,565,265,'Thanks, looking into it (564)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(565,566,266,'Thanks, looking into it (565)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(566,567,267,'Thanks, looking into it (566)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(567,568,268,'Thanks, looking into it (567)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(568,569,269,'Thanks, looking into it (568)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(569,570,270,'Thanks, looking into it (569)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(570,571,271,'Thanks, looking into it (570)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(571,572,272,'Thanks, looking into it (571)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(572,573,273,'Thanks, looking into it (572)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(573,574,274,'Thanks, looking into it (573)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(574,575,275,'Thanks, looking into it (574)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(575,576,276,'Thanks, looking into it (575)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(576,577,277,'Thanks, looking into it (576)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(577,578,278,'Thanks, looking into it (577)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(578,579,279,'Thanks, looking into it (578)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(579,580,280,'Thanks, looking into it (579)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(580,581,281,'Thanks, looking into it (580)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(581,582,282,'Thanks, looking into it (581)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(582,583,283,'Thanks, looking into it (582)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(583,584,284,'Thanks, looking into it (583)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(584,585,285,'Thanks, looking into it (584)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(585,586,286,'Thanks, looking into it (585)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(586,587,287,'Thanks, looking into it (586)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(587,588,288,'Thanks, looking into it (587)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(588,589,289,'Thanks, looking into it (588)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(589,590,290,'Thanks, looking into it (589)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(590,591,291,'Thanks, looking into it (590)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(591,592,292,'Thanks, looking into it (591)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(592,593,293,'Thanks, looking into it (592)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(593,594,294,'Thanks, looking into it (593)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(594,595,295,'Thanks, looking into it (594)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(595,596,296,'Thanks, looking into it (595)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(596,597,297,'Thanks, looking into it (596)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(597,598,298,'Thanks, looking into it (597)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(598,599,299,'Thanks, looking into it (598)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(599,600,300,'Thanks, looking into it (599)','2024-03-01 12:00:00');
+INSERT INTO comments VALUES(600,601,1,'Thanks, looking into it (600)','2024-03-01 12:00:00');
+COMMIT;
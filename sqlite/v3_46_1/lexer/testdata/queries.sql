@@ -0,0 +1,63 @@
+-- Reports over the issue tracker.
+WITH RECURSIVE days(day) AS (
+	SELECT date('now', '-30 days')
+	UNION ALL
+	SELECT date(day, '+1 day') FROM days WHERE day < date('now')
+),
+daily AS (
+	SELECT date(created_at) AS day, count(*) AS opened
+	FROM issues
+	WHERE created_at >= date('now', '-30 days')
+	GROUP BY date(created_at)
+)
+SELECT d.day, coalesce(daily.opened, 0) AS opened,
+	sum(coalesce(daily.opened, 0)) OVER (ORDER BY d.day ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS total
+FROM days AS d
+LEFT JOIN daily USING (day)
+ORDER BY d.day;
+
+SELECT p.name,
+	count(*) FILTER (WHERE i.status = 'open') AS open,
+	count(*) FILTER (WHERE i.status = 'in_progress') AS in_progress,
+	count(*) FILTER (WHERE i.status = 'closed') AS closed,
+	round(avg(CASE WHEN i.status = 'closed' THEN julianday(i.updated_at) - julianday(i.created_at) END), 2) AS days_to_close
+FROM projects AS p
+JOIN issues AS i ON i.project_id = p.id
+WHERE NOT p.archived
+GROUP BY p.id
+HAVING count(*) > 10
+ORDER BY open DESC, p.name COLLATE NOCASE
+LIMIT 20 OFFSET 0;
+
+SELECT u.name, i.title,
+	rank() OVER w AS position,
+	lag(i.created_at) OVER w AS previous
+FROM users AS u
+JOIN issues AS i ON i.assignee_id = u.id
+WHERE i.priority <= 2 AND i.title LIKE '%crash%' ESCAPE '\'
+WINDOW w AS (PARTITION BY u.id ORDER BY i.priority, i.created_at);
+
+UPDATE issues
+SET status = 'closed', assignee_id = NULL
+WHERE id IN (
+	SELECT issue_id FROM labels WHERE label = 'wontfix'
+	EXCEPT
+	SELECT id FROM issues WHERE status = 'closed'
+)
+RETURNING id, title;
+
+INSERT INTO labels (issue_id, label)
+SELECT id, 'stale' FROM issues
+WHERE status = 'open' AND julianday('now') - julianday(coalesce(updated_at, created_at)) > 90.0
+ON CONFLICT (issue_id, label) DO NOTHING;
+
+DELETE FROM comments
+WHERE created_at < datetime('now', '-2 years') AND issue_id IN (SELECT id FROM issues WHERE status = 'closed');
+
+SELECT json_object('id', id, 'title', title, 'labels', (SELECT json_group_array(label) FROM labels WHERE issue_id = issues.id))
+FROM issues
+WHERE id = :id OR title GLOB ?1 OR priority = @priority OR status = $status;
+
+EXPLAIN QUERY PLAN SELECT * FROM issues WHERE project_id = 1 AND status = 'open' ORDER BY priority;
+
+SELECT CAST(x'CAFE' AS TEXT), 0x7FFFFFFFFFFFFFFF, 1_000_000, 1.5e-3, -42, ~0 & 255 | 1 << 4 >> 2 % 3;
@@ -0,0 +1,77 @@
+-- Schema of a small issue tracker.
+PRAGMA foreign_keys = ON;
+
+CREATE TABLE IF NOT EXISTS users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	email TEXT NOT NULL UNIQUE COLLATE NOCASE,
+	name TEXT NOT NULL DEFAULT '',
+	created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	is_admin INTEGER NOT NULL DEFAULT 0 CHECK (is_admin IN (0, 1))
+);
+
+CREATE TABLE IF NOT EXISTS projects (
+	id INTEGER PRIMARY KEY,
+	owner_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	description TEXT,
+	archived INTEGER NOT NULL DEFAULT FALSE,
+	UNIQUE (owner_id, name)
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS issues (
+	id INTEGER PRIMARY KEY,
+	project_id INTEGER NOT NULL,
+	reporter_id INTEGER NOT NULL,
+	assignee_id INTEGER,
+	title TEXT NOT NULL CHECK (length(title) BETWEEN 1 AND 200),
+	body TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'in_progress', 'closed')),
+	priority INTEGER NOT NULL DEFAULT 3,
+	created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+	updated_at TEXT,
+	search_key TEXT GENERATED ALWAYS AS (lower(title) || ' ' || lower(body)) VIRTUAL,
+	FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+	FOREIGN KEY (reporter_id) REFERENCES users(id),
+	FOREIGN KEY (assignee_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS comments (
+	id INTEGER PRIMARY KEY,
+	issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+	author_id INTEGER NOT NULL REFERENCES users(id),
+	body TEXT NOT NULL,
+	created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS labels (
+	issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+	label TEXT NOT NULL,
+	PRIMARY KEY (issue_id, label)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS issues_project_status ON issues(project_id, status);
+CREATE INDEX IF NOT EXISTS issues_assignee ON issues(assignee_id) WHERE assignee_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email ON users(email COLLATE NOCASE);
+CREATE INDEX IF NOT EXISTS comments_issue ON comments(issue_id, created_at DESC);
+
+/* Keeps updated_at current. */
+CREATE TRIGGER IF NOT EXISTS issues_touch AFTER UPDATE OF title, body, status, priority ON issues
+FOR EACH ROW WHEN OLD.updated_at IS NEW.updated_at
+BEGIN
+	UPDATE issues SET updated_at = strftime('%Y-%m-%dT%H:%M:%fZ', 'now') WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS issues_closed_check BEFORE UPDATE OF status ON issues
+WHEN NEW.status = 'closed' AND OLD.status = 'open'
+BEGIN
+	SELECT RAISE(ABORT, 'an issue must be in progress before being closed')
+	WHERE NOT EXISTS (SELECT 1 FROM comments WHERE issue_id = NEW.id);
+END;
+
+CREATE VIEW IF NOT EXISTS open_issues AS
+	SELECT i.id, i.title, p.name AS project, u.name AS assignee, i.priority
+	FROM issues AS i
+	JOIN projects AS p ON p.id = i.project_id
+	LEFT JOIN users AS u ON u.id = i.assignee_id
+	WHERE i.status <> 'closed' AND NOT p.archived
+	ORDER BY i.priority, i.created_at;
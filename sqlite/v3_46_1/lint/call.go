@@ -0,0 +1,65 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// functionCall checks a tree of kind parsetree.KindFunctionCall against the catalog of functions.
+func (l *linter) functionCall(call parsetree.NonTerminal) {
+	nameNode, ok := child(call, parsetree.KindFunctionName).(parsetree.Terminal)
+	if !ok {
+		return
+	}
+	fname := syntax.Name(nameNode)
+	n := 0
+	var distinct parsetree.Construction
+	if args, ok := child(call, parsetree.KindFunctionArguments).(parsetree.NonTerminal); ok {
+		distinct = tokenChild(args, token.KindDistinct)
+		if list, ok := child(args, parsetree.KindCommaList).(parsetree.NonTerminal); ok {
+			// the arguments are separated by commas.
+			n = (len(children(list)) + 1) / 2
+		}
+	}
+	filter := child(call, parsetree.KindFilterClause)
+	over := child(call, parsetree.KindOverClause)
+
+	named := l.functions.Named(fname)
+	if len(named) == 0 {
+		l.add(RuleNoSuchFunction, nameNode, "no such function: "+fname)
+		return
+	}
+	f := l.functions.Lookup(fname, n)
+	if f == nil {
+		l.add(RuleArgumentCount, call, fmt.Sprintf(
+			"wrong number of arguments to function %s(): got %d, want %s", fname, n, arities(named),
+		))
+		return
+	}
+	if distinct != nil {
+		switch {
+		case !f.Distinct():
+			l.add(RuleDistinct, distinct, fmt.Sprintf("DISTINCT may not be used with the non-aggregate function %s()", fname))
+		case n != 1:
+			l.add(RuleDistinct, distinct, "DISTINCT aggregates must have exactly one argument")
+		case over != nil:
+			l.add(RuleDistinct, distinct, "DISTINCT is not supported for window functions")
+		}
+	}
+	if filter != nil && !f.Filter() {
+		if f.Kind == FunctionWindow {
+			l.add(RuleFilter, filter, fmt.Sprintf("FILTER may only be used with aggregate window functions, not with %s()", fname))
+		} else {
+			l.add(RuleFilter, filter, fmt.Sprintf("FILTER may not be used with the non-aggregate function %s()", fname))
+		}
+	}
+	if over != nil && !f.Over() {
+		l.add(RuleOver, over, fmt.Sprintf("%s() may not be used as a window function", fname))
+	}
+	if over == nil && f.Kind == FunctionWindow {
+		l.add(RuleMissingOver, call, fmt.Sprintf("the window function %s() requires an OVER clause", fname))
+	}
+}
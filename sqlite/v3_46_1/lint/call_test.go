@@ -0,0 +1,71 @@
+package lint
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFunctionCalls(t *testing.T) {
+	cases := []struct {
+		code  string
+		rules []Rule
+	}{
+		{"SELECT count(*), count(DISTINCT a), max(a, b, 1), sum(a) FILTER (WHERE a > 0) OVER w FROM t WINDOW w AS ();", nil},
+		{"SELECT row_number() OVER (ORDER BY a), lag(a, 1, 0) OVER (), json_extract(a, '$.b'), DATE('now') FROM t;", nil},
+		{"SELECT nope(a) FROM t;", []Rule{RuleNoSuchFunction}},
+		{"SELECT substr(a), abs(*), count(DISTINCT a, b) FROM t;", []Rule{RuleArgumentCount, RuleArgumentCount, RuleArgumentCount}},
+		{"SELECT abs(DISTINCT a), group_concat(DISTINCT a, ','), count(DISTINCT a) OVER () FROM t;", []Rule{RuleDistinct, RuleDistinct, RuleDistinct}},
+		{"SELECT abs(a) FILTER (WHERE a), row_number() FILTER (WHERE a) OVER () FROM t;", []Rule{RuleFilter, RuleFilter}},
+		{"SELECT abs(a) OVER (), row_number() FROM t;", []Rule{RuleOver, RuleMissingOver}},
+		// the calls are checked in all statements and in the arguments of other calls.
+		{"CREATE TABLE u(a DEFAULT (nope()), CHECK (length(a, 1) > 0));", []Rule{RuleNoSuchFunction, RuleArgumentCount}},
+		{"CREATE TRIGGER g AFTER INSERT ON t BEGIN SELECT abs(nope(1)); END;", []Rule{RuleNoSuchFunction}},
+	}
+	for _, c := range cases {
+		fs, err := Check([]byte("CREATE TABLE t(a, b);" + c.code))
+		if err != nil {
+			t.Errorf("%s: %v", c.code, err)
+			continue
+		}
+		var rules []Rule
+		for _, f := range fs {
+			rules = append(rules, f.Rule)
+		}
+		if !slices.Equal(rules, c.rules) {
+			t.Errorf("%s: got %v, want %v", c.code, fs, c.rules)
+		}
+	}
+}
+
+func TestFunctionCallMessages(t *testing.T) {
+	fs, err := Check([]byte("SELECT slugify(a),\n\tsubstr(a),\n\tabs(a) OVER ()\nFROM t;"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range fs {
+		got = append(got, f.String())
+	}
+	want := []string{
+		"1:8: no such function: slugify",
+		"2:2: wrong number of arguments to function substr(): got 1, want 2 or 3",
+		"3:9: abs() may not be used as a window function",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestUserDefinedFunctions(t *testing.T) {
+	functions := NewFunctions()
+	functions.Register(&Function{Name: "slugify", MinArguments: 1, MaxArguments: 1, Result: TypeText})
+	functions.Register(&Function{Name: "median", Kind: FunctionAggregate, MinArguments: 1, MaxArguments: 1})
+	code := "SELECT slugify(a), median(a) FILTER (WHERE a > 0), slugify(a, b) FROM t;"
+	fs, err := CheckWith([]byte(code), Options{Functions: functions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fs) != 1 || fs[0].Rule != RuleArgumentCount {
+		t.Errorf("got %v", fs)
+	}
+}
@@ -0,0 +1,210 @@
+package lint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// strictTypes are the type names allowed in the STRICT tables.
+var strictTypes = []string{"INT", "INTEGER", "REAL", "TEXT", "BLOB", "ANY"}
+
+// createTable checks the constraints and the options of a tree of kind parsetree.KindCreateTable.
+func (l *linter) createTable(tree parsetree.NonTerminal) {
+	table := syntax.ChildName(tree, parsetree.KindTableName)
+	schema := syntax.ChildName(tree, parsetree.KindSchemaName)
+	if hasToken(tree, token.KindTemp) || hasToken(tree, token.KindTemporary) {
+		schema = "temp"
+	} else if schema == "" {
+		schema = "main"
+	}
+	var columns, constraints, options []parsetree.NonTerminal
+	for _, c := range children(tree) {
+		if c.Kind() != parsetree.KindCommaList {
+			continue
+		}
+		for _, item := range children(c.(parsetree.NonTerminal)) {
+			switch item.Kind() {
+			case parsetree.KindColumnDefinition:
+				columns = append(columns, item.(parsetree.NonTerminal))
+			case parsetree.KindTableConstraint:
+				constraints = append(constraints, item.(parsetree.NonTerminal))
+			case parsetree.KindTableOption:
+				options = append(options, item.(parsetree.NonTerminal))
+			}
+		}
+	}
+
+	var strict, withoutRowid bool
+	for _, o := range options {
+		if hasToken(o, token.KindStrict) {
+			strict = true
+		} else if hasToken(o, token.KindWithout) {
+			withoutRowid = true
+			if !hasPrimaryKey(tree) {
+				l.add(RuleWithoutRowidNoPrimaryKey, o, fmt.Sprintf("the WITHOUT ROWID table %s has no PRIMARY KEY", table))
+			}
+		}
+	}
+
+	var names []string
+	for _, def := range columns {
+		names = append(names, syntax.ChildName(def, parsetree.KindColumnName))
+	}
+	for _, def := range columns {
+		l.column(schema, table, def, strict, withoutRowid)
+	}
+	for _, cons := range constraints {
+		fk, ok := child(cons, parsetree.KindForeignKeyTableConstraint).(parsetree.NonTerminal)
+		if !ok {
+			continue
+		}
+		var cols []string
+		if list, ok := child(fk, parsetree.KindCommaList).(parsetree.NonTerminal); ok {
+			for _, c := range children(list) {
+				if c.Kind() == parsetree.KindColumnName {
+					cols = append(cols, syntax.Name(c.(parsetree.Terminal)))
+				}
+			}
+		}
+		for _, col := range cols {
+			if !slices.ContainsFunc(names, func(n string) bool { return literal.EqualIdentifiers(n, col) }) {
+				l.add(RuleNoSuchColumn, cons, fmt.Sprintf("the foreign key of %s has the column %s, that doesn't exist", table, col))
+				cols = nil
+				break
+			}
+		}
+		if clause, ok := child(fk, parsetree.KindForeignKeyClause).(parsetree.NonTerminal); ok && cols != nil {
+			l.foreignKey(schema, table, cols, clause)
+		}
+	}
+}
+
+// alterTable checks the column added by a tree of kind parsetree.KindAlterTable.
+func (l *linter) alterTable(tree parsetree.NonTerminal) {
+	add, ok := child(tree, parsetree.KindAddColumn).(parsetree.NonTerminal)
+	if !ok {
+		return
+	}
+	def, ok := child(add, parsetree.KindColumnDefinition).(parsetree.NonTerminal)
+	if !ok {
+		return
+	}
+	schema, table := syntax.ChildName(tree, parsetree.KindSchemaName), syntax.ChildName(tree, parsetree.KindTableName)
+	var strict, withoutRowid bool
+	if t := l.schema.TableIn(schema, table); t != nil {
+		schema, strict, withoutRowid = t.SchemaName(), t.Strict, t.WithoutRowid
+	}
+	l.column(schema, table, def, strict, withoutRowid)
+}
+
+// column checks the type and the constraints of the column definition def of the table of the schema.
+func (l *linter) column(schema, table string, def parsetree.NonTerminal, strict, withoutRowid bool) {
+	col := syntax.ChildName(def, parsetree.KindColumnName)
+	typeName := child(def, parsetree.KindTypeName)
+	var typ string
+	if typeName != nil {
+		typ = catalog.Text(typeName)
+	}
+	if strict {
+		if typeName == nil {
+			l.add(RuleStrictType, def, fmt.Sprintf("the column %s.%s of the STRICT table has no type", table, col))
+		} else if !slices.Contains(strictTypes, strings.ToUpper(typ)) {
+			l.add(RuleStrictType, typeName, fmt.Sprintf(
+				"the type %s of %s.%s is not allowed in a STRICT table, that allows %s", typ, table, col,
+				strings.Join(strictTypes, ", "),
+			))
+		}
+	}
+
+	for _, c := range children(def) {
+		if c.Kind() != parsetree.KindColumnConstraint {
+			continue
+		}
+		cons := c.(parsetree.NonTerminal)
+		if pk, ok := child(cons, parsetree.KindPrimaryKeyColumnConstraint).(parsetree.NonTerminal); ok &&
+			hasToken(pk, token.KindAutoincrement) {
+			if !strings.EqualFold(typ, "INTEGER") {
+				l.add(RuleAutoincrement, pk, fmt.Sprintf(
+					"AUTOINCREMENT is only allowed on an INTEGER PRIMARY KEY, but %s.%s has the type %q", table, col, typ,
+				))
+			} else if withoutRowid {
+				l.add(RuleAutoincrement, pk, fmt.Sprintf("AUTOINCREMENT is not allowed in the WITHOUT ROWID table %s", table))
+			}
+		}
+		if fk, ok := child(cons, parsetree.KindForeignKeyColumnConstraint).(parsetree.NonTerminal); ok {
+			if clause, ok := child(fk, parsetree.KindForeignKeyClause).(parsetree.NonTerminal); ok {
+				l.foreignKey(schema, table, []string{col}, clause)
+			}
+		}
+	}
+}
+
+// foreignKey checks the foreign key clause of the table of the schema with the given child columns against the parent
+// table. Like in SQLite, the parent table is looked up in the schema of the table.
+func (l *linter) foreignKey(schema, table string, columns []string, clause parsetree.NonTerminal) {
+	parentName := child(clause, parsetree.KindTableName)
+	parent := syntax.ChildName(clause, parsetree.KindTableName)
+	p := l.schema.TableIn(schema, parent)
+	if p == nil {
+		l.add(RuleNoSuchTable, parentName, fmt.Sprintf("the foreign key of %s refers to the table %s, that doesn't exist", table, parent))
+		return
+	}
+	if len(p.Columns) == 0 {
+		// the columns of the tables created with AS SELECT and of the virtual tables are unknown.
+		return
+	}
+
+	var refs []string
+	if list, ok := child(clause, parsetree.KindCommaList).(parsetree.NonTerminal); ok {
+		for _, c := range children(list) {
+			if c.Kind() != parsetree.KindColumnName {
+				continue
+			}
+			ref := syntax.Name(c.(parsetree.Terminal))
+			if p.Column(ref) == nil {
+				l.add(RuleNoSuchColumn, c, fmt.Sprintf("the foreign key of %s refers to the column %s.%s, that doesn't exist", table, parent, ref))
+				return
+			}
+			refs = append(refs, ref)
+		}
+	}
+	if refs == nil {
+		refs = p.PrimaryKey()
+		if refs == nil {
+			l.add(RuleParentKeyNotUnique, parentName, fmt.Sprintf(
+				"the foreign key of %s refers to the primary key of %s, that has none", table, parent,
+			))
+			return
+		}
+	}
+	if len(columns) != len(refs) {
+		l.add(RuleColumnCountMismatch, clause, fmt.Sprintf(
+			"the foreign key of %s has %d columns, but refers to %s(%s)", table, len(columns), parent, strings.Join(refs, ", "),
+		))
+		return
+	}
+	if !l.schema.Unique(schema, parent, refs) {
+		l.add(RuleParentKeyNotUnique, clause, fmt.Sprintf(
+			"the columns %s(%s) referred by a foreign key of %s are not the PRIMARY KEY nor UNIQUE", parent,
+			strings.Join(refs, ", "), table,
+		))
+	}
+}
+
+// hasPrimaryKey reports whether the table of a tree of kind parsetree.KindCreateTable has a PRIMARY KEY, in a column
+// or table constraint.
+func hasPrimaryKey(tree parsetree.NonTerminal) bool {
+	found := false
+	walk(tree, func(c parsetree.Construction) {
+		k := c.Kind()
+		found = found || k == parsetree.KindPrimaryKeyColumnConstraint || k == parsetree.KindPrimaryKeyTableConstraint
+	})
+	return found
+}
@@ -0,0 +1,81 @@
+package lint
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestConstraints(t *testing.T) {
+	parents := `CREATE TABLE p(id INTEGER PRIMARY KEY, a, b, c UNIQUE, UNIQUE(a, b));
+		CREATE TABLE q(x, y);
+		CREATE UNIQUE INDEX q_x ON q(x);
+		CREATE UNIQUE INDEX q_y ON q(y) WHERE y > 0;
+		CREATE TABLE v AS SELECT 1 AS z;
+	`
+	cases := []struct {
+		code  string
+		rules []Rule
+	}{
+		{"CREATE TABLE c(a REFERENCES p, b REFERENCES p(c), FOREIGN KEY (a, b) REFERENCES p(b, a));", nil},
+		{"CREATE TABLE c(a REFERENCES q(x), b REFERENCES v(z));", nil},
+		{"CREATE TABLE c(a REFERENCES nope);", []Rule{RuleNoSuchTable}},
+		{"CREATE TABLE c(a REFERENCES p(zz));", []Rule{RuleNoSuchColumn}},
+		{"CREATE TABLE c(a, FOREIGN KEY (zz) REFERENCES p);", []Rule{RuleNoSuchColumn}},
+		{"CREATE TABLE c(a REFERENCES p(a));", []Rule{RuleParentKeyNotUnique}},
+		{"CREATE TABLE c(a REFERENCES q(y));", []Rule{RuleParentKeyNotUnique}},
+		{"CREATE TABLE c(a REFERENCES q);", []Rule{RuleParentKeyNotUnique}},
+		{"CREATE TABLE c(a, b, FOREIGN KEY (a, b) REFERENCES p);", []Rule{RuleColumnCountMismatch}},
+		{"CREATE TABLE c(a REFERENCES p(a, b));", []Rule{RuleColumnCountMismatch}},
+		{"CREATE TABLE c(a INTEGER PRIMARY KEY AUTOINCREMENT);", nil},
+		{"CREATE TABLE c(a INT PRIMARY KEY AUTOINCREMENT);", []Rule{RuleAutoincrement}},
+		{"CREATE TABLE c(a INTEGER PRIMARY KEY AUTOINCREMENT) WITHOUT ROWID;", []Rule{RuleAutoincrement}},
+		{"CREATE TABLE c(a, b, PRIMARY KEY (a, b)) WITHOUT ROWID;", nil},
+		{"CREATE TABLE c(a, b) WITHOUT ROWID;", []Rule{RuleWithoutRowidNoPrimaryKey}},
+		{"CREATE TABLE c(a INT, b integer, c REAL, d TEXT, e BLOB, f ANY) STRICT;", nil},
+		{"CREATE TABLE c(a VARCHAR(10), b) STRICT, WITHOUT ROWID;", []Rule{RuleWithoutRowidNoPrimaryKey, RuleStrictType, RuleStrictType}},
+		{"CREATE TABLE c(a INT) STRICT; ALTER TABLE c ADD COLUMN b FLOAT REFERENCES p(a);", []Rule{RuleParentKeyNotUnique, RuleStrictType}},
+		{"CREATE TABLE aux.c(a REFERENCES p);", []Rule{RuleNoSuchTable}},
+		{"CREATE TABLE aux.p(a PRIMARY KEY); CREATE TABLE aux.c(a REFERENCES p(a));", nil},
+		{"CREATE TABLE aux.c(a); ALTER TABLE aux.c ADD COLUMN b REFERENCES p;", []Rule{RuleNoSuchTable}},
+	}
+	for _, c := range cases {
+		fs, err := Check([]byte(parents + c.code))
+		if err != nil {
+			t.Errorf("%s: %v", c.code, err)
+			continue
+		}
+		var rules []Rule
+		for _, f := range fs {
+			rules = append(rules, f.Rule)
+		}
+		slices.Sort(rules)
+		if !slices.Equal(rules, c.rules) {
+			t.Errorf("%s: got %v, want %v", c.code, fs, c.rules)
+		}
+	}
+}
+
+func TestConstraintMessages(t *testing.T) {
+	fs, err := Check([]byte(`CREATE TABLE p(id INTEGER PRIMARY KEY, a);
+		CREATE TABLE c(
+			a INT PRIMARY KEY AUTOINCREMENT,
+			b REFERENCES p(a),
+			FOREIGN KEY (a, b) REFERENCES p
+		) STRICT;`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range fs {
+		got = append(got, f.String())
+	}
+	want := []string{
+		`3:10: AUTOINCREMENT is only allowed on an INTEGER PRIMARY KEY, but c.a has the type "INT"`,
+		"4:4: the column c.b of the STRICT table has no type",
+		"4:6: the columns p(a) referred by a foreign key of c are not the PRIMARY KEY nor UNIQUE",
+		"5:23: the foreign key of c has 2 columns, but refers to p(id)",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+}
@@ -0,0 +1,317 @@
+package lint
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FunctionKind is a kind of function.
+type FunctionKind int
+
+const (
+	// FunctionScalar is a function that computes a value of a row.
+	FunctionScalar FunctionKind = iota
+	// FunctionAggregate is a function that computes a value of a group of rows. It may also be used as a window
+	// function, with OVER.
+	FunctionAggregate
+	// FunctionWindow is a function that is only used as a window function, like row_number().
+	FunctionWindow
+)
+
+// String returns a string representation of k.
+func (k FunctionKind) String() string {
+	if k < 0 || int(k) >= len(functionKindStrings) {
+		return strconv.Itoa(int(k))
+	}
+	return functionKindStrings[k]
+}
+
+// functionKindStrings contains the string representation of the function kinds. Note that the value of a kind is the
+// index of your string representation.
+var functionKindStrings = []string{"Scalar", "Aggregate", "Window"}
+
+// Type is the type of the result of a function.
+type Type int
+
+const (
+	// TypeAny is a type that depends on the arguments.
+	TypeAny Type = iota
+	TypeInteger
+	TypeReal
+	// TypeNumeric is an INTEGER or a REAL, depending on the arguments.
+	TypeNumeric
+	TypeText
+	TypeBlob
+)
+
+// String returns a string representation of t.
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeStrings) {
+		return strconv.Itoa(int(t))
+	}
+	return typeStrings[t]
+}
+
+// typeStrings contains the string representation of the types. Note that the value of a type is the index of your
+// string representation.
+var typeStrings = []string{"ANY", "INTEGER", "REAL", "NUMERIC", "TEXT", "BLOB"}
+
+// Function is a SQL function.
+type Function struct {
+	// Name is the name of the function. The names are case insensitive.
+	Name string
+	Kind FunctionKind
+	// MinArguments and MaxArguments are the limits of the number of arguments. A MaxArguments of -1 means no limit.
+	MinArguments, MaxArguments int
+	// Result is the type of the result.
+	Result Type
+}
+
+// Accepts reports whether f accepts n arguments.
+func (f *Function) Accepts(n int) bool {
+	return n >= f.MinArguments && (f.MaxArguments < 0 || n <= f.MaxArguments)
+}
+
+// Distinct reports whether f may be called with DISTINCT, that is, whether it is an aggregate function. Like in
+// SQLite, it must also be called with only one argument.
+func (f *Function) Distinct() bool {
+	return f.Kind == FunctionAggregate
+}
+
+// Filter reports whether f may be called with FILTER, that is, whether it is an aggregate function.
+func (f *Function) Filter() bool {
+	return f.Kind == FunctionAggregate
+}
+
+// Over reports whether f may be called with OVER, that is, whether it is an aggregate or a window function.
+func (f *Function) Over() bool {
+	return f.Kind != FunctionScalar
+}
+
+// Functions is a catalog of functions. A name may have many functions, with different numbers of arguments, like
+// min, that is an aggregate function with one argument and a scalar function with more.
+type Functions struct {
+	// byName maps the names in lower case to the functions, in the order that they were registered.
+	byName map[string][]*Function
+}
+
+// NewFunctions returns a catalog with the built-in functions of SQLite: the core, date and time, math, JSON, aggregate
+// and window functions.
+func NewFunctions() *Functions {
+	fs := &Functions{byName: make(map[string][]*Function)}
+	for _, f := range builtins {
+		fs.Register(&Function{
+			Name: f.name, Kind: f.kind, MinArguments: f.min, MaxArguments: f.max, Result: f.result,
+		})
+	}
+	return fs
+}
+
+// Register registers f, like a user-defined function registered in the connection. Like in SQLite, a function
+// overrides the functions with the same name registered before, in the numbers of arguments that it accepts.
+func (fs *Functions) Register(f *Function) {
+	name := strings.ToLower(f.Name)
+	fs.byName[name] = append(fs.byName[name], f)
+}
+
+// Lookup returns the function with the given name that accepts n arguments, or nil if there is none.
+func (fs *Functions) Lookup(name string, n int) *Function {
+	named := fs.byName[strings.ToLower(name)]
+	for i := len(named) - 1; i >= 0; i-- {
+		if named[i].Accepts(n) {
+			return named[i]
+		}
+	}
+	return nil
+}
+
+// Named returns the functions with the given name, in the order that they were registered.
+func (fs *Functions) Named(name string) []*Function {
+	return slices.Clone(fs.byName[strings.ToLower(name)])
+}
+
+// arities returns the numbers of arguments accepted by the functions, like "2 or 3" or "1 or more".
+func arities(fns []*Function) string {
+	type span struct{ min, max int }
+	var spans []span
+	for _, f := range fns {
+		spans = append(spans, span{f.MinArguments, f.MaxArguments})
+	}
+	slices.SortFunc(spans, func(a, b span) int { return a.min - b.min })
+	var merged []span
+	for _, s := range spans {
+		if n := len(merged); n > 0 && (merged[n-1].max < 0 || s.min <= merged[n-1].max+1) {
+			if merged[n-1].max >= 0 && (s.max < 0 || s.max > merged[n-1].max) {
+				merged[n-1].max = s.max
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	var ss []string
+	for _, s := range merged {
+		lo := strconv.Itoa(s.min)
+		switch s.max {
+		case -1:
+			ss = append(ss, lo+" or more")
+		case s.min:
+			ss = append(ss, lo)
+		case s.min + 1:
+			ss = append(ss, lo+" or "+strconv.Itoa(s.max))
+		default:
+			ss = append(ss, lo+" to "+strconv.Itoa(s.max))
+		}
+	}
+	return strings.Join(ss, ", ")
+}
+
+// builtins are the built-in functions of SQLite.
+var builtins = []struct {
+	name     string
+	kind     FunctionKind
+	min, max int
+	result   Type
+}{
+	// core functions
+	{"abs", FunctionScalar, 1, 1, TypeNumeric},
+	{"changes", FunctionScalar, 0, 0, TypeInteger},
+	{"char", FunctionScalar, 0, -1, TypeText},
+	{"coalesce", FunctionScalar, 2, -1, TypeAny},
+	{"concat", FunctionScalar, 1, -1, TypeText},
+	{"concat_ws", FunctionScalar, 2, -1, TypeText},
+	{"format", FunctionScalar, 0, -1, TypeText},
+	{"glob", FunctionScalar, 2, 2, TypeInteger},
+	{"hex", FunctionScalar, 1, 1, TypeText},
+	{"ifnull", FunctionScalar, 2, 2, TypeAny},
+	{"iif", FunctionScalar, 3, 3, TypeAny},
+	{"instr", FunctionScalar, 2, 2, TypeInteger},
+	{"last_insert_rowid", FunctionScalar, 0, 0, TypeInteger},
+	{"length", FunctionScalar, 1, 1, TypeInteger},
+	{"like", FunctionScalar, 2, 3, TypeInteger},
+	{"likelihood", FunctionScalar, 2, 2, TypeAny},
+	{"likely", FunctionScalar, 1, 1, TypeAny},
+	{"load_extension", FunctionScalar, 1, 2, TypeAny},
+	{"lower", FunctionScalar, 1, 1, TypeText},
+	{"ltrim", FunctionScalar, 1, 2, TypeText},
+	{"max", FunctionScalar, 2, -1, TypeAny},
+	{"min", FunctionScalar, 2, -1, TypeAny},
+	{"nullif", FunctionScalar, 2, 2, TypeAny},
+	{"octet_length", FunctionScalar, 1, 1, TypeInteger},
+	{"printf", FunctionScalar, 0, -1, TypeText},
+	{"quote", FunctionScalar, 1, 1, TypeText},
+	{"random", FunctionScalar, 0, 0, TypeInteger},
+	{"randomblob", FunctionScalar, 1, 1, TypeBlob},
+	{"replace", FunctionScalar, 3, 3, TypeText},
+	{"round", FunctionScalar, 1, 2, TypeReal},
+	{"rtrim", FunctionScalar, 1, 2, TypeText},
+	{"sign", FunctionScalar, 1, 1, TypeInteger},
+	{"sqlite_compileoption_get", FunctionScalar, 1, 1, TypeText},
+	{"sqlite_compileoption_used", FunctionScalar, 1, 1, TypeInteger},
+	{"sqlite_offset", FunctionScalar, 1, 1, TypeInteger},
+	{"sqlite_source_id", FunctionScalar, 0, 0, TypeText},
+	{"sqlite_version", FunctionScalar, 0, 0, TypeText},
+	{"substr", FunctionScalar, 2, 3, TypeAny},
+	{"substring", FunctionScalar, 2, 3, TypeAny},
+	{"total_changes", FunctionScalar, 0, 0, TypeInteger},
+	{"trim", FunctionScalar, 1, 2, TypeText},
+	{"typeof", FunctionScalar, 1, 1, TypeText},
+	{"unhex", FunctionScalar, 1, 2, TypeBlob},
+	{"unicode", FunctionScalar, 1, 1, TypeInteger},
+	{"unlikely", FunctionScalar, 1, 1, TypeAny},
+	{"upper", FunctionScalar, 1, 1, TypeText},
+	{"zeroblob", FunctionScalar, 1, 1, TypeBlob},
+
+	// date and time functions
+	{"date", FunctionScalar, 0, -1, TypeText},
+	{"time", FunctionScalar, 0, -1, TypeText},
+	{"datetime", FunctionScalar, 0, -1, TypeText},
+	{"julianday", FunctionScalar, 0, -1, TypeReal},
+	{"unixepoch", FunctionScalar, 0, -1, TypeInteger},
+	{"strftime", FunctionScalar, 0, -1, TypeText},
+	{"timediff", FunctionScalar, 2, 2, TypeText},
+
+	// math functions
+	{"acos", FunctionScalar, 1, 1, TypeReal},
+	{"acosh", FunctionScalar, 1, 1, TypeReal},
+	{"asin", FunctionScalar, 1, 1, TypeReal},
+	{"asinh", FunctionScalar, 1, 1, TypeReal},
+	{"atan", FunctionScalar, 1, 1, TypeReal},
+	{"atan2", FunctionScalar, 2, 2, TypeReal},
+	{"atanh", FunctionScalar, 1, 1, TypeReal},
+	{"ceil", FunctionScalar, 1, 1, TypeNumeric},
+	{"ceiling", FunctionScalar, 1, 1, TypeNumeric},
+	{"cos", FunctionScalar, 1, 1, TypeReal},
+	{"cosh", FunctionScalar, 1, 1, TypeReal},
+	{"degrees", FunctionScalar, 1, 1, TypeReal},
+	{"exp", FunctionScalar, 1, 1, TypeReal},
+	{"floor", FunctionScalar, 1, 1, TypeNumeric},
+	{"ln", FunctionScalar, 1, 1, TypeReal},
+	{"log", FunctionScalar, 1, 2, TypeReal},
+	{"log10", FunctionScalar, 1, 1, TypeReal},
+	{"log2", FunctionScalar, 1, 1, TypeReal},
+	{"mod", FunctionScalar, 2, 2, TypeReal},
+	{"pi", FunctionScalar, 0, 0, TypeReal},
+	{"pow", FunctionScalar, 2, 2, TypeReal},
+	{"power", FunctionScalar, 2, 2, TypeReal},
+	{"radians", FunctionScalar, 1, 1, TypeReal},
+	{"sin", FunctionScalar, 1, 1, TypeReal},
+	{"sinh", FunctionScalar, 1, 1, TypeReal},
+	{"sqrt", FunctionScalar, 1, 1, TypeReal},
+	{"tan", FunctionScalar, 1, 1, TypeReal},
+	{"tanh", FunctionScalar, 1, 1, TypeReal},
+	{"trunc", FunctionScalar, 1, 1, TypeNumeric},
+
+	// JSON functions
+	{"json", FunctionScalar, 1, 1, TypeText},
+	{"jsonb", FunctionScalar, 1, 1, TypeBlob},
+	{"json_array", FunctionScalar, 0, -1, TypeText},
+	{"jsonb_array", FunctionScalar, 0, -1, TypeBlob},
+	{"json_array_length", FunctionScalar, 1, 2, TypeInteger},
+	{"json_error_position", FunctionScalar, 1, 1, TypeInteger},
+	{"json_extract", FunctionScalar, 0, -1, TypeAny},
+	{"jsonb_extract", FunctionScalar, 0, -1, TypeAny},
+	{"json_insert", FunctionScalar, 0, -1, TypeText},
+	{"jsonb_insert", FunctionScalar, 0, -1, TypeBlob},
+	{"json_object", FunctionScalar, 0, -1, TypeText},
+	{"jsonb_object", FunctionScalar, 0, -1, TypeBlob},
+	{"json_patch", FunctionScalar, 2, 2, TypeText},
+	{"jsonb_patch", FunctionScalar, 2, 2, TypeBlob},
+	{"json_pretty", FunctionScalar, 1, 2, TypeText},
+	{"json_quote", FunctionScalar, 1, 1, TypeText},
+	{"json_remove", FunctionScalar, 0, -1, TypeText},
+	{"jsonb_remove", FunctionScalar, 0, -1, TypeBlob},
+	{"json_replace", FunctionScalar, 0, -1, TypeText},
+	{"jsonb_replace", FunctionScalar, 0, -1, TypeBlob},
+	{"json_set", FunctionScalar, 0, -1, TypeText},
+	{"jsonb_set", FunctionScalar, 0, -1, TypeBlob},
+	{"json_type", FunctionScalar, 1, 2, TypeText},
+	{"json_valid", FunctionScalar, 1, 2, TypeInteger},
+	{"json_group_array", FunctionAggregate, 1, 1, TypeText},
+	{"jsonb_group_array", FunctionAggregate, 1, 1, TypeBlob},
+	{"json_group_object", FunctionAggregate, 2, 2, TypeText},
+	{"jsonb_group_object", FunctionAggregate, 2, 2, TypeBlob},
+
+	// aggregate functions
+	{"avg", FunctionAggregate, 1, 1, TypeReal},
+	{"count", FunctionAggregate, 0, 1, TypeInteger},
+	{"group_concat", FunctionAggregate, 1, 2, TypeText},
+	{"max", FunctionAggregate, 1, 1, TypeAny},
+	{"min", FunctionAggregate, 1, 1, TypeAny},
+	{"string_agg", FunctionAggregate, 2, 2, TypeText},
+	{"sum", FunctionAggregate, 1, 1, TypeNumeric},
+	{"total", FunctionAggregate, 1, 1, TypeReal},
+
+	// window functions
+	{"row_number", FunctionWindow, 0, 0, TypeInteger},
+	{"rank", FunctionWindow, 0, 0, TypeInteger},
+	{"dense_rank", FunctionWindow, 0, 0, TypeInteger},
+	{"percent_rank", FunctionWindow, 0, 0, TypeReal},
+	{"cume_dist", FunctionWindow, 0, 0, TypeReal},
+	{"ntile", FunctionWindow, 1, 1, TypeInteger},
+	{"lag", FunctionWindow, 1, 3, TypeAny},
+	{"lead", FunctionWindow, 1, 3, TypeAny},
+	{"first_value", FunctionWindow, 1, 1, TypeAny},
+	{"last_value", FunctionWindow, 1, 1, TypeAny},
+	{"nth_value", FunctionWindow, 2, 2, TypeAny},
+}
@@ -0,0 +1,92 @@
+package lint
+
+import "testing"
+
+func TestFunctions(t *testing.T) {
+	fs := NewFunctions()
+	cases := []struct {
+		name string
+		n    int
+		kind FunctionKind
+		ok   bool
+	}{
+		{"ABS", 1, FunctionScalar, true},
+		{"abs", 2, 0, false},
+		{"min", 1, FunctionAggregate, true},
+		{"min", 3, FunctionScalar, true},
+		{"min", 0, 0, false},
+		{"count", 0, FunctionAggregate, true},
+		{"row_number", 0, FunctionWindow, true},
+		{"json_extract", 3, FunctionScalar, true},
+		{"nope", 0, 0, false},
+	}
+	for _, c := range cases {
+		f := fs.Lookup(c.name, c.n)
+		if (f != nil) != c.ok || f != nil && f.Kind != c.kind {
+			t.Errorf("Lookup(%q, %d) = %+v", c.name, c.n, f)
+		}
+	}
+	if f := fs.Lookup("julianday", 1); f.Result != TypeReal || f.Result.String() != "REAL" {
+		t.Errorf("got %+v", f)
+	}
+}
+
+func TestFunctionsRegister(t *testing.T) {
+	fs := NewFunctions()
+	fs.Register(&Function{Name: "Slugify", MinArguments: 1, MaxArguments: 1, Result: TypeText})
+	if f := fs.Lookup("slugify", 1); f == nil || f.Name != "Slugify" {
+		t.Errorf("got %+v", f)
+	}
+	// the user-defined function overrides the built-in one in the numbers of arguments that it accepts.
+	median := &Function{Name: "substr", Kind: FunctionAggregate, MinArguments: 1, MaxArguments: 1}
+	fs.Register(median)
+	if f := fs.Lookup("substr", 1); f != median {
+		t.Errorf("got %+v", f)
+	}
+	if f := fs.Lookup("substr", 2); f == nil || f.Kind != FunctionScalar {
+		t.Errorf("got %+v", f)
+	}
+	if n := len(fs.Named("SUBSTR")); n != 2 {
+		t.Errorf("got %d functions", n)
+	}
+	// the catalogs are independent.
+	if f := NewFunctions().Lookup("slugify", 1); f != nil {
+		t.Errorf("got %+v", f)
+	}
+}
+
+func TestFunctionEligibility(t *testing.T) {
+	cases := []struct {
+		kind                   FunctionKind
+		distinct, filter, over bool
+	}{
+		{FunctionScalar, false, false, false},
+		{FunctionAggregate, true, true, true},
+		{FunctionWindow, false, false, true},
+	}
+	for _, c := range cases {
+		f := &Function{Kind: c.kind}
+		if f.Distinct() != c.distinct || f.Filter() != c.filter || f.Over() != c.over {
+			t.Errorf("%s: got %t, %t, %t", c.kind, f.Distinct(), f.Filter(), f.Over())
+		}
+	}
+	if s := FunctionKind(7).String(); s != "7" {
+		t.Errorf("got %q", s)
+	}
+}
+
+func TestArities(t *testing.T) {
+	fs := NewFunctions()
+	cases := map[string]string{
+		"abs": "1", "like": "2 or 3", "lag": "1 to 3", "min": "1 or more", "char": "0 or more",
+	}
+	for name, want := range cases {
+		if got := arities(fs.Named(name)); got != want {
+			t.Errorf("%s: got %q, want %q", name, got, want)
+		}
+	}
+	got := arities([]*Function{{MinArguments: 4, MaxArguments: 4}, {MinArguments: 0, MaxArguments: 1}})
+	if got != "0 or 1, 4" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,229 @@
+// This package checks SQL scripts for mistakes that SQLite reports only when the statements are executed, or never,
+// like a foreign key that refers to a table that doesn't exist or a call of a function with a wrong number of
+// arguments. The functions are checked against a catalog of the built-in functions of SQLite, where the applications
+// may register your own functions. The findings point to the constructions of the parse trees where the mistakes are.
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Rule is a kind of mistake.
+type Rule int
+
+const (
+	// RuleNoSuchTable is a foreign key that refers to a table that doesn't exist.
+	RuleNoSuchTable Rule = iota
+	// RuleNoSuchColumn is a foreign key with a column that doesn't exist, in the child or in the parent table.
+	RuleNoSuchColumn
+	// RuleParentKeyNotUnique is a foreign key whose parent columns are not the primary key nor a unique key of the
+	// parent table.
+	RuleParentKeyNotUnique
+	// RuleColumnCountMismatch is a foreign key with a number of child columns different from the number of parent
+	// columns.
+	RuleColumnCountMismatch
+	// RuleAutoincrement is an AUTOINCREMENT in a column that is not an INTEGER PRIMARY KEY of a rowid table.
+	RuleAutoincrement
+	// RuleWithoutRowidNoPrimaryKey is a WITHOUT ROWID table without a primary key.
+	RuleWithoutRowidNoPrimaryKey
+	// RuleStrictType is a column of a STRICT table without a type or with a type that is not allowed.
+	RuleStrictType
+	// RuleNoSuchFunction is a call of a function that is not in the catalog.
+	RuleNoSuchFunction
+	// RuleArgumentCount is a call of a function with a number of arguments that it doesn't accept.
+	RuleArgumentCount
+	// RuleDistinct is a DISTINCT in a call of a function that is not an aggregate function, with more than one argument
+	// or with OVER.
+	RuleDistinct
+	// RuleFilter is a FILTER in a call of a function that is not an aggregate function.
+	RuleFilter
+	// RuleOver is an OVER in a call of a scalar function.
+	RuleOver
+	// RuleMissingOver is a call of a window function without OVER.
+	RuleMissingOver
+)
+
+// String returns a string representation of r.
+func (r Rule) String() string {
+	if r < 0 || int(r) >= len(ruleStrings) {
+		return strconv.Itoa(int(r))
+	}
+	return ruleStrings[r]
+}
+
+// ruleStrings contains the string representation of the rules. Note that the value of a rule is the index of your
+// string representation.
+var ruleStrings = []string{
+	"NoSuchTable", "NoSuchColumn", "ParentKeyNotUnique", "ColumnCountMismatch", "Autoincrement",
+	"WithoutRowidNoPrimaryKey", "StrictType", "NoSuchFunction", "ArgumentCount", "Distinct", "Filter", "Over",
+	"MissingOver",
+}
+
+// Options are the options of the check.
+type Options struct {
+	// Functions are the functions that may be called. If it is nil, the built-in functions of SQLite are used.
+	Functions *Functions
+}
+
+// Finding is a mistake found in a script.
+type Finding struct {
+	Rule Rule
+	// Offset is the offset in the script of the first token of the construction with the mistake. Line and Column are
+	// the line and the column of that offset, starting at 1. The column is in bytes.
+	Offset, Line, Column int
+	// Message describes the mistake.
+	Message string
+}
+
+// String returns the finding as "line:column: message".
+func (f Finding) String() string {
+	return fmt.Sprintf("%d:%d: %s", f.Line, f.Column, f.Message)
+}
+
+// Check checks the statements of code, returning the mistakes in the order that they appear. The objects referred by
+// a statement may be created by any statement of code, like SQLite allows for the foreign keys, so they are looked up
+// in the schema created by all the statements. An error is returned if a statement has a syntax error. The function
+// calls are checked against the built-in functions of SQLite.
+func Check(code []byte) ([]Finding, error) {
+	return CheckWith(code, Options{})
+}
+
+// CheckWith checks the statements of code like Check, but with the given options.
+func CheckWith(code []byte, opts Options) ([]Finding, error) {
+	rec := syntax.NewRecorder(lexer.New(code))
+	p := parser.New(rec)
+	var trees []parsetree.NonTerminal
+	for i := 1; ; i++ {
+		tree, err := syntax.Parse(p)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+		trees = append(trees, tree)
+		if syntax.Last(tree) {
+			break
+		}
+	}
+
+	l := &linter{code: code, offsets: rec.Offsets, schema: catalog.New(), functions: opts.Functions}
+	if l.functions == nil {
+		l.functions = NewFunctions()
+	}
+	for _, tree := range trees {
+		// the errors, like of a table that already exists, don't stop the check.
+		l.schema.Apply(tree)
+	}
+	for _, tree := range trees {
+		stmt := syntax.FirstNonTerminal(tree)
+		if stmt == nil {
+			continue
+		}
+		switch stmt.Kind() {
+		case parsetree.KindCreateTable:
+			l.createTable(stmt)
+		case parsetree.KindAlterTable:
+			l.alterTable(stmt)
+		}
+		walk(tree, func(c parsetree.Construction) {
+			if c.Kind() == parsetree.KindFunctionCall {
+				l.functionCall(c.(parsetree.NonTerminal))
+			}
+		})
+	}
+	slices.SortStableFunc(l.findings, func(a, b Finding) int { return a.Offset - b.Offset })
+	return l.findings, nil
+}
+
+// linter checks the statements of a script.
+type linter struct {
+	code []byte
+	// offsets maps the tokens to their offsets in code.
+	offsets map[*token.Token]int
+	// schema is the schema created by the statements.
+	schema *catalog.Catalog
+	// functions are the functions that may be called.
+	functions *Functions
+	findings  []Finding
+}
+
+// add adds a finding in the construction c.
+func (l *linter) add(rule Rule, c parsetree.Construction, message string) {
+	offset := len(l.code)
+	if tok := firstToken(c); tok != nil {
+		offset = l.offsets[tok]
+	}
+	line := bytes.Count(l.code[:offset], []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(l.code[:offset], '\n')
+	l.findings = append(l.findings, Finding{Rule: rule, Offset: offset, Line: line, Column: column, Message: message})
+}
+
+// firstToken returns the token of the first terminal of c, or nil if there is none.
+func firstToken(c parsetree.Construction) *token.Token {
+	switch c := c.(type) {
+	case parsetree.Terminal:
+		return c.Token()
+	case parsetree.NonTerminal:
+		var tok *token.Token
+		c.Children(func(child parsetree.Construction) bool {
+			tok = firstToken(child)
+			return tok == nil
+		})
+		return tok
+	}
+	return nil
+}
+
+// children returns the children of tree.
+func children(tree parsetree.NonTerminal) []parsetree.Construction {
+	var cs []parsetree.Construction
+	tree.Children(func(child parsetree.Construction) bool {
+		cs = append(cs, child)
+		return true
+	})
+	return cs
+}
+
+// child returns the first child of tree of kind k, or nil if there is none.
+func child(tree parsetree.NonTerminal, k parsetree.Kind) parsetree.Construction {
+	for _, c := range children(tree) {
+		if c.Kind() == k {
+			return c
+		}
+	}
+	return nil
+}
+
+// hasToken reports whether a child of tree is a token of kind k.
+func hasToken(tree parsetree.NonTerminal, k token.Kind) bool {
+	return tokenChild(tree, k) != nil
+}
+
+// tokenChild returns the first child of tree that is a token of kind k, or nil if there is none.
+func tokenChild(tree parsetree.NonTerminal, k token.Kind) parsetree.Construction {
+	for _, c := range children(tree) {
+		if t, ok := c.(parsetree.Terminal); ok && c.Kind() == parsetree.KindToken && t.Token().Kind == k {
+			return c
+		}
+	}
+	return nil
+}
+
+// walk calls f for c and its descendants, in order.
+func walk(c parsetree.Construction, f func(c parsetree.Construction)) {
+	f(c)
+	if nt, ok := c.(parsetree.NonTerminal); ok {
+		nt.Children(func(child parsetree.Construction) bool {
+			walk(child, f)
+			return true
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	code := "CREATE TABLE a(x);\n\nCREATE TABLE b(\n\ty REFERENCES c\n);"
+	fs, err := Check([]byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fs) != 1 {
+		t.Fatalf("got %v, want one finding", fs)
+	}
+	f := fs[0]
+	if f.Rule != RuleNoSuchTable || f.Line != 4 || f.Column != 15 || code[f.Offset:f.Offset+1] != "c" {
+		t.Errorf("got %+v", f)
+	}
+	if s := f.String(); s != "4:15: the foreign key of b refers to the table c, that doesn't exist" {
+		t.Errorf("got %q", s)
+	}
+
+	// the table may be created after the statement that refers to it.
+	if fs, err := Check([]byte("CREATE TABLE b(y REFERENCES a); CREATE TABLE a(id INTEGER PRIMARY KEY);")); err != nil || len(fs) != 0 {
+		t.Errorf("got %v, %v", fs, err)
+	}
+	if fs, err := Check(nil); err != nil || len(fs) != 0 {
+		t.Errorf("got %v, %v", fs, err)
+	}
+}
+
+func TestCheckSyntaxError(t *testing.T) {
+	_, err := Check([]byte("CREATE TABLE a(x); CREATE TABLE (;"))
+	if err == nil || !strings.HasPrefix(err.Error(), "statement 2: ") {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestRuleString(t *testing.T) {
+	if s := RuleStrictType.String(); s != "StrictType" {
+		t.Errorf("got %q", s)
+	}
+	if s := Rule(-1).String(); s != "-1" {
+		t.Errorf("got %q", s)
+	}
+}
@@ -0,0 +1,109 @@
+package literal
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// QuoteStyle is a way of quoting identifiers.
+type QuoteStyle int
+
+const (
+	// QuoteDouble quotes with double quotes, like "order". It is the standard SQL style.
+	QuoteDouble QuoteStyle = iota
+	// QuoteGrave quotes with grave accents, like `order`. It is the MySQL style.
+	QuoteGrave
+	// QuoteBracket quotes with square brackets, like [order]. It is the MS Access and SQL Server style. An identifier
+	// that contains ']' can't be quoted in this style.
+	QuoteBracket
+)
+
+// NeedsQuoting reports whether s needs quoting to be used as an identifier. That is the case if s is empty, is not
+// valid UTF-8, doesn't start with a rune that the lexer accepts at the start of an identifier (an ASCII letter, '_' or
+// a rune outside ASCII, see lexer.IsAlphabetic), contains a rune that is not allowed in a bare identifier, or is a
+// keyword, including the fallback keywords. The ASCII letters, the digits, '_', '$' and the runes outside ASCII are
+// allowed after the first rune.
+func NeedsQuoting(s string) bool {
+	if s == "" || !utf8.ValidString(s) {
+		return true
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	if !lexer.IsAlphabetic(first) {
+		return true
+	}
+	for i := range len(s) {
+		b := s[i]
+		if b < utf8.RuneSelf && b != '_' && b != '$' && !(b >= 'a' && b <= 'z') && !(b >= 'A' && b <= 'Z') &&
+			!(b >= '0' && b <= '9') {
+			return true
+		}
+	}
+	_, isKeyword := lexer.LookupKeyword([]byte(s))
+	return isKeyword
+}
+
+// IsFallbackKeyword reports whether s is a keyword that SQLite accepts as an identifier when the keyword doesn't make
+// sense in its position, like KEY or ACTION, or that is not a keyword in SQLite, like ROWID. They are the keywords that
+// the parser accepts as names, see token.IsFallback. NeedsQuoting reports true for these keywords anyway, since whether
+// SQLite accepts them depends on the context.
+func IsFallbackKeyword(s string) bool {
+	k, isKeyword := lexer.LookupKeyword([]byte(s))
+	return isKeyword && token.IsFallback(k)
+}
+
+// QuoteIdentifierStyle returns s as an identifier quoted in the given style. If style is QuoteBracket and s contains
+// ']' the identifier is quoted with double quotes, since it can't be quoted with brackets.
+func QuoteIdentifierStyle(s string, style QuoteStyle) string {
+	switch style {
+	case QuoteGrave:
+		return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+	case QuoteBracket:
+		if !strings.Contains(s, "]") {
+			return "[" + s + "]"
+		}
+	}
+	return QuoteIdentifier(s)
+}
+
+// FormatIdentifier returns s as it is if it can be used as a bare identifier, or quoted in the given style otherwise.
+func FormatIdentifier(s string, style QuoteStyle) string {
+	if NeedsQuoting(s) {
+		return QuoteIdentifierStyle(s, style)
+	}
+	return s
+}
+
+// EqualIdentifiers reports whether a and b are the same identifier, that is, if they are equal ignoring the case of
+// the ASCII letters. As in SQLite, the case of the other letters is not ignored.
+func EqualIdentifiers(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range len(a) {
+		if toLowerASCII(a[i]) != toLowerASCII(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// FoldIdentifier returns s with the ASCII letters in lowercase. Two identifiers are equal by EqualIdentifiers if and
+// only if your folds are equal, so the fold may be used as the key of a map of identifiers.
+func FoldIdentifier(s string) string {
+	b := []byte(s)
+	for i := range b {
+		b[i] = toLowerASCII(b[i])
+	}
+	return string(b)
+}
+
+// toLowerASCII converts b to lowercase if it is an ASCII letter.
+func toLowerASCII(b byte) byte {
+	if b >= 'A' && b <= 'Z' {
+		return b + 'a' - 'A'
+	}
+	return b
+}
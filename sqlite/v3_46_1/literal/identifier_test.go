@@ -0,0 +1,121 @@
+package literal
+
+import (
+	"testing"
+)
+
+func TestNeedsQuoting(t *testing.T) {
+	cases := []struct {
+		s    string
+		want bool
+	}{
+		{"column_a", false},
+		{"_a", false},
+		{"a$1", false},
+		{"ação", false},
+		{"€x", false},
+		{"→", false},
+		{"Order", true},
+		{"group", true},
+		{"key", true},
+		{"", true},
+		{"1a", true},
+		{"$a", true},
+		{"a b", true},
+		{"a-b", true},
+		{`a"b`, true},
+		{"\xFF", true},
+	}
+
+	for _, c := range cases {
+		if got := NeedsQuoting(c.s); got != c.want {
+			t.Errorf("NeedsQuoting(%q) = %v, want %v", c.s, got, c.want)
+		}
+	}
+}
+
+func TestIsFallbackKeyword(t *testing.T) {
+	cases := []struct {
+		s    string
+		want bool
+	}{
+		{"key", true}, {"ACTION", true}, {"current_timestamp", true}, {"like", true}, {"rowid", true}, {"Strict", true},
+		{"rollback", true}, {"TEMPORARY", true},
+		{"order", false}, {"select", false}, {"column_a", false}, {"ſelect", false},
+	}
+
+	for _, c := range cases {
+		if got := IsFallbackKeyword(c.s); got != c.want {
+			t.Errorf("IsFallbackKeyword(%q) = %v, want %v", c.s, got, c.want)
+		}
+	}
+}
+
+func TestQuoteIdentifierStyle(t *testing.T) {
+	cases := []struct {
+		s     string
+		style QuoteStyle
+		want  string
+	}{
+		{"order", QuoteDouble, `"order"`},
+		{`a"b`, QuoteDouble, `"a""b"`},
+		{"order", QuoteGrave, "`order`"},
+		{"a`b", QuoteGrave, "`a``b`"},
+		{"order", QuoteBracket, "[order]"},
+		{"a]b", QuoteBracket, `"a]b"`},
+	}
+
+	for _, c := range cases {
+		got := QuoteIdentifierStyle(c.s, c.style)
+		if got != c.want {
+			t.Errorf("QuoteIdentifierStyle(%q, %d) = %s, want %s", c.s, c.style, got, c.want)
+		}
+		if v, err := Identifier([]byte(got)); err != nil || v != c.s {
+			t.Errorf("Identifier(%s) = %q, %v", got, v, err)
+		}
+	}
+}
+
+func TestFormatIdentifier(t *testing.T) {
+	cases := []struct {
+		s    string
+		want string
+	}{
+		{"column_a", "column_a"},
+		{"group", "[group]"},
+		{"a b", "[a b]"},
+	}
+
+	for _, c := range cases {
+		if got := FormatIdentifier(c.s, QuoteBracket); got != c.want {
+			t.Errorf("FormatIdentifier(%q) = %s, want %s", c.s, got, c.want)
+		}
+	}
+}
+
+func TestEqualIdentifiers(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"column_a", "COLUMN_A", true},
+		{"Column_A", "column_a", true},
+		{"ação", "AÇÃO", false},
+		{"ação", "aÇão", false},
+		{"ação", "AçãO", true},
+		{"a", "ab", false},
+	}
+
+	for _, c := range cases {
+		if got := EqualIdentifiers(c.a, c.b); got != c.want {
+			t.Errorf("EqualIdentifiers(%q, %q) = %v, want %v", c.a, c.b, got, c.want)
+		}
+		if got := FoldIdentifier(c.a) == FoldIdentifier(c.b); got != c.want {
+			t.Errorf("FoldIdentifier(%q) == FoldIdentifier(%q) is %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+
+	if got := FoldIdentifier("Ação_A"); got != "ação_a" {
+		t.Errorf(`FoldIdentifier("Ação_A") = %q, want "ação_a"`, got)
+	}
+}
@@ -0,0 +1,279 @@
+// This package decodes the lexemes of the literals and of the quoted identifiers to Go values, following the rules of
+// SQLite, and encodes Go values as literals and identifiers.
+package literal
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrSyntax indicates that a lexeme is not of the expected kind of literal or identifier.
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrRange indicates that a hexadecimal literal doesn't fit in 64 bits.
+	ErrRange = errors.New("value out of range")
+)
+
+// Error is the error returned when a lexeme can't be decoded.
+type Error struct {
+	// Func is the function that failed.
+	Func string
+	// Lexeme is the lexeme given to Func.
+	Lexeme string
+	// Err is the reason of the failure, ErrSyntax or ErrRange.
+	Err error
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return "literal." + e.Func + ": decoding " + strconv.Quote(e.Lexeme) + ": " + e.Err.Error()
+}
+
+// Unwrap returns e.Err.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// String decodes the lexeme of a string literal, replacing each pair of single quotes by one single quote.
+func String(lexeme []byte) (string, error) {
+	if len(lexeme) < 2 || lexeme[0] != '\'' || lexeme[len(lexeme)-1] != '\'' {
+		return "", &Error{Func: "String", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	s, ok := unescape(lexeme[1:len(lexeme)-1], '\'')
+	if !ok {
+		return "", &Error{Func: "String", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	return s, nil
+}
+
+// unescape replaces the pairs of quote in b by one quote. It reports false if b contains a quote that is not paired.
+func unescape(b []byte, quote byte) (string, bool) {
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == quote {
+			if i+1 == len(b) || b[i+1] != quote {
+				return "", false
+			}
+			i++
+		}
+		sb.WriteByte(b[i])
+	}
+	return sb.String(), true
+}
+
+// Blob decodes the lexeme of a blob literal, like X'0A'. The number of hexadecimal digits must be even.
+func Blob(lexeme []byte) ([]byte, error) {
+	if len(lexeme) < 3 || (lexeme[0] != 'x' && lexeme[0] != 'X') || lexeme[1] != '\'' || lexeme[len(lexeme)-1] != '\'' {
+		return nil, &Error{Func: "Blob", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	digits := lexeme[2 : len(lexeme)-1]
+	b := make([]byte, hex.DecodedLen(len(digits)))
+	if _, err := hex.Decode(b, digits); err != nil {
+		return nil, &Error{Func: "Blob", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	return b, nil
+}
+
+// Number is the value of a numeric literal.
+type Number struct {
+	// Integer is the value if IsReal is false.
+	Integer int64
+	// Real is the value if IsReal is true.
+	Real float64
+	// IsReal reports whether the value is a REAL. Otherwise it is an INTEGER.
+	IsReal bool
+}
+
+// Float64 returns the value of n as a float64.
+func (n Number) Float64() float64 {
+	if n.IsReal {
+		return n.Real
+	}
+	return float64(n.Integer)
+}
+
+// String returns a string representation of n.
+func (n Number) String() string {
+	if n.IsReal {
+		return FormatReal(n.Real)
+	}
+	return FormatInteger(n.Integer)
+}
+
+// Numeric decodes the lexeme of a numeric literal, like 1_000, 0x7FFFFFFFFFFFFFFF or 1.5e3. The underscores between
+// digits are ignored. As in SQLite:
+//   - a decimal literal without a decimal point and without an exponent is an INTEGER, unless it doesn't fit in 64
+//     bits, then it is a REAL;
+//   - a hexadecimal literal is an INTEGER whose bits are the 64 bits of the two's complement representation, so
+//     0xFFFFFFFFFFFFFFFF is -1. A hexadecimal literal that doesn't fit in 64 bits is an error;
+//   - the other literals are REAL.
+//
+// The literals are never negative, the minus sign is an operator. So 9223372036854775808 is a REAL, even if it is
+// preceded by a minus sign.
+func Numeric(lexeme []byte) (Number, error) {
+	s := string(lexeme)
+	if strings.Contains(s, "_") {
+		if !validUnderscores(s, len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')) {
+			return Number{}, &Error{Func: "Numeric", Lexeme: s, Err: ErrSyntax}
+		}
+		s = strings.ReplaceAll(s, "_", "")
+	}
+
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		digits := strings.TrimLeft(s[2:], "0")
+		if len(digits) > 16 {
+			return Number{}, &Error{Func: "Numeric", Lexeme: string(lexeme), Err: ErrRange}
+		}
+		if digits == "" {
+			digits = "0"
+		}
+		u, err := strconv.ParseUint(digits, 16, 64)
+		if err != nil {
+			return Number{}, &Error{Func: "Numeric", Lexeme: string(lexeme), Err: ErrSyntax}
+		}
+		return Number{Integer: int64(u)}, nil
+	}
+
+	isInteger, ok := decimal(s)
+	if !ok {
+		return Number{}, &Error{Func: "Numeric", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	if isInteger {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return Number{Integer: i}, nil
+		}
+		// the integer overflows, so it is a REAL.
+	}
+	// on overflow f is ±Inf, as in SQLite.
+	f, _ := strconv.ParseFloat(s, 64)
+	return Number{Real: f, IsReal: true}, nil
+}
+
+// decimal reports whether s is a decimal literal without underscores, and if it is an integer, that is, if it has
+// neither a decimal point nor an exponent.
+func decimal(s string) (isInteger, ok bool) {
+	i, digits := 0, 0
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		digits++
+	}
+	isInteger = true
+	if i < len(s) && s[i] == '.' {
+		isInteger = false
+		for i++; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return false, false
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		isInteger = false
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		start := i
+		for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		}
+		if i == start {
+			return false, false
+		}
+	}
+	return isInteger, i == len(s)
+}
+
+// validUnderscores reports whether all the underscores of s are between two digits. The digits are hexadecimal if hex
+// is true.
+func validUnderscores(s string, hex bool) bool {
+	isDigit := func(b byte) bool {
+		return b >= '0' && b <= '9' || hex && (b >= 'a' && b <= 'f' || b >= 'A' && b <= 'F')
+	}
+	for i := range len(s) {
+		if s[i] == '_' && (i == 0 || i == len(s)-1 || !isDigit(s[i-1]) || !isDigit(s[i+1])) {
+			return false
+		}
+	}
+	return true
+}
+
+// Identifier decodes the lexeme of an identifier. The identifiers quoted with double quotes, square brackets or grave
+// accents are unquoted, the others are returned as they are.
+func Identifier(lexeme []byte) (string, error) {
+	if len(lexeme) == 0 {
+		return "", &Error{Func: "Identifier", Lexeme: "", Err: ErrSyntax}
+	}
+	var (
+		closing byte
+		ok      bool
+		s       string
+	)
+	switch lexeme[0] {
+	case '"':
+		closing = '"'
+	case '`':
+		closing = '`'
+	case '[':
+		closing = ']'
+	default:
+		return string(lexeme), nil
+	}
+	if len(lexeme) < 2 || lexeme[len(lexeme)-1] != closing {
+		return "", &Error{Func: "Identifier", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	if closing == ']' {
+		s, ok = string(lexeme[1:len(lexeme)-1]), !strings.Contains(string(lexeme[1:len(lexeme)-1]), "]")
+	} else {
+		s, ok = unescape(lexeme[1:len(lexeme)-1], closing)
+	}
+	if !ok {
+		return "", &Error{Func: "Identifier", Lexeme: string(lexeme), Err: ErrSyntax}
+	}
+	return s, nil
+}
+
+// QuoteString returns s as a string literal.
+func QuoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// QuoteBlob returns b as a blob literal.
+func QuoteBlob(b []byte) string {
+	return "X'" + strings.ToUpper(hex.EncodeToString(b)) + "'"
+}
+
+// FormatInteger returns i as a numeric literal. If i is negative the result is an expression with the minus operator.
+// math.MinInt64 is formatted as a hexadecimal literal, since its absolute value doesn't fit in an INTEGER.
+func FormatInteger(i int64) string {
+	if i == math.MinInt64 {
+		return "0x8000000000000000"
+	}
+	return strconv.FormatInt(i, 10)
+}
+
+// FormatReal returns f as a numeric literal that is decoded as a REAL. If f is negative the result is an expression
+// with the minus operator. The infinities are formatted as 9e999 and -9e999, like SQLite does, and NaN is formatted as
+// NULL, since SQLite has no NaN.
+func FormatReal(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NULL"
+	case math.IsInf(f, 1):
+		return "9e999"
+	case math.IsInf(f, -1):
+		return "-9e999"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// QuoteIdentifier returns s as an identifier quoted with double quotes.
+func QuoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
@@ -0,0 +1,190 @@
+package literal
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		lexeme string
+		value  string
+		err    error
+	}{
+		{lexeme: `''`, value: ``},
+		{lexeme: `'abc'`, value: `abc`},
+		{lexeme: `'it''s'`, value: `it's`},
+		{lexeme: `''''`, value: `'`},
+		{lexeme: `'ação'`, value: `ação`},
+		{lexeme: `'it's'`, err: ErrSyntax},
+		{lexeme: `'abc`, err: ErrSyntax},
+		{lexeme: `'`, err: ErrSyntax},
+		{lexeme: `abc`, err: ErrSyntax},
+	}
+
+	for _, c := range cases {
+		value, err := String([]byte(c.lexeme))
+		if !errors.Is(err, c.err) || value != c.value {
+			t.Errorf("String(%s) = %q, %v; want %q, %v", c.lexeme, value, err, c.value, c.err)
+		}
+	}
+}
+
+func TestBlob(t *testing.T) {
+	cases := []struct {
+		lexeme string
+		value  []byte
+		err    error
+	}{
+		{lexeme: `X''`, value: []byte{}},
+		{lexeme: `X'0A'`, value: []byte{0x0A}},
+		{lexeme: `x'cafe'`, value: []byte{0xCA, 0xFE}},
+		{lexeme: `X'C0FEE'`, err: ErrSyntax},
+		{lexeme: `X'CAR'`, err: ErrSyntax},
+		{lexeme: `'CAFE'`, err: ErrSyntax},
+		{lexeme: `X'CAFE`, err: ErrSyntax},
+	}
+
+	for _, c := range cases {
+		value, err := Blob([]byte(c.lexeme))
+		if !errors.Is(err, c.err) || !bytes.Equal(value, c.value) {
+			t.Errorf("Blob(%s) = %v, %v; want %v, %v", c.lexeme, value, err, c.value, c.err)
+		}
+	}
+}
+
+func TestNumeric(t *testing.T) {
+	cases := []struct {
+		lexeme string
+		value  Number
+		err    error
+	}{
+		{lexeme: `0`, value: Number{Integer: 0}},
+		{lexeme: `1234`, value: Number{Integer: 1234}},
+		{lexeme: `1_000_000`, value: Number{Integer: 1000000}},
+		{lexeme: `9223372036854775807`, value: Number{Integer: math.MaxInt64}},
+		{lexeme: `9223372036854775808`, value: Number{Real: 9223372036854775808, IsReal: true}},
+		{lexeme: `0x7FFFFFFFFFFFFFFF`, value: Number{Integer: math.MaxInt64}},
+		{lexeme: `0xFFFFFFFFFFFFFFFF`, value: Number{Integer: -1}},
+		{lexeme: `0x8000000000000000`, value: Number{Integer: math.MinInt64}},
+		{lexeme: `0x0000000000000000FF`, value: Number{Integer: 255}},
+		{lexeme: `0xCA_FE`, value: Number{Integer: 0xCAFE}},
+		{lexeme: `0x0`, value: Number{Integer: 0}},
+		{lexeme: `0x10000000000000000`, err: ErrRange},
+		{lexeme: `1.5`, value: Number{Real: 1.5, IsReal: true}},
+		{lexeme: `1.`, value: Number{Real: 1, IsReal: true}},
+		{lexeme: `.5`, value: Number{Real: 0.5, IsReal: true}},
+		{lexeme: `1e3`, value: Number{Real: 1000, IsReal: true}},
+		{lexeme: `1.5E-3`, value: Number{Real: 0.0015, IsReal: true}},
+		{lexeme: `1.9E+8_0`, value: Number{Real: 1.9e80, IsReal: true}},
+		{lexeme: `1e999`, value: Number{Real: math.Inf(1), IsReal: true}},
+		{lexeme: `1_`, err: ErrSyntax},
+		{lexeme: `_1`, err: ErrSyntax},
+		{lexeme: `1__0`, err: ErrSyntax},
+		{lexeme: `1_e5`, err: ErrSyntax},
+		{lexeme: `-1`, err: ErrSyntax},
+		{lexeme: `1e`, err: ErrSyntax},
+		{lexeme: `.`, err: ErrSyntax},
+		{lexeme: `inf`, err: ErrSyntax},
+		{lexeme: `0x`, err: ErrSyntax},
+		{lexeme: `0xG`, err: ErrSyntax},
+		{lexeme: ``, err: ErrSyntax},
+	}
+
+	for _, c := range cases {
+		value, err := Numeric([]byte(c.lexeme))
+		if !errors.Is(err, c.err) || value != c.value {
+			t.Errorf("Numeric(%s) = %v, %v; want %v, %v", c.lexeme, value, err, c.value, c.err)
+		}
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	cases := []struct {
+		lexeme string
+		value  string
+		err    error
+	}{
+		{lexeme: `col`, value: `col`},
+		{lexeme: `"col"`, value: `col`},
+		{lexeme: `"col"""`, value: `col"`},
+		{lexeme: `[col]`, value: `col`},
+		{lexeme: `[co"l]`, value: `co"l`},
+		{lexeme: "`co``l`", value: "co`l"},
+		{lexeme: `"col`, err: ErrSyntax},
+		{lexeme: `[col`, err: ErrSyntax},
+		{lexeme: `[co]l]`, err: ErrSyntax},
+		{lexeme: `"co"l"`, err: ErrSyntax},
+		{lexeme: ``, err: ErrSyntax},
+	}
+
+	for _, c := range cases {
+		value, err := Identifier([]byte(c.lexeme))
+		if !errors.Is(err, c.err) || value != c.value {
+			t.Errorf("Identifier(%s) = %q, %v; want %q, %v", c.lexeme, value, err, c.value, c.err)
+		}
+	}
+}
+
+func TestError(t *testing.T) {
+	_, err := Numeric([]byte("0x10000000000000000"))
+	want := `literal.Numeric: decoding "0x10000000000000000": value out of range`
+	if err == nil || err.Error() != want {
+		t.Errorf("want %q, got %v", want, err)
+	}
+}
+
+func TestEncoders(t *testing.T) {
+	cases := []struct {
+		got, want string
+	}{
+		{QuoteString(`it's`), `'it''s'`},
+		{QuoteString(``), `''`},
+		{QuoteBlob([]byte{0x0A, 0xFE}), `X'0AFE'`},
+		{QuoteBlob(nil), `X''`},
+		{FormatInteger(-42), `-42`},
+		{FormatInteger(math.MinInt64), `0x8000000000000000`},
+		{FormatReal(1), `1.0`},
+		{FormatReal(1.5e-7), `1.5e-07`},
+		{FormatReal(math.Inf(1)), `9e999`},
+		{FormatReal(math.Inf(-1)), `-9e999`},
+		{FormatReal(math.NaN()), `NULL`},
+		{QuoteIdentifier(`col"`), `"col"""`},
+		{Number{Integer: 7}.String(), `7`},
+		{Number{Real: 7, IsReal: true}.String(), `7.0`},
+	}
+
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("want %s, got %s", c.want, c.got)
+		}
+	}
+}
+
+// TestRoundTrip tests that the encoders and the decoders are inverses.
+func TestRoundTrip(t *testing.T) {
+	for _, s := range []string{``, `it's`, `''`, `ação`} {
+		if v, err := String([]byte(QuoteString(s))); err != nil || v != s {
+			t.Errorf("%q: got %q, %v", s, v, err)
+		}
+		if v, err := Identifier([]byte(QuoteIdentifier(s))); err != nil || v != s {
+			t.Errorf("%q: got %q, %v", s, v, err)
+		}
+	}
+	for _, i := range []int64{0, 1, math.MaxInt64, math.MinInt64} {
+		if v, err := Numeric([]byte(FormatInteger(i))); err != nil || v != (Number{Integer: i}) {
+			t.Errorf("%d: got %v, %v", i, v, err)
+		}
+	}
+	for _, f := range []float64{0, 1, 0.1, 1e300, 5e-324} {
+		if v, err := Numeric([]byte(FormatReal(f))); err != nil || v != (Number{Real: f, IsReal: true}) {
+			t.Errorf("%g: got %v, %v", f, v, err)
+		}
+	}
+	b := []byte{0, 1, 0xFF}
+	if v, err := Blob([]byte(QuoteBlob(b))); err != nil || !bytes.Equal(v, b) {
+		t.Errorf("%v: got %v, %v", b, v, err)
+	}
+}
@@ -0,0 +1,276 @@
+// This package compares two schemas and generates the SQL that migrates a database from one to the other. The schemas
+// are catalogs, usually loaded from the CREATE statements of the schema before and after the migration. It also checks
+// migration scripts for destructive or risky operations and squashes a sequence of migrations in a single schema
+// script.
+package migration
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+)
+
+// Rename is the renaming of an object or of a column.
+type Rename struct {
+	From, To string
+}
+
+// Change is the change of the definition of an object or of a column. From is as it was and To is as it is.
+type Change[T any] struct {
+	From, To T
+}
+
+// Diff is the difference between two catalogs. The renames are found by comparing the definitions: an object dropped
+// and an object added with the same definition, except for the name, are a rename, and so are a column dropped and a
+// column added with the same definition in the same position. The objects that were renamed and changed are dropped
+// and added, but a column dropped and a column added in the same position with the same type name are a rename even if
+// the constraints were changed, so that the rows are copied when the table is rebuilt. The type names are compared
+// ignoring the case.
+type Diff struct {
+	AddedTables   []*catalog.Table
+	DroppedTables []*catalog.Table
+	RenamedTables []Rename
+	// ChangedTables are the tables whose columns, constraints or options were changed. The names are the names after
+	// the renames.
+	ChangedTables []*TableDiff
+
+	AddedIndexes   []*catalog.Index
+	DroppedIndexes []*catalog.Index
+	RenamedIndexes []Rename
+	ChangedIndexes []Change[*catalog.Index]
+
+	AddedViews   []*catalog.View
+	DroppedViews []*catalog.View
+	RenamedViews []Rename
+	ChangedViews []Change[*catalog.View]
+
+	AddedTriggers   []*catalog.Trigger
+	DroppedTriggers []*catalog.Trigger
+	RenamedTriggers []Rename
+	ChangedTriggers []Change[*catalog.Trigger]
+
+	// from and to are the catalogs compared. from has the renames already applied.
+	from, to *catalog.Catalog
+}
+
+// Empty reports whether d has no differences.
+func (d *Diff) Empty() bool {
+	return len(d.AddedTables) == 0 && len(d.DroppedTables) == 0 && len(d.RenamedTables) == 0 && len(d.ChangedTables) == 0 &&
+		len(d.AddedIndexes) == 0 && len(d.DroppedIndexes) == 0 && len(d.RenamedIndexes) == 0 && len(d.ChangedIndexes) == 0 &&
+		len(d.AddedViews) == 0 && len(d.DroppedViews) == 0 && len(d.RenamedViews) == 0 && len(d.ChangedViews) == 0 &&
+		len(d.AddedTriggers) == 0 && len(d.DroppedTriggers) == 0 && len(d.RenamedTriggers) == 0 && len(d.ChangedTriggers) == 0
+}
+
+// TableDiff is the difference between two definitions of a table.
+type TableDiff struct {
+	// From is the table as it was, but with the renames applied, and To is the table as it is.
+	From, To *catalog.Table
+
+	AddedColumns   []*catalog.Column
+	DroppedColumns []*catalog.Column
+	RenamedColumns []Rename
+	// ChangedColumns are the columns whose type name or constraints were changed.
+	ChangedColumns []Change[*catalog.Column]
+
+	// AddedConstraints and DroppedConstraints are the table constraints added and dropped. A changed constraint is
+	// dropped and added.
+	AddedConstraints   []*catalog.Constraint
+	DroppedConstraints []*catalog.Constraint
+
+	// ChangedOptions reports whether WITHOUT ROWID or STRICT were changed, or, for a virtual table or a table created
+	// with AS SELECT, whether the definition was changed.
+	ChangedOptions bool
+}
+
+// empty reports whether td has no differences.
+func (td *TableDiff) empty() bool {
+	return len(td.AddedColumns) == 0 && len(td.DroppedColumns) == 0 && len(td.RenamedColumns) == 0 &&
+		len(td.ChangedColumns) == 0 && len(td.AddedConstraints) == 0 && len(td.DroppedConstraints) == 0 && !td.ChangedOptions &&
+		!td.Reordered()
+}
+
+// Compare returns the difference between the catalogs from and to, that is, what must be done to from to make it equal
+// to to. The catalogs are not changed.
+func Compare(from, to *catalog.Catalog) *Diff {
+	d := &Diff{from: from.Clone(), to: to}
+
+	// the renames are applied to from, so the objects that depend on the renamed ones are compared with their new names.
+	dropped, added := unmatched(d.from.Tables, to.Tables, tableName)
+	for _, r := range renames(dropped, added, tableName, func(t *catalog.Table) string { return definition(t).SQL() }) {
+		d.from.RenameTable(r.From, r.To)
+		d.RenamedTables = append(d.RenamedTables, r)
+	}
+	var columnRenames [][]Rename
+	for _, t := range to.Tables {
+		ft := d.from.TableIn(t.SchemaName(), t.Name)
+		if ft == nil {
+			columnRenames = append(columnRenames, nil)
+			continue
+		}
+		position := func(c *catalog.Column) int {
+			if i := slices.Index(ft.Columns, c); i >= 0 {
+				return i
+			}
+			return slices.Index(t.Columns, c)
+		}
+		dropped, added := unmatched(ft.Columns, t.Columns, columnName)
+		rs := renames(dropped, added, columnName, func(c *catalog.Column) string {
+			return strconv.Itoa(position(c)) + " " + columnDefinition(c)
+		})
+		// the columns renamed and changed are paired by the position and the type name.
+		dropped = slices.DeleteFunc(dropped, func(c *catalog.Column) bool {
+			return slices.ContainsFunc(rs, func(r Rename) bool { return literal.EqualIdentifiers(r.From, c.Name) })
+		})
+		added = slices.DeleteFunc(added, func(c *catalog.Column) bool {
+			return slices.ContainsFunc(rs, func(r Rename) bool { return literal.EqualIdentifiers(r.To, c.Name) })
+		})
+		rs = append(rs, renames(dropped, added, columnName, func(c *catalog.Column) string {
+			return strconv.Itoa(position(c)) + " " + strings.ToUpper(c.Type)
+		})...)
+		for _, r := range rs {
+			d.from.RenameColumn(t.Name, r.From, r.To)
+		}
+		columnRenames = append(columnRenames, rs)
+	}
+
+	d.DroppedTables, d.AddedTables = unmatched(d.from.Tables, to.Tables, tableName)
+	for i, t := range to.Tables {
+		ft := d.from.TableIn(t.SchemaName(), t.Name)
+		if ft == nil {
+			continue
+		}
+		if td := compareTables(ft, t); !td.empty() || len(columnRenames[i]) > 0 {
+			td.RenamedColumns = columnRenames[i]
+			d.ChangedTables = append(d.ChangedTables, td)
+		}
+	}
+
+	d.DroppedIndexes, d.AddedIndexes, d.RenamedIndexes, d.ChangedIndexes = compareObjects(d.from.Indexes, to.Indexes,
+		func(i *catalog.Index) string { return i.Name },
+		func(i *catalog.Index, name string) string { c := *i; c.Name, c.Schema = name, ""; return c.SQL() })
+	d.DroppedViews, d.AddedViews, d.RenamedViews, d.ChangedViews = compareObjects(d.from.Views, to.Views,
+		func(v *catalog.View) string { return v.Name },
+		func(v *catalog.View, name string) string { c := *v; c.Name, c.Schema = name, ""; return c.SQL() })
+	d.DroppedTriggers, d.AddedTriggers, d.RenamedTriggers, d.ChangedTriggers = compareObjects(d.from.Triggers, to.Triggers,
+		func(t *catalog.Trigger) string { return t.Name },
+		func(t *catalog.Trigger, name string) string { c := *t; c.Name, c.Schema = name, ""; return c.SQL() })
+	return d
+}
+
+// compareTables compares two definitions of a table with the same name.
+func compareTables(from, to *catalog.Table) *TableDiff {
+	td := &TableDiff{From: from, To: to}
+	if from.Virtual() || to.Virtual() || from.Select != "" || to.Select != "" {
+		td.ChangedOptions = definition(from).SQL() != definition(to).SQL()
+		return td
+	}
+	td.DroppedColumns, td.AddedColumns = unmatched(from.Columns, to.Columns, columnName)
+	for _, c := range to.Columns {
+		if fc := from.Column(c.Name); fc != nil && columnDefinition(fc) != columnDefinition(c) {
+			td.ChangedColumns = append(td.ChangedColumns, Change[*catalog.Column]{From: fc, To: c})
+		}
+	}
+	sqlOf := func(c *catalog.Constraint) string { return c.SQL() }
+	for _, c := range from.Constraints {
+		if !slices.ContainsFunc(to.Constraints, func(d *catalog.Constraint) bool { return sqlOf(c) == sqlOf(d) }) {
+			td.DroppedConstraints = append(td.DroppedConstraints, c)
+		}
+	}
+	for _, c := range to.Constraints {
+		if !slices.ContainsFunc(from.Constraints, func(d *catalog.Constraint) bool { return sqlOf(c) == sqlOf(d) }) {
+			td.AddedConstraints = append(td.AddedConstraints, c)
+		}
+	}
+	td.ChangedOptions = from.WithoutRowid != to.WithoutRowid || from.Strict != to.Strict
+	return td
+}
+
+// compareObjects compares the objects from and to, that are identified by the name given by nameOf. sqlAs returns the
+// definition of an object with the given name.
+func compareObjects[T any](from, to []T, nameOf func(T) string, sqlAs func(T, string) string) (dropped, added []T, renamed []Rename, changed []Change[T]) {
+	dropped, added = unmatched(from, to, nameOf)
+	renamed = renames(dropped, added, nameOf, func(o T) string { return sqlAs(o, "") })
+	dropped = slices.DeleteFunc(dropped, func(o T) bool {
+		return slices.ContainsFunc(renamed, func(r Rename) bool { return literal.EqualIdentifiers(r.From, nameOf(o)) })
+	})
+	added = slices.DeleteFunc(added, func(o T) bool {
+		return slices.ContainsFunc(renamed, func(r Rename) bool { return literal.EqualIdentifiers(r.To, nameOf(o)) })
+	})
+	for _, o := range to {
+		i := slices.IndexFunc(from, func(f T) bool { return literal.EqualIdentifiers(nameOf(f), nameOf(o)) })
+		if i >= 0 && sqlAs(from[i], "") != sqlAs(o, "") {
+			changed = append(changed, Change[T]{From: from[i], To: o})
+		}
+	}
+	return dropped, added, renamed, changed
+}
+
+// unmatched returns the elements of from whose names, given by nameOf, are not in to, and the elements of to whose
+// names are not in from.
+func unmatched[T any](from, to []T, nameOf func(T) string) (onlyFrom, onlyTo []T) {
+	has := func(s []T, n string) bool {
+		return slices.ContainsFunc(s, func(e T) bool { return literal.EqualIdentifiers(nameOf(e), n) })
+	}
+	for _, e := range from {
+		if !has(to, nameOf(e)) {
+			onlyFrom = append(onlyFrom, e)
+		}
+	}
+	for _, e := range to {
+		if !has(from, nameOf(e)) {
+			onlyTo = append(onlyTo, e)
+		}
+	}
+	return onlyFrom, onlyTo
+}
+
+// renames pairs the dropped elements with the added elements that have the same definition, in order. Each element is
+// paired at most once.
+func renames[T any](dropped, added []T, nameOf func(T) string, definitionOf func(T) string) []Rename {
+	var rs []Rename
+	used := make([]bool, len(added))
+	for _, d := range dropped {
+		for i, a := range added {
+			if !used[i] && definitionOf(d) == definitionOf(a) {
+				used[i] = true
+				rs = append(rs, Rename{From: nameOf(d), To: nameOf(a)})
+				break
+			}
+		}
+	}
+	return rs
+}
+
+// definition returns a copy of t without the name and the schema, and with the type names in upper case, so that its
+// SQL is the definition of the table.
+func definition(t *catalog.Table) *catalog.Table {
+	c := *t
+	c.Name, c.Schema = "", ""
+	c.Columns = make([]*catalog.Column, len(t.Columns))
+	for i, col := range t.Columns {
+		cc := *col
+		cc.Type = strings.ToUpper(cc.Type)
+		c.Columns[i] = &cc
+	}
+	return &c
+}
+
+// columnDefinition returns the definition of c with the type name in upper case.
+func columnDefinition(c *catalog.Column) string {
+	cc := *c
+	cc.Type = strings.ToUpper(cc.Type)
+	return cc.Definition()
+}
+
+// tableName returns the name of t.
+func tableName(t *catalog.Table) string {
+	return t.Name
+}
+
+// columnName returns the name of c.
+func columnName(c *catalog.Column) string {
+	return c.Name
+}
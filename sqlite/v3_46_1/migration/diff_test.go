@@ -0,0 +1,198 @@
+package migration
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// load loads code in a catalog, failing the test on error.
+func load(t *testing.T, code string) *catalog.Catalog {
+	t.Helper()
+	c, err := catalog.Load([]byte(code))
+	if err != nil {
+		t.Fatalf("Load(%q): %v", code, err)
+	}
+	return c
+}
+
+// names returns the names of the objects in s.
+func names[T any](s []T, nameOf func(T) string) []string {
+	var ns []string
+	for _, e := range s {
+		ns = append(ns, nameOf(e))
+	}
+	return ns
+}
+
+// TestCompareEqual tests that equal schemas have no differences.
+func TestCompareEqual(t *testing.T) {
+	code := `CREATE TABLE a(x INTEGER PRIMARY KEY, y TEXT);
+		CREATE INDEX i ON a(y);
+		CREATE VIEW v AS SELECT y FROM a;
+		CREATE TRIGGER tr AFTER DELETE ON a BEGIN SELECT 1; END;`
+	// the same schema, written differently.
+	other := `create table A (x integer primary key, y text);
+		create index i on a (y);
+		create view v as select y from a;
+		create trigger tr after delete on a begin select 1; end;`
+	if d := Compare(load(t, code), load(t, other)); !d.Empty() {
+		t.Errorf("want no differences, got %+v", d)
+	}
+}
+
+// TestCompareTables tests the differences between tables.
+func TestCompareTables(t *testing.T) {
+	from := load(t, `
+		CREATE TABLE kept(a, b);
+		CREATE TABLE dropped(a INT);
+		CREATE TABLE renamed(a INT NOT NULL, b TEXT);
+		CREATE TABLE changed(a INT, b TEXT, c, d INT, UNIQUE (a));
+	`)
+	to := load(t, `
+		CREATE TABLE kept(a, b);
+		CREATE TABLE added(a TEXT);
+		CREATE TABLE "new name"(a INT NOT NULL, b TEXT);
+		CREATE TABLE changed(a INT, bb TEXT, c REAL, e, CHECK (a > 0)) STRICT;
+	`)
+	d := Compare(from, to)
+	tableName := func(t *catalog.Table) string { return t.Name }
+	if got := names(d.AddedTables, tableName); !slices.Equal(got, []string{"added"}) {
+		t.Errorf("got added tables %v", got)
+	}
+	if got := names(d.DroppedTables, tableName); !slices.Equal(got, []string{"dropped"}) {
+		t.Errorf("got dropped tables %v", got)
+	}
+	if want := []Rename{{From: "renamed", To: "new name"}}; !slices.Equal(d.RenamedTables, want) {
+		t.Errorf("got renamed tables %v, want %v", d.RenamedTables, want)
+	}
+	if len(d.ChangedTables) != 1 {
+		t.Fatalf("got %d changed tables, want 1", len(d.ChangedTables))
+	}
+
+	td := d.ChangedTables[0]
+	columnName := func(c *catalog.Column) string { return c.Name }
+	if got := names(td.AddedColumns, columnName); !slices.Equal(got, []string{"e"}) {
+		t.Errorf("got added columns %v", got)
+	}
+	if got := names(td.DroppedColumns, columnName); !slices.Equal(got, []string{"d"}) {
+		t.Errorf("got dropped columns %v", got)
+	}
+	if want := []Rename{{From: "b", To: "bb"}}; !slices.Equal(td.RenamedColumns, want) {
+		t.Errorf("got renamed columns %v, want %v", td.RenamedColumns, want)
+	}
+	if len(td.ChangedColumns) != 1 || td.ChangedColumns[0].To.Name != "c" || td.ChangedColumns[0].To.Type != "REAL" {
+		t.Errorf("got changed columns %v", td.ChangedColumns)
+	}
+	if len(td.AddedConstraints) != 1 || td.AddedConstraints[0].Kind != catalog.ConstraintCheck {
+		t.Errorf("got added constraints %v", td.AddedConstraints)
+	}
+	if len(td.DroppedConstraints) != 1 || td.DroppedConstraints[0].Kind != catalog.ConstraintUnique {
+		t.Errorf("got dropped constraints %v", td.DroppedConstraints)
+	}
+	if !td.ChangedOptions || td.Reordered() || !td.Rebuild() {
+		t.Errorf("got ChangedOptions %t, Reordered %t and Rebuild %t", td.ChangedOptions, td.Reordered(), td.Rebuild())
+	}
+}
+
+// TestCompareRenamedAndChangedColumn tests that a column renamed and changed is a rename when the position and the
+// type name are the same.
+func TestCompareRenamedAndChangedColumn(t *testing.T) {
+	from := load(t, "CREATE TABLE t(a INTEGER, b TEXT, c INT);")
+	d := Compare(from, load(t, "CREATE TABLE t(a INTEGER, bb text NOT NULL, cc REAL);"))
+	if len(d.ChangedTables) != 1 {
+		t.Fatalf("got %d changed tables, want 1", len(d.ChangedTables))
+	}
+	td := d.ChangedTables[0]
+	if want := []Rename{{From: "b", To: "bb"}}; !slices.Equal(td.RenamedColumns, want) {
+		t.Errorf("got renamed columns %v, want %v", td.RenamedColumns, want)
+	}
+	if len(td.ChangedColumns) != 1 || td.ChangedColumns[0].From.Name != "bb" || td.ChangedColumns[0].To.Name != "bb" {
+		t.Errorf("got changed columns %v", td.ChangedColumns)
+	}
+	columnName := func(c *catalog.Column) string { return c.Name }
+	if got := names(td.DroppedColumns, columnName); !slices.Equal(got, []string{"c"}) {
+		t.Errorf("got dropped columns %v", got)
+	}
+	if got := names(td.AddedColumns, columnName); !slices.Equal(got, []string{"cc"}) {
+		t.Errorf("got added columns %v", got)
+	}
+}
+
+// TestCompareObjects tests the differences between indexes, views and triggers.
+func TestCompareObjects(t *testing.T) {
+	from := load(t, `
+		CREATE TABLE a(x, y);
+		CREATE INDEX kept ON a(x);
+		CREATE INDEX dropped ON a(y);
+		CREATE INDEX renamed ON a(x, y);
+		CREATE INDEX changed ON a(y) WHERE y > 0;
+		CREATE VIEW v AS SELECT x FROM a;
+		CREATE VIEW w AS SELECT y FROM a;
+		CREATE TRIGGER tr AFTER INSERT ON a BEGIN SELECT 1; END;
+	`)
+	to := load(t, `
+		CREATE TABLE a(x, y);
+		CREATE INDEX kept ON a(x);
+		CREATE INDEX added ON a(x DESC);
+		CREATE INDEX "new name" ON a(x, y);
+		CREATE INDEX changed ON a(y) WHERE y > 1;
+		CREATE VIEW v AS SELECT x FROM a;
+		CREATE VIEW w AS SELECT x, y FROM a;
+		CREATE TRIGGER tr2 AFTER INSERT ON a BEGIN SELECT 1; END;
+	`)
+	d := Compare(from, to)
+	indexName := func(i *catalog.Index) string { return i.Name }
+	if got := names(d.AddedIndexes, indexName); !slices.Equal(got, []string{"added"}) {
+		t.Errorf("got added indexes %v", got)
+	}
+	if got := names(d.DroppedIndexes, indexName); !slices.Equal(got, []string{"dropped"}) {
+		t.Errorf("got dropped indexes %v", got)
+	}
+	if want := []Rename{{From: "renamed", To: "new name"}}; !slices.Equal(d.RenamedIndexes, want) {
+		t.Errorf("got renamed indexes %v, want %v", d.RenamedIndexes, want)
+	}
+	if len(d.ChangedIndexes) != 1 || d.ChangedIndexes[0].To.Where != "y > 1" {
+		t.Errorf("got changed indexes %v", d.ChangedIndexes)
+	}
+	if len(d.ChangedViews) != 1 || d.ChangedViews[0].From.Name != "w" || len(d.AddedViews)+len(d.DroppedViews) != 0 {
+		t.Errorf("got changed views %v", d.ChangedViews)
+	}
+	if want := []Rename{{From: "tr", To: "tr2"}}; !slices.Equal(d.RenamedTriggers, want) {
+		t.Errorf("got renamed triggers %v, want %v", d.RenamedTriggers, want)
+	}
+}
+
+// TestCompareRenamedDependencies tests that the objects that depend on a renamed table or column are not changed when
+// they only follow the rename.
+func TestCompareRenamedDependencies(t *testing.T) {
+	from := load(t, `
+		CREATE TABLE a(x INT, y TEXT);
+		CREATE INDEX i ON a(y);
+		CREATE VIEW v AS SELECT a.y FROM a;
+	`)
+	d := Compare(from, load(t, `
+		CREATE TABLE b(x INT, y TEXT);
+		CREATE INDEX i ON b(y);
+		CREATE VIEW v AS SELECT b.y FROM b;
+	`))
+	if want := []Rename{{From: "a", To: "b"}}; !slices.Equal(d.RenamedTables, want) {
+		t.Fatalf("got renamed tables %v, want %v", d.RenamedTables, want)
+	}
+	if len(d.ChangedTables) != 0 || len(d.ChangedIndexes) != 0 || len(d.ChangedViews) != 0 {
+		t.Errorf("got changed tables %v, indexes %v and views %v", d.ChangedTables, d.ChangedIndexes, d.ChangedViews)
+	}
+
+	d = Compare(from, load(t, `
+		CREATE TABLE a(x INT, z TEXT);
+		CREATE INDEX i ON a(z);
+		CREATE VIEW v AS SELECT a.z FROM a;
+	`))
+	if len(d.ChangedTables) != 1 || !slices.Equal(d.ChangedTables[0].RenamedColumns, []Rename{{From: "y", To: "z"}}) {
+		t.Fatalf("got changed tables %v", d.ChangedTables)
+	}
+	if len(d.ChangedIndexes) != 0 || len(d.ChangedViews) != 0 {
+		t.Errorf("got changed indexes %v and views %v", d.ChangedIndexes, d.ChangedViews)
+	}
+}
@@ -0,0 +1,495 @@
+// This package reads migration files in the formats of the migration tools goose, golang-migrate and dbmate. The
+// annotations of goose and dbmate are comments, found with the comment tokens of the lexer, and the statements of each
+// section are split like sqlite3_complete does, unless the annotations mark the statement boundaries explicitly.
+package file
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/statement"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Format is the format of a migration file.
+type Format int
+
+const (
+	// Goose is the format of goose: a file with the sections started by "-- +goose Up" and "-- +goose Down". The
+	// statements between "-- +goose StatementBegin" and "-- +goose StatementEnd" are a single statement, and
+	// "-- +goose NO TRANSACTION" runs the migration outside of a transaction.
+	Goose Format = iota
+	// GolangMigrate is the format of golang-migrate: a pair of files, VERSION_NAME.up.sql and VERSION_NAME.down.sql.
+	GolangMigrate
+	// Dbmate is the format of dbmate: a file with the sections started by "-- migrate:up" and "-- migrate:down". The
+	// option "transaction:false" after the annotation runs the section outside of a transaction.
+	Dbmate
+)
+
+// String returns a string representation of f.
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatStrings) {
+		return strconv.Itoa(int(f))
+	}
+	return formatStrings[f]
+}
+
+// formatStrings contains the string representation of the formats. Note that the value of a format is the index of
+// your string representation.
+var formatStrings = []string{"Goose", "GolangMigrate", "Dbmate"}
+
+var (
+	// ErrNoUp is returned when a file of goose or dbmate has no up section.
+	ErrNoUp = errors.New("migration file: no up annotation")
+	// ErrOutsideSection is returned when a file of goose or dbmate has a statement before the first annotation.
+	ErrOutsideSection = errors.New("migration file: statement outside of an up or down section")
+	// ErrStatementBlock is returned when the goose annotations StatementBegin and StatementEnd don't match.
+	ErrStatementBlock = errors.New("migration file: unbalanced StatementBegin and StatementEnd")
+	// ErrUnknownFormat is returned when the format of a file can't be detected.
+	ErrUnknownFormat = errors.New("migration file: unknown format")
+	// ErrDuplicateVersion is returned when two migrations of a directory have the same version.
+	ErrDuplicateVersion = errors.New("migration file: duplicate version")
+)
+
+// Error is an error in a line of a migration file.
+type Error struct {
+	// Line is the line of the error, starting at 1.
+	Line int
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Statement is a statement of a migration.
+type Statement struct {
+	// SQL is the code of the statement, with the comments before it, the semicolon and the comments on the same line
+	// after it, if any, but without the white spaces around it.
+	SQL []byte
+	// Offset is the offset of SQL in the file.
+	Offset int
+	// Trees are the parse trees of the statements in SQL, of kind parsetree.KindSQLStatement. There is more than one
+	// only in a block of goose with several statements.
+	Trees []parsetree.NonTerminal
+}
+
+// Section is the up or the down section of a migration.
+type Section struct {
+	Statements []Statement
+	// NoTransaction reports whether the section must be executed outside of a transaction.
+	NoTransaction bool
+}
+
+// Migration is a migration read from a file, or from a pair of files of golang-migrate.
+type Migration struct {
+	// Version and Name come from the name of the file, like in 20240102150405_create_users.sql. They are empty if the
+	// migration was not read by ReadDir.
+	Version, Name string
+	Format        Format
+	Up, Down      Section
+}
+
+// Parse parses a migration file of goose or dbmate.
+func Parse(code []byte, f Format) (*Migration, error) {
+	m := &Migration{Format: f}
+	r := &reader{code: code, m: m}
+	if err := r.read(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ParseGolangMigrate parses the up and down files of a migration of golang-migrate. down is nil if there is no down
+// file.
+func ParseGolangMigrate(up, down []byte) (*Migration, error) {
+	m := &Migration{Format: GolangMigrate}
+	var err error
+	if m.Up.Statements, err = split(up, 0, len(up)); err != nil {
+		return nil, fmt.Errorf("up: %w", err)
+	}
+	if m.Down.Statements, err = split(down, 0, len(down)); err != nil {
+		return nil, fmt.Errorf("down: %w", err)
+	}
+	return m, nil
+}
+
+// Detect returns the format of the file with the given name and code.
+func Detect(name string, code []byte) (Format, error) {
+	if strings.HasSuffix(name, ".up.sql") || strings.HasSuffix(name, ".down.sql") {
+		return GolangMigrate, nil
+	}
+	l := lexer.New(code)
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		if tok.Kind != token.KindSQLComment {
+			continue
+		}
+		if _, ok := gooseAnnotation(tok.Lexeme); ok {
+			return Goose, nil
+		}
+		if _, _, ok := dbmateAnnotation(tok.Lexeme); ok {
+			return Dbmate, nil
+		}
+	}
+	return 0, ErrUnknownFormat
+}
+
+// ReadDir reads the migrations in the directory dir of fsys, ordered by version. The files must be named
+// VERSION_NAME.sql, or VERSION_NAME.up.sql and VERSION_NAME.down.sql, and the format of each one is detected. The
+// versions must be unique, comparing the numeric versions as numbers.
+func ReadDir(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	var ms []*Migration
+	pairs := make(map[string][2][]byte)
+	// bases are the base names of the pairs, in the order of the directory.
+	var bases []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".sql") {
+			continue
+		}
+		code, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		f, err := Detect(e.Name(), code)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+		if f == GolangMigrate {
+			base, down := strings.CutSuffix(strings.TrimSuffix(e.Name(), ".up.sql"), ".down.sql")
+			pair, ok := pairs[base]
+			if !ok {
+				bases = append(bases, base)
+			}
+			if down {
+				pair[1] = code
+			} else {
+				pair[0] = code
+			}
+			pairs[base] = pair
+			continue
+		}
+		m, err := Parse(code, f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+		m.Version, m.Name = versionAndName(strings.TrimSuffix(e.Name(), ".sql"))
+		ms = append(ms, m)
+	}
+	for _, base := range bases {
+		pair := pairs[base]
+		if pair[0] == nil {
+			return nil, fmt.Errorf("%s.down.sql: %w: no up file", base, ErrNoUp)
+		}
+		m, err := ParseGolangMigrate(pair[0], pair[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", base, err)
+		}
+		m.Version, m.Name = versionAndName(base)
+		ms = append(ms, m)
+	}
+	slices.SortStableFunc(ms, func(a, b *Migration) int { return compareVersions(a.Version, b.Version) })
+	for i := 1; i < len(ms); i++ {
+		if compareVersions(ms[i-1].Version, ms[i].Version) == 0 {
+			return nil, fmt.Errorf("%w: %s and %s", ErrDuplicateVersion, baseName(ms[i-1]), baseName(ms[i]))
+		}
+	}
+	return ms, nil
+}
+
+// baseName returns the base name of the file of m, that is VERSION_NAME, or VERSION if m has no name.
+func baseName(m *Migration) string {
+	if m.Name == "" {
+		return m.Version
+	}
+	return m.Version + "_" + m.Name
+}
+
+// versionAndName splits the base name of a migration file in the version and the name.
+func versionAndName(base string) (version, name string) {
+	version, name, _ = strings.Cut(base, "_")
+	return version, name
+}
+
+// compareVersions compares two versions. The numeric versions are compared as numbers.
+func compareVersions(a, b string) int {
+	if isDigits(a) && isDigits(b) {
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// isDigits reports whether s has only ASCII digits.
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// reader reads a migration file of goose or dbmate.
+type reader struct {
+	code []byte
+	m    *Migration
+	// section is the section being read, or nil before the first annotation.
+	section *Section
+	// start is the offset of the code not yet added to a section.
+	start int
+	// block is the offset of the StatementBegin being read, or -1.
+	block int
+}
+
+// read reads the file.
+func (r *reader) read() error {
+	r.block = -1
+	l := lexer.New(r.code)
+	lineStart := true
+	for {
+		offset := int(l.Offset())
+		tok := l.Next()
+		if tok.Kind == token.KindEOF {
+			break
+		}
+		annotation := tok.Kind == token.KindSQLComment && lineStart
+		lineStart = tok.Kind == token.KindWhiteSpace && bytes.ContainsRune(tok.Lexeme, '\n') ||
+			tok.Kind == token.KindWhiteSpace && lineStart || tok.Kind == token.KindByteOrderMark
+		if !annotation {
+			continue
+		}
+		if err := r.annotation(tok.Lexeme, offset); err != nil {
+			if e := (*Error)(nil); errors.As(err, &e) {
+				return err
+			}
+			return &Error{Line: line(r.code, offset), Err: err}
+		}
+	}
+	if r.block >= 0 {
+		return &Error{Line: line(r.code, r.block), Err: ErrStatementBlock}
+	}
+	if err := r.flush(len(r.code)); err != nil {
+		return err
+	}
+	if r.section == nil {
+		return ErrNoUp
+	}
+	return nil
+}
+
+// annotation handles the comment at offset, if it is an annotation of the format.
+func (r *reader) annotation(comment []byte, offset int) error {
+	var (
+		kind          string
+		noTransaction bool
+		ok            bool
+	)
+	if r.m.Format == Goose {
+		kind, ok = gooseAnnotation(comment)
+	} else {
+		kind, noTransaction, ok = dbmateAnnotation(comment)
+	}
+	if !ok {
+		return nil
+	}
+	end := offset + len(comment)
+
+	switch kind {
+	case "StatementBegin":
+		if r.block >= 0 || r.section == nil {
+			return ErrStatementBlock
+		}
+		if err := r.flush(offset); err != nil {
+			return err
+		}
+		r.block, r.start = offset, end
+		return nil
+	case "StatementEnd":
+		if r.block < 0 {
+			return ErrStatementBlock
+		}
+		stmt, err := newStatement(r.code, r.start, offset)
+		if err != nil {
+			return err
+		}
+		if stmt != nil {
+			r.section.Statements = append(r.section.Statements, *stmt)
+		}
+		r.block, r.start = -1, end
+		return nil
+	case "NO TRANSACTION":
+		if err := r.flush(offset); err != nil {
+			return err
+		}
+		r.m.Up.NoTransaction, r.m.Down.NoTransaction = true, true
+		r.start = end
+		return nil
+	}
+
+	if r.block >= 0 {
+		return ErrStatementBlock
+	}
+	if err := r.flush(offset); err != nil {
+		return err
+	}
+	if kind == "Up" {
+		r.section = &r.m.Up
+	} else {
+		if r.section == nil {
+			return ErrNoUp
+		}
+		r.section = &r.m.Down
+	}
+	r.section.NoTransaction = r.section.NoTransaction || noTransaction
+	r.start = end
+	return nil
+}
+
+// flush adds the statements of the code from the start to end to the current section.
+func (r *reader) flush(end int) error {
+	stmts, err := split(r.code, r.start, end)
+	if err != nil {
+		return err
+	}
+	if len(stmts) > 0 && r.section == nil {
+		return &Error{Line: line(r.code, stmts[0].Offset), Err: ErrOutsideSection}
+	}
+	if r.section != nil {
+		r.section.Statements = append(r.section.Statements, stmts...)
+	}
+	r.start = end
+	return nil
+}
+
+// gooseAnnotation returns the kind of the goose annotation in comment: Up, Down, StatementBegin, StatementEnd or
+// NO TRANSACTION. ok is false if comment is not an annotation.
+func gooseAnnotation(comment []byte) (kind string, ok bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(string(comment)), "-- +goose ")
+	if !ok {
+		return "", false
+	}
+	rest = strings.Join(strings.Fields(rest), " ")
+	for _, k := range []string{"Up", "Down", "StatementBegin", "StatementEnd", "NO TRANSACTION"} {
+		if strings.EqualFold(rest, k) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// dbmateAnnotation returns the kind of the dbmate annotation in comment, Up or Down, and whether it has the option
+// transaction:false. ok is false if comment is not an annotation.
+func dbmateAnnotation(comment []byte) (kind string, noTransaction, ok bool) {
+	fields := strings.Fields(strings.TrimPrefix(string(comment), "--"))
+	if len(fields) == 0 {
+		return "", false, false
+	}
+	switch fields[0] {
+	case "migrate:up":
+		kind = "Up"
+	case "migrate:down":
+		kind = "Down"
+	default:
+		return "", false, false
+	}
+	return kind, slices.Contains(fields[1:], "transaction:false"), true
+}
+
+// split splits the code of file from start to end in statements.
+func split(file []byte, start, end int) ([]Statement, error) {
+	parts, rest := statement.Split(file[start:end])
+	parts = append(parts, rest)
+	// the comments on the line of the end of a statement belong to it.
+	for i := 1; i < len(parts); i++ {
+		n := trailing(parts[i])
+		parts[i-1] = parts[i-1][:len(parts[i-1])+n]
+		parts[i] = parts[i][n:]
+	}
+	var stmts []Statement
+	for _, part := range parts {
+		stmt, err := newStatement(file, start, start+len(part))
+		if err != nil {
+			return nil, err
+		}
+		if stmt != nil {
+			stmts = append(stmts, *stmt)
+		}
+		start += len(part)
+	}
+	return stmts, nil
+}
+
+// trailing returns the length of the prefix of code that ends with the last comment before the first new line, or zero
+// if there is no such comment.
+func trailing(code []byte) int {
+	n := 0
+	l := lexer.New(code)
+	for tok := l.Next(); ; tok = l.Next() {
+		switch tok.Kind {
+		case token.KindWhiteSpace:
+			if bytes.ContainsRune(tok.Lexeme, '\n') {
+				return n
+			}
+		case token.KindCComment:
+			n = int(l.Offset())
+		case token.KindSQLComment:
+			return int(l.Offset())
+		default:
+			return n
+		}
+	}
+}
+
+// newStatement parses the code of file from start to end, that has one statement or, in a block of goose, several
+// ones. It returns nil if the code has only white spaces and comments.
+func newStatement(file []byte, start, end int) (*Statement, error) {
+	code := file[start:end]
+	trimmed := bytes.TrimLeft(code, " \t\r\n\f")
+	start += len(code) - len(trimmed)
+	code = bytes.TrimRight(trimmed, " \t\r\n\f")
+	if !syntax.Significant(code) {
+		return nil, nil
+	}
+
+	stmt := &Statement{SQL: code, Offset: start}
+	p := parser.New(lexer.New(code))
+	for {
+		tree, err := syntax.Parse(p)
+		if err != nil {
+			return nil, &Error{Line: line(file, start), Err: err}
+		}
+		if !empty(tree) || len(stmt.Trees) == 0 {
+			stmt.Trees = append(stmt.Trees, tree)
+		}
+		if syntax.Last(tree) {
+			return stmt, nil
+		}
+	}
+}
+
+// empty reports whether tree has only the EOF.
+func empty(tree parsetree.NonTerminal) bool {
+	return tree.NumberOfChildren() == 1 && syntax.Last(tree)
+}
+
+// line returns the line, starting at 1, of the offset in code.
+func line(code []byte, offset int) int {
+	return bytes.Count(code[:offset], []byte("\n")) + 1
+}
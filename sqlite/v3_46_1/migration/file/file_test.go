@@ -0,0 +1,206 @@
+package file
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// sqls returns the SQL of the statements of s.
+func sqls(s Section) []string {
+	var ss []string
+	for _, stmt := range s.Statements {
+		ss = append(ss, string(stmt.SQL))
+	}
+	return ss
+}
+
+// TestParseGoose tests the annotations of goose.
+func TestParseGoose(t *testing.T) {
+	code := `-- a comment before the migration
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE TABLE t(a); -- +goose Down is not an annotation here
+-- the trigger
+CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;
+
+-- +goose StatementBegin
+INSERT INTO t VALUES (1);
+INSERT INTO t VALUES (2);
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER tr;
+DROP TABLE t
+`
+	m, err := Parse([]byte(code), Goose)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"CREATE TABLE t(a); -- +goose Down is not an annotation here",
+		"-- the trigger\nCREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;",
+		"INSERT INTO t VALUES (1);\nINSERT INTO t VALUES (2);",
+	}
+	if got := sqls(m.Up); !slices.Equal(got, want) {
+		t.Errorf("got up %q, want %q", got, want)
+	}
+	if got, want := sqls(m.Down), []string{"DROP TRIGGER tr;", "DROP TABLE t"}; !slices.Equal(got, want) {
+		t.Errorf("got down %q, want %q", got, want)
+	}
+	if !m.Up.NoTransaction || !m.Down.NoTransaction {
+		t.Errorf("want NoTransaction")
+	}
+	if n := len(m.Up.Statements[2].Trees); n != 2 {
+		t.Errorf("got %d trees in the block, want 2", n)
+	}
+	if n := len(m.Down.Statements[1].Trees); n != 1 {
+		t.Errorf("got %d trees in the last statement, want 1", n)
+	}
+	if stmt := m.Up.Statements[0]; string(code[stmt.Offset:stmt.Offset+len(stmt.SQL)]) != string(stmt.SQL) {
+		t.Errorf("wrong offset %d", stmt.Offset)
+	}
+}
+
+// TestParseDbmate tests the annotations of dbmate.
+func TestParseDbmate(t *testing.T) {
+	code := "-- migrate:up transaction:false\nCREATE TABLE t(a);\nVACUUM;\n\n-- migrate:down\nDROP TABLE t;\n"
+	m, err := Parse([]byte(code), Dbmate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sqls(m.Up), []string{"CREATE TABLE t(a);", "VACUUM;"}; !slices.Equal(got, want) {
+		t.Errorf("got up %q, want %q", got, want)
+	}
+	if got, want := sqls(m.Down), []string{"DROP TABLE t;"}; !slices.Equal(got, want) {
+		t.Errorf("got down %q, want %q", got, want)
+	}
+	if !m.Up.NoTransaction || m.Down.NoTransaction {
+		t.Errorf("got NoTransaction %t and %t", m.Up.NoTransaction, m.Down.NoTransaction)
+	}
+}
+
+// TestParseErrors tests the errors of Parse.
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		code   string
+		format Format
+		want   error
+		line   int
+	}{
+		{code: "-- a comment\n", format: Goose, want: ErrNoUp},
+		{code: "CREATE TABLE t(a);", format: Goose, want: ErrOutsideSection, line: 1},
+		{code: "CREATE TABLE t(a);\n-- +goose Up\n", format: Goose, want: ErrOutsideSection, line: 1},
+		{code: "-- +goose Down\nDROP TABLE t;", format: Goose, want: ErrNoUp, line: 1},
+		{code: "-- +goose Up\n-- +goose StatementBegin\nSELECT 1;\n", format: Goose, want: ErrStatementBlock, line: 2},
+		{code: "-- +goose Up\n-- +goose StatementEnd\n", format: Goose, want: ErrStatementBlock, line: 2},
+		{
+			code:   "-- +goose Up\n-- +goose StatementBegin\n-- +goose Down\n-- +goose StatementEnd",
+			format: Goose, want: ErrStatementBlock, line: 3,
+		},
+		{code: "-- migrate:up\nSELECT 1;\n\nSELECT FROM;", format: Dbmate, line: 4},
+	}
+	for _, c := range cases {
+		_, err := Parse([]byte(c.code), c.format)
+		if err == nil || c.want != nil && !errors.Is(err, c.want) {
+			t.Errorf("Parse(%q) = %v, want %v", c.code, err, c.want)
+			continue
+		}
+		var e *Error
+		if c.line > 0 && (!errors.As(err, &e) || e.Line != c.line) {
+			t.Errorf("Parse(%q) = %v, want an error on the line %d", c.code, err, c.line)
+		}
+	}
+}
+
+// TestParseGolangMigrate tests ParseGolangMigrate.
+func TestParseGolangMigrate(t *testing.T) {
+	m, err := ParseGolangMigrate([]byte("CREATE TABLE t(a);\nCREATE INDEX i ON t(a);\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sqls(m.Up), []string{"CREATE TABLE t(a);", "CREATE INDEX i ON t(a);"}; !slices.Equal(got, want) {
+		t.Errorf("got up %q, want %q", got, want)
+	}
+	if len(m.Down.Statements) != 0 {
+		t.Errorf("want no down statements")
+	}
+	if _, err := ParseGolangMigrate([]byte("SELECT 1;"), []byte("DROP;")); err == nil {
+		t.Errorf("want an error")
+	}
+}
+
+// TestReadDir tests that ReadDir detects the formats and orders the migrations by version.
+func TestReadDir(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/10_third.sql":       {Data: []byte("-- migrate:up\nCREATE TABLE c(x);\n-- migrate:down\nDROP TABLE c;")},
+		"m/2_second.up.sql":    {Data: []byte("CREATE TABLE b(x);")},
+		"m/2_second.down.sql":  {Data: []byte("DROP TABLE b;")},
+		"m/1_first.sql":        {Data: []byte("-- +goose Up\nCREATE TABLE a(x);\n-- +goose Down\nDROP TABLE a;")},
+		"m/README.md":          {Data: []byte("not a migration")},
+		"other/1_unknown.sql":  {Data: []byte("CREATE TABLE a(x);")},
+		"other2/1_a.down.sql":  {Data: []byte("DROP TABLE a;")},
+		"other3/1_a.sql":       {Data: []byte("-- +goose Up\nCREATE TABLE (;")},
+		"other4/dir.sql/x.sql": {Data: []byte("-- +goose Up")},
+		"dup/1_a.sql":          {Data: []byte("-- +goose Up\nCREATE TABLE a(x);")},
+		"dup/01_b.up.sql":      {Data: []byte("CREATE TABLE b(x);")},
+		"dup2/1_a.up.sql":      {Data: []byte("CREATE TABLE a(x);")},
+		"dup2/1_b.up.sql":      {Data: []byte("CREATE TABLE b(x);")},
+	}
+	ms, err := ReadDir(fsys, "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range ms {
+		got = append(got, m.Version+" "+m.Name+" "+m.Format.String())
+	}
+	if want := []string{"1 first Goose", "2 second GolangMigrate", "10 third Dbmate"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if len(ms[1].Down.Statements) != 1 {
+		t.Errorf("want the down file of golang-migrate")
+	}
+
+	if _, err := ReadDir(fsys, "other"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("got %v, want ErrUnknownFormat", err)
+	}
+	if _, err := ReadDir(fsys, "other2"); !errors.Is(err, ErrNoUp) {
+		t.Errorf("got %v, want ErrNoUp", err)
+	}
+	if _, err := ReadDir(fsys, "other3"); err == nil {
+		t.Errorf("want a syntax error")
+	}
+	if ms, err := ReadDir(fsys, "other4"); err != nil || len(ms) != 0 {
+		t.Errorf("got %v and %v, want no migrations", ms, err)
+	}
+	for dir, want := range map[string]string{"dup": "1_a and 01_b", "dup2": "1_a and 1_b"} {
+		if _, err := ReadDir(fsys, dir); !errors.Is(err, ErrDuplicateVersion) || !strings.HasSuffix(err.Error(), want) {
+			t.Errorf("got %v, want ErrDuplicateVersion of %s", err, want)
+		}
+	}
+	if _, err := ReadDir(fsys, "none"); err == nil {
+		t.Errorf("want an error")
+	}
+}
+
+// TestCompareVersions tests compareVersions.
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{a: "2", b: "10", want: -1},
+		{a: "010", b: "9", want: 1},
+		{a: "20240101", b: "20240101", want: 0},
+		{a: "v2", b: "v10", want: 1},
+	}
+	for _, c := range cases {
+		got := compareVersions(c.a, c.b)
+		if got < 0 && c.want >= 0 || got > 0 && c.want <= 0 || got == 0 && c.want != 0 {
+			t.Errorf("compareVersions(%q, %q) = %d, want the sign of %d", c.a, c.b, got, c.want)
+		}
+	}
+}
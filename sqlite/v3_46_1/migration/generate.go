@@ -0,0 +1,442 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// ErrNotNullColumn is returned when a table must be rebuilt with a new NOT NULL column without a default value, since
+// the rows copied to the new table would have NULL in that column.
+var ErrNotNullColumn = errors.New("migration: NOT NULL column without default")
+
+// Statements returns the statements, without the semicolons, that migrate a database with the schema compared as from
+// to the schema compared as to. The statements are in the order:
+//
+//  1. DROP TRIGGER, DROP VIEW and DROP INDEX for the objects that were dropped, renamed or changed, and for the
+//     triggers and views that depend on a table that will be rebuilt;
+//  2. ALTER TABLE ... RENAME TO for the renamed tables;
+//  3. the changes of the changed tables;
+//  4. DROP TABLE and CREATE TABLE for the dropped and the added tables;
+//  5. CREATE INDEX, CREATE VIEW and CREATE TRIGGER for the objects that were added, renamed or changed, and for the
+//     objects dropped in the first step or by the rebuild of a table.
+//
+// A changed table is changed with ALTER TABLE when SQLite allows it. Otherwise it is rebuilt following the
+// generalized ALTER TABLE procedure of SQLite: a new table is created, the rows are copied to it, the old table is
+// dropped and the new table is renamed, the renamed columns copied from your old names. In that case the statements
+// start with PRAGMA foreign_keys = OFF and end with PRAGMA foreign_key_check and PRAGMA foreign_keys = ON, so they must
+// not be executed in a transaction. A virtual table or a table created with AS SELECT that was changed is dropped and
+// created again.
+//
+// If a table must be rebuilt with a new NOT NULL column without a default value, no statements are returned and the
+// error wraps ErrNotNullColumn.
+func (d *Diff) Statements() ([]string, error) {
+	for _, td := range d.ChangedTables {
+		if !td.Rebuild() {
+			continue
+		}
+		for _, c := range td.To.Columns {
+			if td.From.Column(c.Name) == nil && notNullWithoutDefault(c) {
+				return nil, fmt.Errorf("%w: %s", ErrNotNullColumn, quote(td.To.Name)+"."+quote(c.Name))
+			}
+		}
+	}
+	g := &generator{d: d}
+	g.generate()
+	return g.stmts, nil
+}
+
+// Script returns the statements of d, each one followed by a semicolon and a new line. The error is the one of
+// Statements.
+func (d *Diff) Script() (string, error) {
+	stmts, err := d.Statements()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, stmt := range stmts {
+		b.WriteString(stmt)
+		b.WriteString(";\n")
+	}
+	return b.String(), nil
+}
+
+// Reordered reports whether the columns that were kept are in a different order, or whether a column was added
+// before the last column of the table. ALTER TABLE only adds a column after the last one.
+func (td *TableDiff) Reordered() bool {
+	var kept []string
+	for _, c := range td.From.Columns {
+		if td.To.Column(c.Name) != nil {
+			kept = append(kept, c.Name)
+		}
+	}
+	added := false
+	i := 0
+	for _, c := range td.To.Columns {
+		if td.From.Column(c.Name) == nil {
+			added = true
+			continue
+		}
+		if added || i >= len(kept) || !literal.EqualIdentifiers(kept[i], c.Name) {
+			return true
+		}
+		i++
+	}
+	return false
+}
+
+// Rebuild reports whether td has changes that SQLite cannot make with ALTER TABLE, so the table must be rebuilt.
+// The virtual tables and the tables created with AS SELECT are never rebuilt, they are dropped and created again.
+func (td *TableDiff) Rebuild() bool {
+	if td.recreate() {
+		return false
+	}
+	if len(td.ChangedColumns) > 0 || len(td.AddedConstraints) > 0 || len(td.DroppedConstraints) > 0 ||
+		td.ChangedOptions || td.Reordered() {
+		return true
+	}
+	for _, c := range td.AddedColumns {
+		if !addable(c) {
+			return true
+		}
+	}
+	for _, c := range td.DroppedColumns {
+		if !droppable(td.From, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// recreate reports whether the table must be dropped and created again.
+func (td *TableDiff) recreate() bool {
+	special := func(t *catalog.Table) bool { return t.Virtual() || t.Select != "" }
+	return td.ChangedOptions && (special(td.From) || special(td.To))
+}
+
+// addable reports whether c can be added with ALTER TABLE ... ADD COLUMN.
+func addable(c *catalog.Column) bool {
+	if c.Constraint(catalog.ConstraintPrimaryKey) != nil || c.Constraint(catalog.ConstraintUnique) != nil {
+		return false
+	}
+	if g := c.Constraint(catalog.ConstraintGenerated); g != nil && g.Stored {
+		return false
+	}
+	def := ""
+	if d := c.Constraint(catalog.ConstraintDefault); d != nil {
+		def = strings.ToUpper(d.Expression)
+	}
+	switch {
+	case !constant(def):
+		return false
+	case c.Constraint(catalog.ConstraintNotNull) != nil && (def == "" || def == "NULL"):
+		return false
+	case c.Constraint(catalog.ConstraintForeignKey) != nil && def != "" && def != "NULL":
+		return false
+	}
+	return true
+}
+
+// notNullWithoutDefault reports whether c is NOT NULL and has no default value, nor is generated.
+func notNullWithoutDefault(c *catalog.Column) bool {
+	if c.Constraint(catalog.ConstraintNotNull) == nil || c.Constraint(catalog.ConstraintGenerated) != nil {
+		return false
+	}
+	d := c.Constraint(catalog.ConstraintDefault)
+	return d == nil || strings.EqualFold(d.Expression, "NULL")
+}
+
+// constant reports whether the default expression def, in upper case, is constant, as ADD COLUMN requires.
+func constant(def string) bool {
+	return !strings.HasPrefix(def, "(") && def != "CURRENT_TIME" && def != "CURRENT_DATE" && def != "CURRENT_TIMESTAMP"
+}
+
+// droppable reports whether the column c of t can be dropped with ALTER TABLE ... DROP COLUMN.
+func droppable(t *catalog.Table, c *catalog.Column) bool {
+	for _, cons := range c.Constraints {
+		switch cons.Kind {
+		case catalog.ConstraintPrimaryKey, catalog.ConstraintUnique, catalog.ConstraintForeignKey:
+			return false
+		}
+	}
+	for _, cons := range t.Constraints {
+		switch cons.Kind {
+		case catalog.ConstraintPrimaryKey, catalog.ConstraintUnique:
+			if containsName(cons.ColumnNames(), c.Name) {
+				return false
+			}
+			for _, ic := range cons.Columns {
+				if mentions(ic.Expression, c.Name) {
+					return false
+				}
+			}
+		case catalog.ConstraintForeignKey:
+			if containsName(cons.ForeignKey.Columns, c.Name) {
+				return false
+			}
+		case catalog.ConstraintCheck:
+			if mentions(cons.Expression, c.Name) {
+				return false
+			}
+		}
+	}
+	for _, other := range t.Columns {
+		if other == c {
+			continue
+		}
+		for _, cons := range other.Constraints {
+			if (cons.Kind == catalog.ConstraintCheck || cons.Kind == catalog.ConstraintGenerated) && mentions(cons.Expression, c.Name) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mentions reports whether the expression expr has an identifier equal to name.
+func mentions(expr, name string) bool {
+	if expr == "" {
+		return false
+	}
+	l := lexer.New([]byte(expr))
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		if tok.Kind != token.KindIdentifier && !tok.Kind.IsKeyword() {
+			continue
+		}
+		if id, err := literal.Identifier(tok.Lexeme); err == nil && literal.EqualIdentifiers(id, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsName reports whether ns has a name equal to n.
+func containsName(ns []string, n string) bool {
+	return slices.ContainsFunc(ns, func(e string) bool { return literal.EqualIdentifiers(e, n) })
+}
+
+// generator generates the statements of a diff.
+type generator struct {
+	d     *Diff
+	stmts []string
+	// rebuilt are the names of the tables that are rebuilt or created again.
+	rebuilt []string
+	// droppedTriggers, droppedViews and droppedIndexes are the names of the objects dropped by the first step.
+	droppedTriggers, droppedViews, droppedIndexes []string
+}
+
+// generate generates the statements.
+func (g *generator) generate() {
+	rebuild := false
+	for _, td := range g.d.ChangedTables {
+		if td.Rebuild() {
+			rebuild = true
+			g.rebuilt = append(g.rebuilt, td.To.Name)
+		} else if td.recreate() {
+			g.rebuilt = append(g.rebuilt, td.To.Name)
+		}
+	}
+	if rebuild {
+		g.add("PRAGMA foreign_keys = OFF")
+	}
+
+	g.drops()
+	for _, r := range g.d.RenamedTables {
+		g.add("ALTER TABLE " + g.qualifiedFrom(r.To, r.From) + " RENAME TO " + quote(r.To))
+	}
+	for _, td := range g.d.ChangedTables {
+		g.alter(td)
+	}
+	for _, t := range g.d.DroppedTables {
+		g.add("DROP TABLE " + qualified(t.Schema, t.Name))
+	}
+	for _, t := range g.d.AddedTables {
+		g.add(t.SQL())
+	}
+	g.creates()
+
+	if rebuild {
+		g.add("PRAGMA foreign_key_check")
+		g.add("PRAGMA foreign_keys = ON")
+	}
+}
+
+// drops generates the DROP TRIGGER, DROP VIEW and DROP INDEX statements.
+func (g *generator) drops() {
+	d := g.d
+	// the views that depend, directly or through other views, on a rebuilt table.
+	var dependents []string
+	deps := slices.Clone(g.rebuilt)
+	for changed := true; changed; {
+		changed = false
+		for _, v := range d.from.Views {
+			if containsName(dependents, v.Name) {
+				continue
+			}
+			if slices.ContainsFunc(v.References(), func(ref string) bool { return containsName(deps, ref) }) {
+				dependents = append(dependents, v.Name)
+				deps = append(deps, v.Name)
+				changed = true
+			}
+		}
+	}
+
+	for _, t := range d.from.Triggers {
+		drop := slices.ContainsFunc(d.DroppedTriggers, func(e *catalog.Trigger) bool { return e == t }) ||
+			slices.ContainsFunc(d.ChangedTriggers, func(c Change[*catalog.Trigger]) bool { return c.From == t }) ||
+			slices.ContainsFunc(d.RenamedTriggers, func(r Rename) bool { return literal.EqualIdentifiers(r.From, t.Name) }) ||
+			containsName(deps, t.Table) ||
+			slices.ContainsFunc(t.References(), func(ref string) bool { return containsName(deps, ref) })
+		if drop {
+			g.add("DROP TRIGGER " + qualified(t.Schema, t.Name))
+			g.droppedTriggers = append(g.droppedTriggers, t.Name)
+		}
+	}
+	for _, v := range slices.Backward(d.from.Views) {
+		drop := slices.ContainsFunc(d.DroppedViews, func(e *catalog.View) bool { return e == v }) ||
+			slices.ContainsFunc(d.ChangedViews, func(c Change[*catalog.View]) bool { return c.From == v }) ||
+			slices.ContainsFunc(d.RenamedViews, func(r Rename) bool { return literal.EqualIdentifiers(r.From, v.Name) }) ||
+			containsName(dependents, v.Name)
+		if drop {
+			g.add("DROP VIEW " + qualified(v.Schema, v.Name))
+			g.droppedViews = append(g.droppedViews, v.Name)
+		}
+	}
+	for _, i := range d.from.Indexes {
+		drop := slices.ContainsFunc(d.DroppedIndexes, func(e *catalog.Index) bool { return e == i }) ||
+			slices.ContainsFunc(d.ChangedIndexes, func(c Change[*catalog.Index]) bool { return c.From == i }) ||
+			slices.ContainsFunc(d.RenamedIndexes, func(r Rename) bool { return literal.EqualIdentifiers(r.From, i.Name) })
+		if drop {
+			g.add("DROP INDEX " + qualified(i.Schema, i.Name))
+			g.droppedIndexes = append(g.droppedIndexes, i.Name)
+		}
+	}
+}
+
+// alter generates the statements that change a table.
+func (g *generator) alter(td *TableDiff) {
+	name := qualified(td.To.Schema, td.To.Name)
+	if td.recreate() {
+		g.add("DROP TABLE " + name)
+		g.add(td.To.SQL())
+		return
+	}
+	if td.Rebuild() {
+		g.rebuild(td)
+		return
+	}
+	for _, r := range td.RenamedColumns {
+		g.add("ALTER TABLE " + name + " RENAME COLUMN " + quote(r.From) + " TO " + quote(r.To))
+	}
+	for _, c := range td.DroppedColumns {
+		g.add("ALTER TABLE " + name + " DROP COLUMN " + quote(c.Name))
+	}
+	for _, c := range td.AddedColumns {
+		g.add("ALTER TABLE " + name + " ADD COLUMN " + c.SQL())
+	}
+}
+
+// rebuild generates the statements that rebuild a table: the table is created with a new name, the rows are copied,
+// the old table is dropped and the new one is renamed. The renamed columns are copied from your old names.
+func (g *generator) rebuild(td *TableDiff) {
+	temp := *td.To
+	temp.Name = g.tempName(td.To.Name)
+	g.add(temp.SQL())
+
+	var columns, values []string
+	for _, c := range td.To.Columns {
+		if td.From.Column(c.Name) == nil || c.Constraint(catalog.ConstraintGenerated) != nil {
+			continue
+		}
+		columns = append(columns, quote(c.Name))
+		value := quote(c.Name)
+		if i := slices.IndexFunc(td.RenamedColumns, func(r Rename) bool { return literal.EqualIdentifiers(r.To, c.Name) }); i >= 0 {
+			value = quote(td.RenamedColumns[i].From) + " AS " + quote(c.Name)
+		}
+		values = append(values, value)
+	}
+	if len(columns) > 0 {
+		g.add("INSERT INTO " + qualified(temp.Schema, temp.Name) + "(" + strings.Join(columns, ", ") + ") SELECT " +
+			strings.Join(values, ", ") + " FROM " + qualified(td.To.Schema, td.To.Name))
+	}
+	g.add("DROP TABLE " + qualified(td.To.Schema, td.To.Name))
+	g.add("ALTER TABLE " + qualified(temp.Schema, temp.Name) + " RENAME TO " + quote(td.To.Name))
+}
+
+// tempName returns a name, based on name, for the new table of a rebuild, that is not used by the catalogs.
+func (g *generator) tempName(name string) string {
+	used := func(n string) bool {
+		for _, c := range []*catalog.Catalog{g.d.from, g.d.to} {
+			if c.Table(n) != nil || c.Index(n) != nil || c.View(n) != nil {
+				return true
+			}
+		}
+		return false
+	}
+	n := "new_" + name
+	for used(n) {
+		n = "new_" + n
+	}
+	return n
+}
+
+// creates generates the CREATE INDEX, CREATE VIEW and CREATE TRIGGER statements.
+func (g *generator) creates() {
+	d := g.d
+	for _, i := range d.to.Indexes {
+		create := slices.ContainsFunc(d.AddedIndexes, func(e *catalog.Index) bool { return e == i }) ||
+			containsName(g.droppedIndexes, i.Name) || containsName(g.rebuilt, i.Table) ||
+			slices.ContainsFunc(d.RenamedIndexes, func(r Rename) bool { return literal.EqualIdentifiers(r.To, i.Name) })
+		if create {
+			g.add(i.SQL())
+		}
+	}
+	for _, v := range d.to.Views {
+		create := slices.ContainsFunc(d.AddedViews, func(e *catalog.View) bool { return e == v }) ||
+			containsName(g.droppedViews, v.Name) ||
+			slices.ContainsFunc(d.RenamedViews, func(r Rename) bool { return literal.EqualIdentifiers(r.To, v.Name) })
+		if create {
+			g.add(v.SQL())
+		}
+	}
+	for _, t := range d.to.Triggers {
+		create := slices.ContainsFunc(d.AddedTriggers, func(e *catalog.Trigger) bool { return e == t }) ||
+			containsName(g.droppedTriggers, t.Name) || containsName(g.rebuilt, t.Table) ||
+			slices.ContainsFunc(d.RenamedTriggers, func(r Rename) bool { return literal.EqualIdentifiers(r.To, t.Name) })
+		if create {
+			g.add(t.SQL())
+		}
+	}
+}
+
+// qualifiedFrom returns the qualified name from of the table that was renamed to to.
+func (g *generator) qualifiedFrom(to, from string) string {
+	if t := g.d.to.Table(to); t != nil {
+		return qualified(t.Schema, from)
+	}
+	return quote(from)
+}
+
+// add adds a statement.
+func (g *generator) add(stmt string) {
+	g.stmts = append(g.stmts, stmt)
+}
+
+// qualified returns the name qualified by the schema, if any, and quoted if needed.
+func qualified(schema, name string) string {
+	if schema == "" {
+		return quote(name)
+	}
+	return quote(schema) + "." + quote(name)
+}
+
+// quote returns s as an identifier, quoted if needed.
+func quote(s string) string {
+	return literal.FormatIdentifier(s, literal.QuoteDouble)
+}
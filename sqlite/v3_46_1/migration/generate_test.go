@@ -0,0 +1,234 @@
+package migration
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// TestStatements tests the statements generated for the differences.
+func TestStatements(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to string
+		want     []string
+	}{
+		{
+			name: "alter",
+			from: "CREATE TABLE t(a, b, c);",
+			to:   "CREATE TABLE t(a, bb, d INT DEFAULT 0);",
+			want: []string{
+				"ALTER TABLE t RENAME COLUMN b TO bb",
+				"ALTER TABLE t DROP COLUMN c",
+				"ALTER TABLE t ADD COLUMN d INT DEFAULT 0",
+			},
+		},
+		{
+			name: "tables",
+			from: "CREATE TABLE a(x); CREATE TABLE b(y);",
+			to:   "CREATE TABLE c(x); CREATE TABLE d(z);",
+			want: []string{
+				"ALTER TABLE a RENAME TO c",
+				"DROP TABLE b",
+				"CREATE TABLE d(\n  z\n)",
+			},
+		},
+		{
+			name: "rebuild",
+			from: `CREATE TABLE t(a INTEGER PRIMARY KEY, b TEXT);
+				CREATE TABLE u(x);
+				CREATE INDEX i ON t(b);
+				CREATE VIEW v AS SELECT b FROM t;
+				CREATE VIEW w AS SELECT * FROM v;
+				CREATE TRIGGER tr AFTER INSERT ON u BEGIN DELETE FROM t; END;`,
+			to: `CREATE TABLE t(a INTEGER PRIMARY KEY, b TEXT NOT NULL, c AS (a + 1));
+				CREATE TABLE u(x);
+				CREATE INDEX i ON t(b);
+				CREATE VIEW v AS SELECT b FROM t;
+				CREATE VIEW w AS SELECT * FROM v;
+				CREATE TRIGGER tr AFTER INSERT ON u BEGIN DELETE FROM t; END;`,
+			want: []string{
+				"PRAGMA foreign_keys = OFF",
+				"DROP TRIGGER tr",
+				"DROP VIEW w",
+				"DROP VIEW v",
+				"CREATE TABLE new_t(\n  a INTEGER PRIMARY KEY,\n  b TEXT NOT NULL,\n  c AS (a + 1)\n)",
+				"INSERT INTO new_t(a, b) SELECT a, b FROM t",
+				"DROP TABLE t",
+				"ALTER TABLE new_t RENAME TO t",
+				"CREATE INDEX i ON t(b)",
+				"CREATE VIEW v AS SELECT b FROM t",
+				"CREATE VIEW w AS SELECT * FROM v",
+				"CREATE TRIGGER tr AFTER INSERT ON u BEGIN\n  DELETE FROM t;\nEND",
+				"PRAGMA foreign_key_check",
+				"PRAGMA foreign_keys = ON",
+			},
+		},
+		{
+			name: "rebuild renamed",
+			from: "CREATE TABLE t(a INTEGER, b TEXT);",
+			to:   "CREATE TABLE t(a INTEGER, bb TEXT NOT NULL);",
+			want: []string{
+				"PRAGMA foreign_keys = OFF",
+				"CREATE TABLE new_t(\n  a INTEGER,\n  bb TEXT NOT NULL\n)",
+				"INSERT INTO new_t(a, bb) SELECT a, b AS bb FROM t",
+				"DROP TABLE t",
+				"ALTER TABLE new_t RENAME TO t",
+				"PRAGMA foreign_key_check",
+				"PRAGMA foreign_keys = ON",
+			},
+		},
+		{
+			name: "objects",
+			from: `CREATE TABLE t(a, b);
+				CREATE INDEX i ON t(a);
+				CREATE INDEX j ON t(b);
+				CREATE VIEW v AS SELECT a FROM t;`,
+			to: `CREATE TABLE t(a, b);
+				CREATE INDEX i ON t(a, b);
+				CREATE INDEX k ON t(b);
+				CREATE VIEW w AS SELECT a FROM t;`,
+			want: []string{
+				"DROP VIEW v",
+				"DROP INDEX i",
+				"DROP INDEX j",
+				"CREATE INDEX i ON t(a, b)",
+				"CREATE INDEX k ON t(b)",
+				"CREATE VIEW w AS SELECT a FROM t",
+			},
+		},
+		{
+			name: "virtual",
+			from: "CREATE VIRTUAL TABLE t USING fts5(a);",
+			to:   "CREATE VIRTUAL TABLE t USING fts5(a, b);",
+			want: []string{"DROP TABLE t", "CREATE VIRTUAL TABLE t USING fts5(a, b)"},
+		},
+	}
+	for _, c := range cases {
+		got, err := Compare(load(t, c.from), load(t, c.to)).Statements()
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		} else if !slices.Equal(got, c.want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", c.name, strings.Join(got, ";\n"), strings.Join(c.want, ";\n"))
+		}
+	}
+}
+
+// TestStatementsApply tests that applying the statements to the catalog compared as from makes it equal to the one
+// compared as to.
+func TestStatementsApply(t *testing.T) {
+	from := `CREATE TABLE a(id INTEGER PRIMARY KEY, name TEXT, x INT);
+		CREATE TABLE b(id INTEGER PRIMARY KEY, a_id INT REFERENCES a(id));
+		CREATE INDEX b_a ON b(a_id);
+		CREATE VIEW v AS SELECT name FROM a;
+		CREATE TRIGGER t AFTER INSERT ON a BEGIN INSERT INTO b(a_id) VALUES (new.id); END;
+		CREATE TABLE old(x);`
+	to := `CREATE TABLE a(id INTEGER PRIMARY KEY, title TEXT, x INT NOT NULL, y INT DEFAULT 0);
+		CREATE TABLE c(id INTEGER PRIMARY KEY, a_id INT REFERENCES a(id));
+		CREATE INDEX c_a ON c(a_id);
+		CREATE VIEW v AS SELECT title FROM a;
+		CREATE TRIGGER t AFTER INSERT ON a BEGIN INSERT INTO c(a_id) VALUES (new.id); END;
+		CREATE TABLE other(y);`
+	c := load(t, from)
+	script, err := Compare(c, load(t, to)).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Exec([]byte(script)); err != nil {
+		t.Fatalf("%v in\n%s", err, script)
+	}
+	if d := Compare(c, load(t, to)); !d.Empty() {
+		t.Errorf("the catalogs differ after the migration\n%s", script)
+	}
+}
+
+// TestStatementsNotNull tests that no statements are generated when a table must be rebuilt with a new NOT NULL column
+// without a default value.
+func TestStatementsNotNull(t *testing.T) {
+	cases := []struct {
+		to      string
+		invalid bool
+	}{
+		{to: "CREATE TABLE t(a INTEGER, b TEXT, c INT NOT NULL);", invalid: true},
+		{to: "CREATE TABLE t(a INTEGER, b TEXT, c INT NOT NULL DEFAULT NULL);", invalid: true},
+		{to: "CREATE TABLE t(a INTEGER, c INT NOT NULL, b TEXT);", invalid: true},
+		{to: "CREATE TABLE t(a INTEGER, c INT NOT NULL DEFAULT 0, b TEXT);"},
+		{to: "CREATE TABLE t(a INTEGER, b TEXT NOT NULL, c INT NOT NULL AS (a + 1));"},
+	}
+	for _, c := range cases {
+		d := Compare(load(t, "CREATE TABLE t(a INTEGER, b TEXT);"), load(t, c.to))
+		stmts, err := d.Statements()
+		if c.invalid {
+			if !errors.Is(err, ErrNotNullColumn) || stmts != nil {
+				t.Errorf("%s: got %q, %v, want %v", c.to, stmts, err, ErrNotNullColumn)
+			}
+			if _, err := d.Script(); !errors.Is(err, ErrNotNullColumn) {
+				t.Errorf("%s: got %v from Script, want %v", c.to, err, ErrNotNullColumn)
+			}
+		} else if err != nil {
+			t.Errorf("%s: %v", c.to, err)
+		}
+	}
+}
+
+// TestAddable tests which columns can be added with ALTER TABLE.
+func TestAddable(t *testing.T) {
+	cases := []struct {
+		column string
+		want   bool
+	}{
+		{column: "c", want: true},
+		{column: "c INT NOT NULL DEFAULT 0", want: true},
+		{column: "c INT NOT NULL", want: false},
+		{column: "c INT NOT NULL DEFAULT NULL", want: false},
+		{column: "c UNIQUE", want: false},
+		{column: "c PRIMARY KEY", want: false},
+		{column: "c DEFAULT CURRENT_TIMESTAMP", want: false},
+		{column: "c DEFAULT (1 + 1)", want: false},
+		{column: "c REFERENCES t(a)", want: true},
+		{column: "c REFERENCES t(a) DEFAULT 1", want: false},
+		{column: "c AS (a + 1)", want: true},
+		{column: "c AS (a + 1) STORED", want: false},
+	}
+	for _, c := range cases {
+		col := load(t, "CREATE TABLE t(a, "+c.column+");").Table("t").Column("c")
+		if got := addable(col); got != c.want {
+			t.Errorf("addable(%q) = %t, want %t", c.column, got, c.want)
+		}
+	}
+}
+
+// TestDroppable tests which columns can be dropped with ALTER TABLE.
+func TestDroppable(t *testing.T) {
+	cases := []struct {
+		table string
+		want  bool
+	}{
+		{table: "CREATE TABLE t(a, c)", want: true},
+		{table: "CREATE TABLE t(a, c UNIQUE)", want: false},
+		{table: "CREATE TABLE t(a, c, PRIMARY KEY (a, c))", want: false},
+		{table: "CREATE TABLE t(a, c REFERENCES u)", want: false},
+		{table: "CREATE TABLE t(a, c, FOREIGN KEY (c) REFERENCES u)", want: false},
+		{table: "CREATE TABLE t(a, c, CHECK (c > 0))", want: false},
+		{table: "CREATE TABLE t(a CHECK (a > c), c)", want: false},
+		{table: "CREATE TABLE t(a AS (c * 2), c)", want: false},
+		{table: "CREATE TABLE t(a CHECK (a > 0), c CHECK (c > 0))", want: true},
+	}
+	for _, c := range cases {
+		tbl := load(t, c.table).Table("t")
+		if got := droppable(tbl, tbl.Column("c")); got != c.want {
+			t.Errorf("droppable(%q) = %t, want %t", c.table, got, c.want)
+		}
+	}
+}
+
+// TestTempName tests that the name of the new table of a rebuild is not used.
+func TestTempName(t *testing.T) {
+	g := &generator{d: &Diff{from: load(t, "CREATE TABLE t(a); CREATE TABLE new_t(b);"), to: catalog.New()}}
+	if got := g.tempName("t"); got != "new_new_t" {
+		t.Errorf("got %q, want %q", got, "new_new_t")
+	}
+}
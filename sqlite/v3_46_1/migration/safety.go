@@ -0,0 +1,287 @@
+package migration
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/statement"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Rule is a kind of destructive or risky operation.
+type Rule int
+
+const (
+	// RuleDropTable is a DROP TABLE.
+	RuleDropTable Rule = iota
+	// RuleDropColumn is an ALTER TABLE ... DROP COLUMN.
+	RuleDropColumn
+	// RuleNotNullWithoutDefault is an ALTER TABLE ... ADD COLUMN of a NOT NULL column without a default.
+	RuleNotNullWithoutDefault
+	// RuleNonConstantDefault is an ALTER TABLE ... ADD COLUMN of a column with a default that is not constant.
+	RuleNonConstantDefault
+	// RuleUniqueColumn is an ALTER TABLE ... ADD COLUMN of a PRIMARY KEY or UNIQUE column.
+	RuleUniqueColumn
+	// RuleRenameUsedColumn is an ALTER TABLE ... RENAME COLUMN of a column used by views or triggers.
+	RuleRenameUsedColumn
+	// RuleVacuumInTransaction is a VACUUM inside a transaction.
+	RuleVacuumInTransaction
+)
+
+// String returns a string representation of r.
+func (r Rule) String() string {
+	if r < 0 || int(r) >= len(ruleStrings) {
+		return strconv.Itoa(int(r))
+	}
+	return ruleStrings[r]
+}
+
+// ruleStrings contains the string representation of the rules. Note that the value of a rule is the index of your
+// string representation.
+var ruleStrings = []string{
+	"DropTable", "DropColumn", "NotNullWithoutDefault", "NonConstantDefault", "UniqueColumn", "RenameUsedColumn",
+	"VacuumInTransaction",
+}
+
+// Finding is a destructive or risky operation found in a migration script.
+type Finding struct {
+	Rule Rule
+	// Statement is the number of the statement, starting at 1.
+	Statement int
+	// Offset is the offset in the script of the first token of the statement.
+	Offset int
+	// Message tells what the statement does and why it is risky.
+	Message string
+	// Suggestion is a safer alternative.
+	Suggestion string
+}
+
+// String returns the finding as "statement N: message; suggestion".
+func (f Finding) String() string {
+	return fmt.Sprintf("statement %d: %s; %s", f.Statement, f.Message, f.Suggestion)
+}
+
+// CheckOptions are the options of Check.
+type CheckOptions struct {
+	// Schema is the schema of the database before the migration. If it is nil, the schema is empty. It is not changed.
+	Schema *catalog.Catalog
+	// Transaction reports whether the migration is executed inside a transaction, as the migration tools usually do.
+	Transaction bool
+}
+
+// Check checks the migration script code, returning the destructive or risky operations, in the order of the
+// statements. The statements are applied to a copy of the schema as they are checked, so a statement is checked
+// against the objects created by the previous ones. An error is returned if a statement has a syntax error.
+func Check(code []byte, opts CheckOptions) ([]Finding, error) {
+	c := &checker{schema: catalog.New(), inTransaction: opts.Transaction}
+	if opts.Schema != nil {
+		c.schema = opts.Schema.Clone()
+	}
+	stmts, rest := statement.Split(code)
+	if syntax.Significant(rest) {
+		stmts = append(stmts, rest)
+	}
+	offset := 0
+	for i, stmt := range stmts {
+		c.number, c.offset = i+1, offset+firstTokenOffset(stmt)
+		offset += len(stmt)
+		if !syntax.Significant(stmt) {
+			continue
+		}
+		tree, err := syntax.Parse(parser.New(lexer.New(stmt)))
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i+1, err)
+		}
+		c.check(tree)
+		// the errors, like of a table that doesn't exist, don't stop the check.
+		c.schema.Apply(tree)
+	}
+	return c.findings, nil
+}
+
+// checker checks the statements of a migration script.
+type checker struct {
+	schema   *catalog.Catalog
+	findings []Finding
+	// number and offset are the number and the offset of the statement being checked.
+	number, offset int
+	// inTransaction reports whether the statement is inside a transaction. savepoints are the names of the
+	// savepoints, if the transaction was started by SAVEPOINT.
+	inTransaction bool
+	savepoints    []string
+}
+
+// check checks the statement in tree.
+func (c *checker) check(tree parsetree.NonTerminal) {
+	stmt := syntax.FirstNonTerminal(tree)
+	if stmt == nil {
+		return
+	}
+	switch stmt.Kind() {
+	case parsetree.KindDropTable:
+		table := syntax.ChildName(stmt, parsetree.KindTableName)
+		c.add(RuleDropTable,
+			fmt.Sprintf("DROP TABLE deletes the table %s and all of its rows, and can't be undone", table),
+			"rename the table with ALTER TABLE ... RENAME TO and drop it in a later migration, after a backup, once "+
+				"nothing uses it")
+	case parsetree.KindAlterTable:
+		c.alterTable(stmt)
+	case parsetree.KindBegin:
+		c.inTransaction = true
+	case parsetree.KindCommit:
+		c.inTransaction, c.savepoints = false, nil
+	case parsetree.KindRollback:
+		if syntax.ChildName(stmt, parsetree.KindSavepointName) == "" {
+			c.inTransaction, c.savepoints = false, nil
+		}
+	case parsetree.KindSavepoint:
+		if !c.inTransaction || len(c.savepoints) > 0 {
+			c.inTransaction = true
+			c.savepoints = append(c.savepoints, syntax.ChildName(stmt, parsetree.KindSavepointName))
+		}
+	case parsetree.KindRelease:
+		n := syntax.ChildName(stmt, parsetree.KindSavepointName)
+		if i := slices.IndexFunc(c.savepoints, func(s string) bool { return literal.EqualIdentifiers(s, n) }); i >= 0 {
+			c.savepoints = c.savepoints[:i]
+			c.inTransaction = i > 0
+		}
+	case parsetree.KindVacuum:
+		if c.inTransaction {
+			c.add(RuleVacuumInTransaction,
+				"VACUUM fails inside a transaction, so the migration fails",
+				"run VACUUM after the migration is committed, outside of the transaction")
+		}
+	}
+}
+
+// alterTable checks the ALTER TABLE statement in tree.
+func (c *checker) alterTable(tree parsetree.NonTerminal) {
+	table := syntax.ChildName(tree, parsetree.KindTableName)
+	action := syntax.FirstNonTerminal(tree)
+	if action == nil {
+		return
+	}
+	switch action.Kind() {
+	case parsetree.KindDropColumn:
+		c.add(RuleDropColumn,
+			fmt.Sprintf("DROP COLUMN deletes the column %s of the table %s and its values, and can't be undone",
+				syntax.ChildName(action, parsetree.KindColumnName), table),
+			"stop using the column first and drop it in a later migration, after a backup")
+	case parsetree.KindAddColumn:
+		if def := syntax.FirstNonTerminal(action); def != nil && def.Kind() == parsetree.KindColumnDefinition {
+			c.addColumn(table, catalog.Text(def))
+		}
+	case parsetree.KindRenameColumn:
+		var ns []string
+		action.Children(func(child parsetree.Construction) bool {
+			if child.Kind() == parsetree.KindColumnName {
+				ns = append(ns, syntax.Name(child.(parsetree.Terminal)))
+			}
+			return true
+		})
+		if len(ns) == 2 {
+			c.renameColumn(table, ns[0], ns[1])
+		}
+	}
+}
+
+// addColumn checks the column with the definition def, added to table.
+func (c *checker) addColumn(table, def string) {
+	cat, err := catalog.Load([]byte("CREATE TABLE t(" + def + ")"))
+	if err != nil || len(cat.Tables[0].Columns) == 0 {
+		return
+	}
+	col := cat.Tables[0].Columns[0]
+	dflt := ""
+	if d := col.Constraint(catalog.ConstraintDefault); d != nil {
+		dflt = strings.ToUpper(d.Expression)
+	}
+
+	if col.Constraint(catalog.ConstraintNotNull) != nil && (dflt == "" || dflt == "NULL") &&
+		col.Constraint(catalog.ConstraintGenerated) == nil {
+		c.add(RuleNotNullWithoutDefault,
+			fmt.Sprintf("SQLite rejects the column %s of the table %s, because it is NOT NULL and has no default, so "+
+				"the existing rows would have NULL in it", col.Name, table),
+			"give the column a default, like NOT NULL DEFAULT 0, or add it without NOT NULL, fill it with an UPDATE "+
+				"and rebuild the table with NOT NULL")
+	}
+	if !constant(dflt) {
+		c.add(RuleNonConstantDefault,
+			fmt.Sprintf("SQLite rejects the column %s of the table %s, because ADD COLUMN requires a constant default "+
+				"and %s is not constant", col.Name, table, col.Constraint(catalog.ConstraintDefault).Expression),
+			"add the column with a constant default, or without a default, and fill it with an UPDATE")
+	}
+	for _, k := range []catalog.ConstraintKind{catalog.ConstraintPrimaryKey, catalog.ConstraintUnique} {
+		if col.Constraint(k) == nil {
+			continue
+		}
+		keyword := "PRIMARY KEY"
+		if k == catalog.ConstraintUnique {
+			keyword = "UNIQUE"
+		}
+		c.add(RuleUniqueColumn,
+			fmt.Sprintf("SQLite rejects the column %s of the table %s, because ADD COLUMN can't add a %s column",
+				col.Name, table, keyword),
+			"add the column without the constraint and create a UNIQUE INDEX on it, or rebuild the table with the "+
+				"constraint")
+	}
+}
+
+// renameColumn checks the rename of the column from of table to to.
+func (c *checker) renameColumn(table, from, to string) {
+	var users []string
+	for _, v := range c.schema.Views {
+		if containsName(v.References(), table) && mentions(v.Select, from) {
+			users = append(users, "view "+v.Name)
+		}
+	}
+	for _, t := range c.schema.Triggers {
+		uses := false
+		if literal.EqualIdentifiers(t.Table, table) {
+			uses = containsName(t.Columns, from) || mentions(t.When, from)
+		}
+		if containsName(t.References(), table) || literal.EqualIdentifiers(t.Table, table) {
+			uses = uses || slices.ContainsFunc(t.Body, func(stmt string) bool { return mentions(stmt, from) })
+		}
+		if uses {
+			users = append(users, "trigger "+t.Name)
+		}
+	}
+	if len(users) == 0 {
+		return
+	}
+	c.add(RuleRenameUsedColumn,
+		fmt.Sprintf("the column %s of the table %s is used by the %s; SQLite 3.25.0 and later rename it in them, but "+
+			"older versions, or legacy_alter_table=ON, leave them using %s, and the queries of the applications that "+
+			"use %s break", from, table, strings.Join(users, ", "), from, from),
+		fmt.Sprintf("recreate the views and triggers using %s in the same migration, and change the applications to "+
+			"use the new name before the migration, or add %s as a new column and copy the values", to, to))
+}
+
+// add adds a finding for the statement being checked.
+func (c *checker) add(rule Rule, message, suggestion string) {
+	c.findings = append(c.findings, Finding{
+		Rule: rule, Statement: c.number, Offset: c.offset, Message: message, Suggestion: suggestion,
+	})
+}
+
+// firstTokenOffset returns the offset of the first token of code that is not a white space or a comment.
+func firstTokenOffset(code []byte) int {
+	l := lexer.New(code)
+	for {
+		offset := int(l.Offset())
+		switch l.Next().Kind {
+		case token.KindWhiteSpace, token.KindByteOrderMark, token.KindSQLComment, token.KindCComment:
+		default:
+			return offset
+		}
+	}
+}
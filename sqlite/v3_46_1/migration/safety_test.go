@@ -0,0 +1,114 @@
+package migration
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestCheck tests the rules of Check.
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		name   string
+		schema string
+		code   string
+		opts   CheckOptions
+		want   []Rule
+	}{
+		{name: "safe", code: "CREATE TABLE t(a); ALTER TABLE t ADD COLUMN b INT NOT NULL DEFAULT 0; VACUUM;"},
+		{name: "drop table", code: "CREATE TABLE t(a); DROP TABLE t;", want: []Rule{RuleDropTable}},
+		{name: "drop column", code: "ALTER TABLE t DROP COLUMN a", want: []Rule{RuleDropColumn}},
+		{name: "not null", code: "ALTER TABLE t ADD COLUMN b INT NOT NULL;", want: []Rule{RuleNotNullWithoutDefault}},
+		{
+			name: "not null default null",
+			code: "ALTER TABLE t ADD COLUMN b INT NOT NULL DEFAULT NULL;",
+			want: []Rule{RuleNotNullWithoutDefault},
+		},
+		{
+			name: "non-constant default",
+			code: "ALTER TABLE t ADD b DEFAULT CURRENT_TIMESTAMP; ALTER TABLE t ADD c DEFAULT (random());",
+			want: []Rule{RuleNonConstantDefault, RuleNonConstantDefault},
+		},
+		{
+			name: "unique",
+			code: "ALTER TABLE t ADD COLUMN b UNIQUE; ALTER TABLE t ADD COLUMN c INTEGER PRIMARY KEY;",
+			want: []Rule{RuleUniqueColumn, RuleUniqueColumn},
+		},
+		{
+			name:   "rename used column",
+			schema: "CREATE TABLE t(a, b); CREATE TABLE u(x);",
+			code: `CREATE VIEW v AS SELECT a FROM t;
+				ALTER TABLE t RENAME COLUMN a TO aa;
+				ALTER TABLE t RENAME COLUMN b TO bb;
+				CREATE TRIGGER tr AFTER INSERT ON u BEGIN UPDATE t SET bb = new.x; END;
+				ALTER TABLE t RENAME COLUMN bb TO b;`,
+			want: []Rule{RuleRenameUsedColumn, RuleRenameUsedColumn},
+		},
+		{
+			name: "vacuum",
+			code: `BEGIN; VACUUM; COMMIT; VACUUM;
+				SAVEPOINT s; SAVEPOINT r; RELEASE r; VACUUM; RELEASE s; VACUUM;
+				BEGIN; ROLLBACK TO s; VACUUM; ROLLBACK; VACUUM`,
+			want: []Rule{RuleVacuumInTransaction, RuleVacuumInTransaction, RuleVacuumInTransaction},
+		},
+		{name: "vacuum in migration", code: "VACUUM;", opts: CheckOptions{Transaction: true}, want: []Rule{RuleVacuumInTransaction}},
+	}
+	for _, c := range cases {
+		if c.schema != "" {
+			c.opts.Schema = load(t, c.schema)
+		}
+		findings, err := Check([]byte(c.code), c.opts)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		var got []Rule
+		for _, f := range findings {
+			got = append(got, f.Rule)
+			if f.Message == "" || f.Suggestion == "" {
+				t.Errorf("%s: finding without message or suggestion: %v", c.name, f)
+			}
+		}
+		if !slices.Equal(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// TestCheckPosition tests the statement number and the offset of the findings.
+func TestCheckPosition(t *testing.T) {
+	code := "SELECT 1;\n-- drop it\nDROP TABLE t;\n  DROP TABLE u"
+	findings, err := Check([]byte(code), CheckOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 2 {
+		t.Fatalf("got %d findings, want 2", len(findings))
+	}
+	for i, want := range []struct{ statement, offset int }{{2, 21}, {3, 37}} {
+		if f := findings[i]; f.Statement != want.statement || f.Offset != want.offset {
+			t.Errorf("got statement %d at %d, want statement %d at %d", f.Statement, f.Offset, want.statement, want.offset)
+		}
+	}
+	if s := findings[0].String(); !strings.HasPrefix(s, "statement 2: DROP TABLE deletes the table t") {
+		t.Errorf("unexpected String %q", s)
+	}
+}
+
+// TestCheckSyntaxError tests that Check returns the syntax errors.
+func TestCheckSyntaxError(t *testing.T) {
+	if _, err := Check([]byte("DROP TABLE t; DROP TABLE;"), CheckOptions{}); err == nil ||
+		!strings.HasPrefix(err.Error(), "statement 2: ") {
+		t.Errorf("want a syntax error in the statement 2, got %v", err)
+	}
+}
+
+// TestRuleString tests Rule.String.
+func TestRuleString(t *testing.T) {
+	if got := RuleVacuumInTransaction.String(); got != "VacuumInTransaction" {
+		t.Errorf("got %q", got)
+	}
+	if got := Rule(100).String(); got != "100" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,71 @@
+package migration
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/migration/file"
+)
+
+// Replay returns the catalog of the schema created by the up sections of the migrations, applied in order. The
+// statements that don't change the schema, like INSERT, are ignored.
+func Replay(ms []*file.Migration) (*catalog.Catalog, error) {
+	c := catalog.New()
+	for _, m := range ms {
+		for _, stmt := range m.Up.Statements {
+			if err := c.Exec(stmt.SQL); err != nil {
+				return nil, fmt.Errorf("migration %s: %w", migrationName(m), err)
+			}
+		}
+	}
+	return c, nil
+}
+
+// migrationName returns the version and the name of m, to identify it in the errors.
+func migrationName(m *file.Migration) string {
+	if m.Name == "" {
+		return m.Version
+	}
+	return m.Version + "_" + m.Name
+}
+
+// Squash replays the migrations in the directory dir of fsys, like Replay, and returns the schema script of the
+// result, like Schema.
+func Squash(fsys fs.FS, dir string) (string, error) {
+	ms, err := file.ReadDir(fsys, dir)
+	if err != nil {
+		return "", err
+	}
+	c, err := Replay(ms)
+	if err != nil {
+		return "", err
+	}
+	return Schema(c), nil
+}
+
+// Schema returns a script that creates the objects of c, in the order of catalog.Graph.Sort: the tables, the indexes,
+// the views and the triggers, each one after the objects that it depends on. The comments of the objects and of the
+// columns precede them.
+func Schema(c *catalog.Catalog) string {
+	// a cycle of views is kept, SQLite only checks the views when they are used.
+	order, _ := c.Graph().Sort()
+	var stmts []string
+	for _, o := range order {
+		switch o.Kind {
+		case catalog.ObjectTable:
+			stmts = append(stmts, c.TableIn(o.SchemaName(), o.Name).CommentedSQL())
+		case catalog.ObjectIndex:
+			stmts = append(stmts, c.IndexIn(o.SchemaName(), o.Name).CommentedSQL())
+		case catalog.ObjectView:
+			stmts = append(stmts, c.ViewIn(o.SchemaName(), o.Name).CommentedSQL())
+		case catalog.ObjectTrigger:
+			stmts = append(stmts, c.TriggerIn(o.SchemaName(), o.Name).CommentedSQL())
+		}
+	}
+	if len(stmts) == 0 {
+		return ""
+	}
+	return strings.Join(stmts, ";\n\n") + ";\n"
+}
@@ -0,0 +1,102 @@
+package migration
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/migration/file"
+)
+
+// TestSquash tests that Squash replays the migrations and orders the objects by dependency.
+func TestSquash(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/1_posts.sql": {Data: []byte(`-- +goose Up
+-- the posts
+CREATE TABLE posts(
+  id INTEGER PRIMARY KEY,
+  author INT REFERENCES users(id), -- the author
+  body TEXT
+);
+CREATE VIEW recent AS SELECT * FROM post_titles LIMIT 10;
+INSERT INTO posts(body) VALUES ('first');
+
+-- +goose Down
+DROP TABLE posts;
+`)},
+		"m/2_users.sql": {Data: []byte(`-- +goose Up
+-- the users
+CREATE TABLE people(id INTEGER PRIMARY KEY);
+ALTER TABLE people RENAME TO users;
+-- the name of the user
+ALTER TABLE users ADD COLUMN name TEXT NOT NULL DEFAULT '';
+CREATE INDEX posts_author ON posts(author);
+-- the titles
+CREATE VIEW post_titles AS SELECT substr(body, 1, 10) AS title FROM posts;
+CREATE TRIGGER users_delete AFTER DELETE ON users BEGIN
+  DELETE FROM posts WHERE author = old.id;
+END;
+`)},
+	}
+	got, err := Squash(fsys, "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `-- the users
+CREATE TABLE users(
+  id INTEGER PRIMARY KEY,
+  -- the name of the user
+  name TEXT NOT NULL DEFAULT ''
+);
+
+-- the posts
+CREATE TABLE posts(
+  id INTEGER PRIMARY KEY,
+  -- the author
+  author INT REFERENCES users(id),
+  body TEXT
+);
+
+CREATE INDEX posts_author ON posts(author);
+
+-- the titles
+CREATE VIEW post_titles AS SELECT substr(body, 1, 10) AS title FROM posts;
+
+CREATE VIEW recent AS SELECT * FROM post_titles LIMIT 10;
+
+CREATE TRIGGER users_delete AFTER DELETE ON users BEGIN
+  DELETE FROM posts WHERE author = old.id;
+END;
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err := Squash(fsys, "none"); err == nil {
+		t.Errorf("want an error")
+	}
+}
+
+// TestReplay tests that Replay identifies the migration that fails.
+func TestReplay(t *testing.T) {
+	var ms []*file.Migration
+	for _, code := range []string{"CREATE TABLE a(x);", "CREATE INDEX i ON b(x);"} {
+		m, err := file.ParseGolangMigrate([]byte(code), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ms = append(ms, m)
+	}
+	ms[1].Version, ms[1].Name = "2", "index"
+	if _, err := Replay(ms); !errors.Is(err, catalog.ErrNoSuchTable) || err.Error() != "migration 2_index: statement 1: catalog: no such table: b" {
+		t.Errorf("got %v", err)
+	}
+	c, err := Replay(ms[:1])
+	if err != nil || c.Table("a") == nil {
+		t.Errorf("got %v and %v", c, err)
+	}
+	if got := Schema(catalog.New()); got != "" {
+		t.Errorf("got %q for an empty catalog", got)
+	}
+}
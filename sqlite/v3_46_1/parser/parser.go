@@ -1,17 +1,16 @@
 // This package deals with the parsing of the SQL.
 package parser
 
-// TODO: implement the quote rule exceptions: lang_keywords.html.
-
 import (
 	"errors"
 	"fmt"
 	"slices"
 	"strings"
 
-	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/literal"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
 )
 
 type syntaxError struct {
@@ -26,33 +25,99 @@ func (se *syntaxError) Error() string {
 	return fmt.Sprintf("expecting %s, got %s", se.expected, se.got.Kind)
 }
 
+// ErrorToken returns the token where the syntax error err was found, if err is an error that the parser panics with.
+func ErrorToken(err error) (tok *token.Token, ok bool) {
+	var se *syntaxError
+	if errors.As(err, &se) {
+		return se.got, true
+	}
+	return nil, false
+}
+
 // Parser is a parser for the SQL.
 type Parser struct {
 	// comments contains the comments for the current SQLStatement being parsed.
 	comments map[*token.Token][]*token.Token
 	// tok contains the current look ahead tokens.
 	tok [3]*token.Token
-	// l is the lexer.
-	l         *lexer.Lexer
+	// tp provides the tokens, usually it is a lexer.Lexer.
+	tp        lexical.TokenProvider
 	treeStack []parsetree.NonTerminal
+	// arena allocates the parse trees. If it is nil they are allocated in the heap.
+	arena *parsetree.Arena
+	// ahead and aheadComments keep the look ahead tokens and your comments out of the arena between the statements,
+	// since the arena may be released between them. See park.
+	ahead         [3]token.Token
+	aheadComments [3][]token.Token
+	// parked reports whether the look ahead tokens are in ahead.
+	parked bool
+	// isColumn reports whether a name is of a column, see WithColumns.
+	isColumn func(name string) bool
+}
+
+// Option is an option of a Parser.
+type Option func(*Parser)
+
+// WithArena makes the parser allocate the parse trees, the comments maps and, if the TokenProvider has the method
+// NextInto like lexer.Lexer, the tokens in a. They are valid until a is released. a may be released between the calls
+// of SQLStatement: the tokens already read for the next statement are kept out of a.
+func WithArena(a *parsetree.Arena) Option {
+	return func(p *Parser) {
+		p.arena = a
+	}
+}
+
+// WithColumns makes the parser apply the quote rule exception of SQLite for the identifiers quoted with double quotes:
+// in an expression, such an identifier that is not qualified and for which isColumn reports false is a string literal.
+// It is parsed as a terminal with the fallback parsetree.FallbackString. isColumn receives the unquoted name. Without
+// this option these identifiers are always column names, since the parser doesn't know the columns.
+func WithColumns(isColumn func(name string) bool) Option {
+	return func(p *Parser) {
+		p.isColumn = isColumn
+	}
 }
 
-// New creates a parser.
-func New(l *lexer.Lexer) *Parser {
-	return &Parser{
-		l: l,
+// New creates a parser that parses the tokens provided by tp. tp can be a lexer.Lexer or any other TokenProvider, for
+// example one that transforms the tokens of a lexer. The white spaces, comments and byte order marks are handled by the
+// parser, so tp can provide them or not.
+func New(tp lexical.TokenProvider, opts ...Option) *Parser {
+	p := &Parser{
+		tp: tp,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Reset makes p parse the tokens provided by tp, as if it were created by New with the same options. It reuses the
+// memory of p.
+func (p *Parser) Reset(tp lexical.TokenProvider) {
+	p.tp = tp
+	p.tok = [3]*token.Token{}
+	p.parked = false
+	p.comments = nil
+	clear(p.treeStack)
+	p.treeStack = p.treeStack[:0]
 }
 
-// SQLStatement parses a SQLStatement and returns your parse tree and a map containing the comments found.
+// SQLStatement parses a SQLStatement and returns your parse tree and a map containing the comments found. If the parser
+// has an arena the map is the map of the arena, that contains the comments of all the statements parsed since the
+// arena was released.
 func (p *Parser) SQLStatement() (c parsetree.Construction, comments map[*token.Token][]*token.Token) {
-	comments = make(map[*token.Token][]*token.Token)
+	if p.arena != nil {
+		comments = p.arena.Comments()
+	} else {
+		comments = make(map[*token.Token][]*token.Token)
+	}
 	p.comments = comments
 
 	if p.tok[0] == nil {
 		p.advance()
 		p.advance()
 		p.advance()
+	} else if p.parked {
+		p.unpark()
 	}
 
 	p.pushTree(parsetree.KindSQLStatement)
@@ -121,30 +186,68 @@ func (p *Parser) SQLStatement() (c parsetree.Construction, comments map[*token.T
 		p.term(token.KindEOF)
 	}
 
+	if p.arena != nil {
+		p.park()
+	}
 	p.comments = nil
 	return p.popTree(), comments
 }
 
+// park copies the look ahead tokens, that belong to the next statement, and your comments to p.ahead and
+// p.aheadComments, removing the comments from the map of the arena. So the arena can be released before the next
+// statement, that calls unpark.
+func (p *Parser) park() {
+	for i, tok := range p.tok {
+		p.ahead[i] = *tok
+		p.aheadComments[i] = p.aheadComments[i][:0]
+		for _, c := range p.comments[tok] {
+			p.aheadComments[i] = append(p.aheadComments[i], *c)
+		}
+		delete(p.comments, tok)
+		p.tok[i] = &p.ahead[i]
+	}
+	p.parked = true
+}
+
+// unpark creates the look ahead tokens copied by park, and your comments, in the arena again.
+func (p *Parser) unpark() {
+	for i := range p.tok {
+		tok := p.arena.NewToken()
+		*tok = p.ahead[i]
+		if len(p.aheadComments[i]) > 0 {
+			comments := make([]*token.Token, len(p.aheadComments[i]))
+			for j := range comments {
+				comments[j] = p.arena.NewToken()
+				*comments[j] = p.aheadComments[i][j]
+			}
+			p.comments[tok] = comments
+		}
+		p.tok[i] = tok
+	}
+	p.parked = false
+}
+
 // alterTable parses a alter table statement.
 func (p *Parser) alterTable() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindAlterTable)
 	p.term(token.KindAlter)
 	p.term(token.KindTable)
 
-	if p.isSeq(token.KindIdentifier, token.KindDot) {
-		p.termKind(parsetree.KindSchemaName, token.KindIdentifier)
+	if p.isName(0) && p.isPos(1, token.KindDot) {
+		p.name(parsetree.KindSchemaName)
 		p.term(token.KindDot)
 	}
 
-	p.termKind(parsetree.KindTableName, token.KindIdentifier)
+	p.name(parsetree.KindTableName)
 
 	switch p.token(token.KindRename, token.KindAdd, token.KindDrop) {
 	case token.KindRename:
-		switch p.tokenPos(1, token.KindTo, token.KindColumn, token.KindIdentifier) {
-		case token.KindTo:
+		if p.isPos(1, token.KindTo) {
 			p.addChild(p.alterTableRenameTo())
-		default:
+		} else if p.isName(1) {
 			p.addChild(p.alterTableRenameColumn())
+		} else {
+			p.tokenPos(1, token.KindTo, token.KindColumn, token.KindIdentifier)
 		}
 	case token.KindAdd:
 		p.addChild(p.alterTableAddColumn())
@@ -159,7 +262,7 @@ func (p *Parser) alterTableRenameTo() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindRenameTo)
 	p.term(token.KindRename)
 	p.term(token.KindTo)
-	p.termKind(parsetree.KindTableName, token.KindIdentifier)
+	p.name(parsetree.KindTableName)
 	return p.popTree()
 }
 
@@ -171,9 +274,9 @@ func (p *Parser) alterTableRenameColumn() parsetree.NonTerminal {
 		p.term(token.KindColumn)
 	}
 
-	p.termKind(parsetree.KindColumnName, token.KindIdentifier)
+	p.name(parsetree.KindColumnName)
 	p.term(token.KindTo)
-	p.termKind(parsetree.KindColumnName, token.KindIdentifier)
+	p.name(parsetree.KindColumnName)
 	return p.popTree()
 }
 
@@ -185,7 +288,6 @@ func (p *Parser) alterTableAddColumn() parsetree.NonTerminal {
 		p.term(token.KindColumn)
 	}
 
-	p.token(token.KindIdentifier)
 	p.addChild(p.columnDefinition())
 	return p.popTree()
 }
@@ -198,7 +300,7 @@ func (p *Parser) alterTableDropColumn() parsetree.NonTerminal {
 		p.term(token.KindColumn)
 	}
 
-	p.termKind(parsetree.KindColumnName, token.KindIdentifier)
+	p.name(parsetree.KindColumnName)
 	return p.popTree()
 }
 
@@ -206,7 +308,7 @@ func (p *Parser) alterTableDropColumn() parsetree.NonTerminal {
 func (p *Parser) columnDefinition() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindColumnDefinition)
 
-	p.termKind(parsetree.KindColumnName, token.KindIdentifier)
+	p.name(parsetree.KindColumnName)
 
 	if p.is(token.KindIdentifier) {
 		p.addChild(p.typeName())
@@ -263,7 +365,7 @@ func (p *Parser) columnConstraint() parsetree.NonTerminal {
 
 	if p.is(token.KindConstraint) {
 		p.term(token.KindConstraint)
-		p.termKind(parsetree.KindConstraintName, token.KindIdentifier)
+		p.name(parsetree.KindConstraintName)
 	}
 
 	switch p.token(token.KindPrimary, token.KindNot, token.KindUnique, token.KindCheck, token.KindDefault,
@@ -370,7 +472,7 @@ func (p *Parser) defaultColumnConstraint() parsetree.NonTerminal {
 func (p *Parser) collateColumnConstraint() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindCollateColumnConstraint)
 	p.term(token.KindCollate)
-	p.termKind(parsetree.KindCollationName, token.KindIdentifier)
+	p.name(parsetree.KindCollationName)
 	return p.popTree()
 }
 
@@ -405,7 +507,7 @@ func (p *Parser) foreignKeyColumnConstraint() parsetree.NonTerminal {
 func (p *Parser) foreignKeyClause() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindForeignKeyClause)
 	p.term(token.KindReferences)
-	p.termKind(parsetree.KindTableName, token.KindIdentifier)
+	p.name(parsetree.KindTableName)
 
 	if p.is(token.KindLeftParen) {
 		p.term(token.KindLeftParen)
@@ -480,12 +582,12 @@ func (p *Parser) analyze() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindAnalyze)
 	p.term(token.KindAnalyze)
 
-	if p.isSeq(token.KindIdentifier, token.KindDot) {
-		p.termKind(parsetree.KindSchemaName, token.KindIdentifier)
+	if p.isName(0) && p.isPos(1, token.KindDot) {
+		p.name(parsetree.KindSchemaName)
 		p.term(token.KindDot)
-		p.termKind(parsetree.KindTableOrIndexName, token.KindIdentifier)
+		p.name(parsetree.KindTableOrIndexName)
 	} else {
-		p.termKind(parsetree.KindSchemaIndexOrTableName, token.KindIdentifier)
+		p.name(parsetree.KindSchemaIndexOrTableName)
 	}
 
 	return p.popTree()
@@ -502,7 +604,7 @@ func (p *Parser) attach() parsetree.NonTerminal {
 
 	p.addChild(p.expression())
 	p.term(token.KindAs)
-	p.termKind(parsetree.KindSchemaName, token.KindIdentifier)
+	p.name(parsetree.KindSchemaName)
 
 	return p.popTree()
 }
@@ -556,7 +658,7 @@ func (p *Parser) rollback() parsetree.NonTerminal {
 	if p.is(token.KindSavepoint) {
 		p.term()
 	}
-	p.termKind(parsetree.KindSavepointName, token.KindIdentifier)
+	p.name(parsetree.KindSavepointName)
 
 	return p.popTree()
 }
@@ -606,14 +708,14 @@ func (p *Parser) createIndex() parsetree.NonTerminal {
 		p.term(token.KindExists)
 	}
 
-	if p.isSeq(token.KindIdentifier, token.KindDot) {
-		p.termKind(parsetree.KindSchemaName, token.KindIdentifier)
+	if p.isName(0) && p.isPos(1, token.KindDot) {
+		p.name(parsetree.KindSchemaName)
 		p.term(token.KindDot)
 	}
-	p.termKind(parsetree.KindIndexName, token.KindIdentifier)
+	p.name(parsetree.KindIndexName)
 
 	p.term(token.KindOn)
-	p.termKind(parsetree.KindTableName, token.KindIdentifier)
+	p.name(parsetree.KindTableName)
 
 	p.term(token.KindLeftParen)
 	p.addChild(p.indexedColumnList(true))
@@ -645,11 +747,11 @@ func (p *Parser) indexedColumn(canBeExpression bool) parsetree.NonTerminal {
 		// the collate part is handled by the expression parser
 		p.addChild(p.expression())
 	} else {
-		p.termKind(parsetree.KindColumnName, token.KindIdentifier)
+		p.name(parsetree.KindColumnName)
 
 		if p.is(token.KindCollate) {
 			p.term()
-			p.termKind(parsetree.KindCollationName, token.KindIdentifier)
+			p.name(parsetree.KindCollationName)
 		}
 	}
 
@@ -677,15 +779,12 @@ func (p *Parser) createTable() parsetree.NonTerminal {
 		p.term(token.KindExists)
 	}
 
-	if p.isSeq(token.KindIdentifier, token.KindDot) {
-		p.termKind(parsetree.KindSchemaName, token.KindIdentifier)
-		p.term(token.KindDot)
-	} else if p.isSeq(token.KindTemp, token.KindDot) {
-		p.termKind(parsetree.KindSchemaName, token.KindTemp)
+	if p.isName(0) && p.isPos(1, token.KindDot) {
+		p.name(parsetree.KindSchemaName)
 		p.term(token.KindDot)
 	}
 
-	p.termKind(parsetree.KindTableName, token.KindIdentifier)
+	p.name(parsetree.KindTableName)
 
 	switch p.token(token.KindLeftParen, token.KindAs) {
 	case token.KindLeftParen:
@@ -722,7 +821,7 @@ func (p *Parser) columnDefinitionList() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindCommaList)
 
 	p.addChild(p.columnDefinition())
-	for p.isSeq(token.KindComma, token.KindIdentifier) {
+	for p.is(token.KindComma) && p.isName(1) {
 		p.term(token.KindComma)
 		p.addChild(p.columnDefinition())
 	}
@@ -746,7 +845,7 @@ func (p *Parser) tableConstraint() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindTableConstraint)
 	if p.is(token.KindConstraint) {
 		p.term()
-		p.termKind(parsetree.KindConstraintName, token.KindIdentifier)
+		p.name(parsetree.KindConstraintName)
 	}
 
 	switch p.token(token.KindPrimary, token.KindUnique, token.KindCheck, token.KindForeign) {
@@ -860,7 +959,7 @@ func (p *Parser) tableOption() parsetree.NonTerminal {
 func (p *Parser) columnNameList(follow ...token.Kind) parsetree.NonTerminal {
 	p.pushTree(parsetree.KindCommaList)
 	for {
-		p.termKind(parsetree.KindColumnName, token.KindIdentifier)
+		p.name(parsetree.KindColumnName)
 		if !p.is(token.KindComma) {
 			break
 		}
@@ -885,11 +984,11 @@ func (p *Parser) createTrigger() parsetree.NonTerminal {
 		p.term(token.KindExists)
 	}
 
-	if p.isAnyOf(token.KindIdentifier, token.KindTemp) && p.isPos(1, token.KindDot) {
-		p.termKind(parsetree.KindSchemaName)
+	if p.isName(0) && p.isPos(1, token.KindDot) {
+		p.name(parsetree.KindSchemaName)
 		p.term(token.KindDot)
 	}
-	p.termKind(parsetree.KindTriggerName, token.KindIdentifier)
+	p.name(parsetree.KindTriggerName)
 
 	if p.isAnyOf(token.KindBefore, token.KindAfter) {
 		p.term()
@@ -910,7 +1009,7 @@ func (p *Parser) createTrigger() parsetree.NonTerminal {
 	}
 
 	p.term(token.KindOn)
-	p.termKind(parsetree.KindTableName, token.KindIdentifier)
+	p.name(parsetree.KindTableName)
 
 	if p.is(token.KindFor) {
 		p.term()
@@ -969,69 +1068,69 @@ func (p *Parser) triggerBody() parsetree.NonTerminal {
 
 // createView parses a create view statement.
 func (p *Parser) createView() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCreateView)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindCreateView)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindTemp || p.tok[0].Kind == token.KindTemporary {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindIf {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindNot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindExists {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "NOT"`)))
 		}
 
 		if p.tok[0].Kind == token.KindExists {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "EXISTS"`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindViewName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindViewName, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindAs || p.tok[0].Kind == token.KindLeftParen {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing view name`)))
 	}
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		nt.AddChild(p.columnNameList(token.KindRightParen, token.KindSemicolon, token.KindAs, token.KindEOF))
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -1039,14 +1138,18 @@ func (p *Parser) createView() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindAs {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
-	} else if p.tok[0].Kind == token.KindSelect {
+	} else if p.tok[0].Kind == token.KindWith || p.tok[0].Kind == token.KindSelect {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "AS"`)))
 	}
 
-	if p.tok[0].Kind == token.KindSelect {
-		nt.AddChild(p.selectStatement(nil))
+	if p.tok[0].Kind == token.KindWith || p.tok[0].Kind == token.KindSelect {
+		var withClause parsetree.NonTerminal
+		if p.tok[0].Kind == token.KindWith {
+			withClause = p.withClause()
+		}
+		nt.AddChild(p.selectStatement(withClause))
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing select`)))
 	}
@@ -1056,85 +1159,85 @@ func (p *Parser) createView() parsetree.NonTerminal {
 
 // createVirtualTable parses a create virtual table statement.
 func (p *Parser) createVirtualTable() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCreateVirtualTable)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindCreateVirtualTable)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindTable {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindIf || p.tok[0].Kind == token.KindIdentifier {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "TABLE"`)))
 	}
 
 	if p.tok[0].Kind == token.KindIf {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindNot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindExists {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "NOT"`)))
 		}
 
 		if p.tok[0].Kind == token.KindExists {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "EXISTS"`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindUsing {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table name`)))
 	}
 
 	if p.tok[0].Kind == token.KindUsing {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindIdentifier {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "USING"`)))
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindModuleName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindModuleName, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindLeftParen || p.tok[0].Kind == token.KindSemicolon || p.tok[0].Kind == token.KindEOF {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing module name`)))
 	}
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		nt.AddChild(p.moduleArgumentList())
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -1146,7 +1249,7 @@ func (p *Parser) createVirtualTable() parsetree.NonTerminal {
 
 // moduleArgumentList parses a list of module arguments separated by comma.
 func (p *Parser) moduleArgumentList() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCommaList)
+	nt := p.newNonTerminal(parsetree.KindCommaList)
 
 	if p.tok[0].Kind == token.KindRightParen {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing module argument`)))
@@ -1160,7 +1263,7 @@ func (p *Parser) moduleArgumentList() parsetree.NonTerminal {
 		case token.KindRightParen, token.KindEOF:
 			return nt
 		case token.KindComma:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 	}
@@ -1168,23 +1271,23 @@ func (p *Parser) moduleArgumentList() parsetree.NonTerminal {
 
 // moduleArgument parses a module argument in a create virtual table statement.
 func (p *Parser) moduleArgument() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindModuleArgument)
+	nt := p.newNonTerminal(parsetree.KindModuleArgument)
 	for {
 		switch p.tok[0].Kind {
 		case token.KindComma, token.KindRightParen, token.KindEOF:
 			return nt
 		case token.KindLeftParen:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			p.moduleArgumentInner(nt)
 			if p.tok[0].Kind == token.KindRightParen {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				return nt
 			}
 		default:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 	}
@@ -1197,17 +1300,17 @@ func (p *Parser) moduleArgumentInner(nt parsetree.NonTerminal) {
 		case token.KindRightParen, token.KindEOF:
 			return
 		case token.KindLeftParen:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			p.moduleArgumentInner(nt)
 			if p.tok[0].Kind == token.KindRightParen {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				return
 			}
 		default:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 	}
@@ -1215,22 +1318,22 @@ func (p *Parser) moduleArgumentInner(nt parsetree.NonTerminal) {
 
 // delete parses a delete statement.
 func (p *Parser) delete(withClause parsetree.NonTerminal) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindDelete)
+	nt := p.newNonTerminal(parsetree.KindDelete)
 	if withClause != nil {
 		nt.AddChild(withClause)
 	}
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindFrom {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindIdentifier {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "FROM"`)))
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
+	if p.isName(0) {
 		nt.AddChild(p.qualifiedTableName())
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing identifier`)))
@@ -1249,14 +1352,14 @@ func (p *Parser) delete(withClause parsetree.NonTerminal) parsetree.NonTerminal
 
 // withClause parses a with clause.
 func (p *Parser) withClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindWithClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindWithClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindRecursive {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
-	} else if p.tok[0].Kind != token.KindIdentifier {
+	} else if !p.isName(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "RECURSIVE", or a CTE`)))
 		return nt
 	}
@@ -1268,15 +1371,15 @@ func (p *Parser) withClause() parsetree.NonTerminal {
 
 // commonTableExpressionList parses a list of CTEs in a with clause.
 func (p *Parser) commonTableExpressionList() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCommaList)
+	nt := p.newNonTerminal(parsetree.KindCommaList)
 
 	nt.AddChild(p.commonTableExpression())
 
 	for p.tok[0].Kind == token.KindComma {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
-		if p.tok[0].Kind == token.KindIdentifier {
+		if p.isName(0) {
 			nt.AddChild(p.commonTableExpression())
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing CTE`)))
@@ -1288,18 +1391,18 @@ func (p *Parser) commonTableExpressionList() parsetree.NonTerminal {
 
 // commonTableExpression parses a common table expression.
 func (p *Parser) commonTableExpression() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCommonTableExpression)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindCommonTableExpression)
+	nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		nt.AddChild(p.columnNameList(token.KindRightParen, token.KindAs, token.KindSemicolon, token.KindEOF))
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -1307,7 +1410,7 @@ func (p *Parser) commonTableExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindAs {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindNot || p.tok[0].Kind == token.KindMaterialized ||
 		p.tok[0].Kind == token.KindLeftParen {
@@ -1315,22 +1418,22 @@ func (p *Parser) commonTableExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindNot {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindMaterialized {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "MATERIALIZED"`)))
 		}
 	} else if p.tok[0].Kind == token.KindMaterialized {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindWith || p.tok[0].Kind == token.KindSelect {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "("`)))
@@ -1351,7 +1454,7 @@ func (p *Parser) commonTableExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -1362,38 +1465,38 @@ func (p *Parser) commonTableExpression() parsetree.NonTerminal {
 
 // qualifiedTableName parses a qualified table name.
 func (p *Parser) qualifiedTableName() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindQualifiedTableName)
+	nt := p.newNonTerminal(parsetree.KindQualifiedTableName)
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table name`)))
 	}
 
 	if p.tok[0].Kind == token.KindAs {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
-		if p.tok[0].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+		if p.isName(0) {
+			nt.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table alias`)))
@@ -1401,28 +1504,28 @@ func (p *Parser) qualifiedTableName() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindIndexed {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindBy {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindIdentifier {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "BY"`)))
 		}
 
-		if p.tok[0].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindIndexName, p.tok[0]))
+		if p.isName(0) {
+			nt.AddChild(p.nameTerminal(parsetree.KindIndexName, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing index name`)))
 		}
 	} else if p.tok[0].Kind == token.KindNot {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindIndexed {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "INDEXED"`)))
@@ -1434,8 +1537,8 @@ func (p *Parser) qualifiedTableName() parsetree.NonTerminal {
 
 // returningClause parses a returning clause.
 func (p *Parser) returningClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindReturningClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindReturningClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) || p.tok[0].Kind == token.KindAsterisk {
@@ -1449,12 +1552,12 @@ func (p *Parser) returningClause() parsetree.NonTerminal {
 
 // returningItemList parses a list of itens in a returning clause.
 func (p *Parser) returningItemList() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCommaList)
+	nt := p.newNonTerminal(parsetree.KindCommaList)
 
 	nt.AddChild(p.returningItem())
 
 	for p.tok[0].Kind == token.KindComma {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) || p.tok[0].Kind == token.KindAsterisk {
@@ -1469,27 +1572,27 @@ func (p *Parser) returningItemList() parsetree.NonTerminal {
 
 // returningItem parses a item in a returning clause.
 func (p *Parser) returningItem() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindReturningItem)
+	nt := p.newNonTerminal(parsetree.KindReturningItem)
 
 	if p.isStartOfExpression(0) {
 		nt.AddChild(p.expression())
-		if p.tok[0].Kind != token.KindAs && p.tok[0].Kind != token.KindIdentifier {
+		if p.tok[0].Kind != token.KindAs && !p.isAlias(0) {
 			return nt
 		}
 
 		if p.tok[0].Kind == token.KindAs {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 
-		if p.tok[0].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindColumnAlias, p.tok[0]))
+		if p.isName(0) {
+			nt.AddChild(p.nameTerminal(parsetree.KindColumnAlias, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing column alias`)))
 		}
 	} else if p.tok[0].Kind == token.KindAsterisk {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
@@ -1498,8 +1601,8 @@ func (p *Parser) returningItem() parsetree.NonTerminal {
 
 // whereClause parses a where clause.
 func (p *Parser) whereClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindWhereClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindWhereClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -1526,17 +1629,17 @@ func (p *Parser) drop() parsetree.NonTerminal {
 
 // detach parses a detach statement.
 func (p *Parser) detach() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindDetach)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindDetach)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindDatabase {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
@@ -1547,44 +1650,44 @@ func (p *Parser) detach() parsetree.NonTerminal {
 
 // dropIndex parses a drop index statement.
 func (p *Parser) dropIndex() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindDropIndex)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindDropIndex)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindIf {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindExists {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "EXISTS"`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindIndexName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindIndexName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing index name`)))
@@ -1595,44 +1698,44 @@ func (p *Parser) dropIndex() parsetree.NonTerminal {
 
 // dropTable parses a drop table statement.
 func (p *Parser) dropTable() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindDropTable)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindDropTable)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindIf {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindExists {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "EXISTS"`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table name`)))
@@ -1643,44 +1746,44 @@ func (p *Parser) dropTable() parsetree.NonTerminal {
 
 // dropTrigger parses a drop trigger statement.
 func (p *Parser) dropTrigger() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindDropTrigger)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindDropTrigger)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindIf {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindExists {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "EXISTS"`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTriggerName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTriggerName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing trigger name`)))
@@ -1691,44 +1794,44 @@ func (p *Parser) dropTrigger() parsetree.NonTerminal {
 
 // dropView parses a drop view statement.
 func (p *Parser) dropView() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindDropView)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindDropView)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindIf {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindExists {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "EXISTS"`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindViewName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindViewName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing view name`)))
@@ -1751,7 +1854,7 @@ func (p *Parser) expressionList() parsetree.NonTerminal {
 
 // expression parses a expression.
 func (p *Parser) expression() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindExpression)
+	nt := p.newNonTerminal(parsetree.KindExpression)
 	nt.AddChild(p.expression1())
 	return nt
 }
@@ -1761,10 +1864,10 @@ func (p *Parser) expression1() parsetree.Construction {
 	exp := p.expression2()
 
 	for p.tok[0].Kind == token.KindOr {
-		nt := parsetree.NewNonTerminal(parsetree.KindOr)
+		nt := p.newNonTerminal(parsetree.KindOr)
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
@@ -1785,10 +1888,10 @@ func (p *Parser) expression2() parsetree.Construction {
 	exp := p.expression3()
 
 	for p.tok[0].Kind == token.KindAnd {
-		nt := parsetree.NewNonTerminal(parsetree.KindAnd)
+		nt := p.newNonTerminal(parsetree.KindAnd)
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
@@ -1807,8 +1910,8 @@ func (p *Parser) expression2() parsetree.Construction {
 // expression3 parses a expression with precedence at least 3.
 func (p *Parser) expression3() parsetree.Construction {
 	if p.tok[0].Kind == token.KindNot && p.tok[1].Kind != token.KindExists {
-		nt := parsetree.NewNonTerminal(parsetree.KindNot)
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt := p.newNonTerminal(parsetree.KindNot)
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
@@ -1844,10 +1947,10 @@ func (p *Parser) expression4() parsetree.Construction {
 	for {
 		switch p.tok[0].Kind {
 		case token.KindEqual, token.KindEqualEqual:
-			nt := parsetree.NewNonTerminal(parsetree.KindEqual)
+			nt := p.newNonTerminal(parsetree.KindEqual)
 			nt.AddChild(exp)
 
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.isStartOfExpressionAtLeast4(0) {
@@ -1859,10 +1962,10 @@ func (p *Parser) expression4() parsetree.Construction {
 
 			exp = nt
 		case token.KindLessThanGreaterThan, token.KindExclamationEqual:
-			nt := parsetree.NewNonTerminal(parsetree.KindNotEqual)
+			nt := p.newNonTerminal(parsetree.KindNotEqual)
 			nt.AddChild(exp)
 
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.isStartOfExpressionAtLeast4(0) {
@@ -1880,9 +1983,9 @@ func (p *Parser) expression4() parsetree.Construction {
 		case token.KindIn:
 			exp = p.in(exp)
 		case token.KindGlob:
-			nt := parsetree.NewNonTerminal(parsetree.KindGlob)
+			nt := p.newNonTerminal(parsetree.KindGlob)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.isStartOfExpressionAtLeast4(0) {
@@ -1894,9 +1997,9 @@ func (p *Parser) expression4() parsetree.Construction {
 
 			exp = nt
 		case token.KindRegexp:
-			nt := parsetree.NewNonTerminal(parsetree.KindRegexp)
+			nt := p.newNonTerminal(parsetree.KindRegexp)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.isStartOfExpressionAtLeast4(0) {
@@ -1908,9 +2011,9 @@ func (p *Parser) expression4() parsetree.Construction {
 
 			exp = nt
 		case token.KindMatch:
-			nt := parsetree.NewNonTerminal(parsetree.KindMatch)
+			nt := p.newNonTerminal(parsetree.KindMatch)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.isStartOfExpressionAtLeast4(0) {
@@ -1922,9 +2025,9 @@ func (p *Parser) expression4() parsetree.Construction {
 
 			exp = nt
 		case token.KindLike:
-			nt := parsetree.NewNonTerminal(parsetree.KindLike)
+			nt := p.newNonTerminal(parsetree.KindLike)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.isStartOfExpressionAtLeast4(0) {
@@ -1935,7 +2038,7 @@ func (p *Parser) expression4() parsetree.Construction {
 
 			// apparently there is an error in the ESCAPE precedence documentation
 			if p.tok[0].Kind == token.KindEscape {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 
 				if p.isStartOfExpressionAtLeast4(0) {
@@ -1948,15 +2051,15 @@ func (p *Parser) expression4() parsetree.Construction {
 
 			exp = nt
 		case token.KindIsnull:
-			nt := parsetree.NewNonTerminal(parsetree.KindIsnull)
+			nt := p.newNonTerminal(parsetree.KindIsnull)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			exp = nt
 		case token.KindNotnull:
-			nt := parsetree.NewNonTerminal(parsetree.KindNotnull)
+			nt := p.newNonTerminal(parsetree.KindNotnull)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			exp = nt
 		case token.KindNot:
@@ -1965,11 +2068,11 @@ func (p *Parser) expression4() parsetree.Construction {
 			} else if p.tok[1].Kind == token.KindIn {
 				exp = p.notIn(exp)
 			} else if p.tok[1].Kind == token.KindGlob {
-				nt := parsetree.NewNonTerminal(parsetree.KindNotGlob)
+				nt := p.newNonTerminal(parsetree.KindNotGlob)
 				nt.AddChild(exp)
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 
 				if p.isStartOfExpressionAtLeast4(0) {
@@ -1981,11 +2084,11 @@ func (p *Parser) expression4() parsetree.Construction {
 
 				exp = nt
 			} else if p.tok[1].Kind == token.KindRegexp {
-				nt := parsetree.NewNonTerminal(parsetree.KindNotRegexp)
+				nt := p.newNonTerminal(parsetree.KindNotRegexp)
 				nt.AddChild(exp)
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 
 				if p.isStartOfExpressionAtLeast4(0) {
@@ -1997,11 +2100,11 @@ func (p *Parser) expression4() parsetree.Construction {
 
 				exp = nt
 			} else if p.tok[1].Kind == token.KindMatch {
-				nt := parsetree.NewNonTerminal(parsetree.KindNotMatch)
+				nt := p.newNonTerminal(parsetree.KindNotMatch)
 				nt.AddChild(exp)
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 
 				if p.isStartOfExpressionAtLeast4(0) {
@@ -2013,11 +2116,11 @@ func (p *Parser) expression4() parsetree.Construction {
 
 				exp = nt
 			} else if p.tok[1].Kind == token.KindLike {
-				nt := parsetree.NewNonTerminal(parsetree.KindNotLike)
+				nt := p.newNonTerminal(parsetree.KindNotLike)
 				nt.AddChild(exp)
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 
 				if p.isStartOfExpressionAtLeast4(0) {
@@ -2027,7 +2130,7 @@ func (p *Parser) expression4() parsetree.Construction {
 				}
 
 				if p.tok[0].Kind == token.KindEscape {
-					nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+					nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 					p.advance()
 
 					if p.isStartOfExpressionAtLeast4(0) {
@@ -2040,11 +2143,11 @@ func (p *Parser) expression4() parsetree.Construction {
 
 				exp = nt
 			} else if p.tok[1].Kind == token.KindNull {
-				nt := parsetree.NewNonTerminal(parsetree.KindNotNull)
+				nt := p.newNonTerminal(parsetree.KindNotNull)
 				nt.AddChild(exp)
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 				exp = nt
 			}
@@ -2059,24 +2162,24 @@ func (p *Parser) isExpression(exp parsetree.Construction) parsetree.NonTerminal
 	var nt parsetree.NonTerminal
 	if p.tok[1].Kind == token.KindNot {
 		if p.isStartOfExpression(2) && p.tok[2].Kind != token.KindNot {
-			nt = parsetree.NewNonTerminal(parsetree.KindIsNot)
+			nt = p.newNonTerminal(parsetree.KindIsNot)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			nt.AddChild(p.expression4())
 		} else if p.tok[2].Kind == token.KindDistinct {
-			nt = parsetree.NewNonTerminal(parsetree.KindIsNotDistinctFrom)
+			nt = p.newNonTerminal(parsetree.KindIsNotDistinctFrom)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			if p.tok[0].Kind == token.KindFrom {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else if p.isStartOfExpression(0) {
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "FROM"`)))
@@ -2088,23 +2191,23 @@ func (p *Parser) isExpression(exp parsetree.Construction) parsetree.NonTerminal
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing expression (not starting with "NOT")`)))
 			}
 		} else {
-			nt = parsetree.NewNonTerminal(parsetree.KindIsNot)
+			nt = p.newNonTerminal(parsetree.KindIsNot)
 			nt.AddChild(exp)
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "DISTINCT", or a expression (not starting with "NOT")`)))
 		}
 	} else if p.tok[1].Kind == token.KindDistinct {
-		nt = parsetree.NewNonTerminal(parsetree.KindIsDistinctFrom)
+		nt = p.newNonTerminal(parsetree.KindIsDistinctFrom)
 		nt.AddChild(exp)
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 		if p.tok[0].Kind == token.KindFrom {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.isStartOfExpression(0) {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "FROM"`)))
@@ -2116,16 +2219,16 @@ func (p *Parser) isExpression(exp parsetree.Construction) parsetree.NonTerminal
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing expression (not starting with "NOT")`)))
 		}
 	} else if p.isStartOfExpression(1) {
-		nt = parsetree.NewNonTerminal(parsetree.KindIs)
+		nt = p.newNonTerminal(parsetree.KindIs)
 		nt.AddChild(exp)
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		nt.AddChild(p.expression4())
 	} else {
-		nt = parsetree.NewNonTerminal(parsetree.KindIs)
+		nt = p.newNonTerminal(parsetree.KindIs)
 		nt.AddChild(exp)
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "NOT", "DISTINCT", or a expression`)))
 	}
@@ -2135,9 +2238,9 @@ func (p *Parser) isExpression(exp parsetree.Construction) parsetree.NonTerminal
 
 // between parses a between expression.
 func (p *Parser) between(exp parsetree.Construction) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindBetween)
+	nt := p.newNonTerminal(parsetree.KindBetween)
 	nt.AddChild(exp)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpressionAtLeast4(0) {
@@ -2147,7 +2250,7 @@ func (p *Parser) between(exp parsetree.Construction) parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindAnd {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpressionAtLeast4(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "AND"`)))
@@ -2164,11 +2267,11 @@ func (p *Parser) between(exp parsetree.Construction) parsetree.NonTerminal {
 
 // notBetween parses a not between expression.
 func (p *Parser) notBetween(exp parsetree.Construction) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindNotBetween)
+	nt := p.newNonTerminal(parsetree.KindNotBetween)
 	nt.AddChild(exp)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpressionAtLeast4(0) {
@@ -2178,7 +2281,7 @@ func (p *Parser) notBetween(exp parsetree.Construction) parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindAnd {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpressionAtLeast4(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "AND"`)))
@@ -2195,17 +2298,17 @@ func (p *Parser) notBetween(exp parsetree.Construction) parsetree.NonTerminal {
 
 // in parses a in expression.
 func (p *Parser) in(exp parsetree.Construction) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindIn)
+	nt := p.newNonTerminal(parsetree.KindIn)
 	nt.AddChild(exp)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			return nt
 		}
@@ -2224,7 +2327,7 @@ func (p *Parser) in(exp parsetree.Construction) parsetree.NonTerminal {
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			return nt
 		} else {
@@ -2233,17 +2336,17 @@ func (p *Parser) in(exp parsetree.Construction) parsetree.NonTerminal {
 		return nt
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier && p.tok[1].Kind == token.KindDot {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+	if p.isName(0) && p.tok[1].Kind == token.KindDot {
+		nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier && p.tok[1].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableFunctionName, p.tok[0]))
+	if p.isName(0) && p.tok[1].Kind == token.KindLeftParen {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableFunctionName, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2253,14 +2356,14 @@ func (p *Parser) in(exp parsetree.Construction) parsetree.NonTerminal {
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			return nt
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
 		}
-	} else if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	} else if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "(", schema name, table name, or table function`)))
@@ -2271,19 +2374,19 @@ func (p *Parser) in(exp parsetree.Construction) parsetree.NonTerminal {
 
 // notIn parses a not in expression.
 func (p *Parser) notIn(exp parsetree.Construction) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindNotIn)
+	nt := p.newNonTerminal(parsetree.KindNotIn)
 	nt.AddChild(exp)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			return nt
 		}
@@ -2302,7 +2405,7 @@ func (p *Parser) notIn(exp parsetree.Construction) parsetree.NonTerminal {
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			return nt
 		} else {
@@ -2311,17 +2414,17 @@ func (p *Parser) notIn(exp parsetree.Construction) parsetree.NonTerminal {
 		return nt
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier && p.tok[1].Kind == token.KindDot {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+	if p.isName(0) && p.tok[1].Kind == token.KindDot {
+		nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier && p.tok[1].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableFunctionName, p.tok[0]))
+	if p.isName(0) && p.tok[1].Kind == token.KindLeftParen {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableFunctionName, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2331,14 +2434,14 @@ func (p *Parser) notIn(exp parsetree.Construction) parsetree.NonTerminal {
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 			return nt
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
 		}
-	} else if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	} else if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "(", schema name, table name, or table function`)))
@@ -2360,20 +2463,20 @@ func (p *Parser) expression5() parsetree.Construction {
 		var nt parsetree.NonTerminal
 
 		if p.tok[0].Kind == token.KindLessThan {
-			nt = parsetree.NewNonTerminal(parsetree.KindLessThan)
+			nt = p.newNonTerminal(parsetree.KindLessThan)
 		} else if p.tok[0].Kind == token.KindLessThanOrEqual {
-			nt = parsetree.NewNonTerminal(parsetree.KindLessThanOrEqual)
+			nt = p.newNonTerminal(parsetree.KindLessThanOrEqual)
 		} else if p.tok[0].Kind == token.KindGreaterThan {
-			nt = parsetree.NewNonTerminal(parsetree.KindGreaterThan)
+			nt = p.newNonTerminal(parsetree.KindGreaterThan)
 		} else if p.tok[0].Kind == token.KindGreaterThanOrEqual {
-			nt = parsetree.NewNonTerminal(parsetree.KindGreaterThanOrEqual)
+			nt = p.newNonTerminal(parsetree.KindGreaterThanOrEqual)
 		} else {
 			return exp
 		}
 
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2396,19 +2499,19 @@ func (p *Parser) expression6() parsetree.Construction {
 		var nt parsetree.NonTerminal
 
 		if p.tok[0].Kind == token.KindAmpersand {
-			nt = parsetree.NewNonTerminal(parsetree.KindBitAnd)
+			nt = p.newNonTerminal(parsetree.KindBitAnd)
 		} else if p.tok[0].Kind == token.KindPipe {
-			nt = parsetree.NewNonTerminal(parsetree.KindBitOr)
+			nt = p.newNonTerminal(parsetree.KindBitOr)
 		} else if p.tok[0].Kind == token.KindLessThanLessThan {
-			nt = parsetree.NewNonTerminal(parsetree.KindLeftShift)
+			nt = p.newNonTerminal(parsetree.KindLeftShift)
 		} else if p.tok[0].Kind == token.KindGreaterThanGreaterThan {
-			nt = parsetree.NewNonTerminal(parsetree.KindRightShift)
+			nt = p.newNonTerminal(parsetree.KindRightShift)
 		} else {
 			return exp
 		}
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2429,15 +2532,15 @@ func (p *Parser) expression7() parsetree.Construction {
 		var nt parsetree.NonTerminal
 
 		if p.tok[0].Kind == token.KindPlus {
-			nt = parsetree.NewNonTerminal(parsetree.KindAdd)
+			nt = p.newNonTerminal(parsetree.KindAdd)
 		} else if p.tok[0].Kind == token.KindMinus {
-			nt = parsetree.NewNonTerminal(parsetree.KindSubtract)
+			nt = p.newNonTerminal(parsetree.KindSubtract)
 		} else {
 			return exp
 		}
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2458,17 +2561,17 @@ func (p *Parser) expression8() parsetree.Construction {
 		var nt parsetree.NonTerminal
 
 		if p.tok[0].Kind == token.KindAsterisk {
-			nt = parsetree.NewNonTerminal(parsetree.KindMultiply)
+			nt = p.newNonTerminal(parsetree.KindMultiply)
 		} else if p.tok[0].Kind == token.KindSlash {
-			nt = parsetree.NewNonTerminal(parsetree.KindDivide)
+			nt = p.newNonTerminal(parsetree.KindDivide)
 		} else if p.tok[0].Kind == token.KindPercent {
-			nt = parsetree.NewNonTerminal(parsetree.KindMod)
+			nt = p.newNonTerminal(parsetree.KindMod)
 		} else {
 			return exp
 		}
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2489,17 +2592,17 @@ func (p *Parser) expression9() parsetree.Construction {
 		var nt parsetree.NonTerminal
 
 		if p.tok[0].Kind == token.KindPipePipe {
-			nt = parsetree.NewNonTerminal(parsetree.KindConcatenate)
+			nt = p.newNonTerminal(parsetree.KindConcatenate)
 		} else if p.tok[0].Kind == token.KindMinusGreaterThan {
-			nt = parsetree.NewNonTerminal(parsetree.KindExtract1)
+			nt = p.newNonTerminal(parsetree.KindExtract1)
 		} else if p.tok[0].Kind == token.KindMinusGreaterThanGreaterThan {
-			nt = parsetree.NewNonTerminal(parsetree.KindExtract2)
+			nt = p.newNonTerminal(parsetree.KindExtract2)
 		} else {
 			return exp
 		}
 		nt.AddChild(exp)
 
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpressionAtLeast4(0) {
@@ -2520,13 +2623,13 @@ func (p *Parser) expression10() parsetree.Construction {
 		return exp
 	}
 
-	nt := parsetree.NewNonTerminal(parsetree.KindCollate)
+	nt := p.newNonTerminal(parsetree.KindCollate)
 	nt.AddChild(exp)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindCollationName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindCollationName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing collation name`)))
@@ -2540,16 +2643,16 @@ func (p *Parser) expression11() parsetree.Construction {
 	var nt parsetree.NonTerminal
 
 	if p.tok[0].Kind == token.KindTilde {
-		nt = parsetree.NewNonTerminal(parsetree.KindBitNot)
+		nt = p.newNonTerminal(parsetree.KindBitNot)
 	} else if p.tok[0].Kind == token.KindPlus {
-		nt = parsetree.NewNonTerminal(parsetree.KindPrefixPlus)
+		nt = p.newNonTerminal(parsetree.KindPrefixPlus)
 	} else if p.tok[0].Kind == token.KindMinus {
-		nt = parsetree.NewNonTerminal(parsetree.KindNegate)
+		nt = p.newNonTerminal(parsetree.KindNegate)
 	} else {
 		return p.simpleExpression()
 	}
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpressionAtLeast4(0) {
@@ -2564,24 +2667,28 @@ func (p *Parser) expression11() parsetree.Construction {
 // simpleExpression parses a simple expression, that is, a expression with the highest precedence.
 func (p *Parser) simpleExpression() parsetree.Construction {
 	if p.isLiteralValue(0) {
-		t := parsetree.NewTerminal(parsetree.KindToken, p.tok[0])
+		t := p.newTerminal(parsetree.KindToken, p.tok[0])
 		p.advance()
 		return t
 	} else if p.isBindParameter(0) {
-		t := parsetree.NewTerminal(parsetree.KindBindParameter, p.tok[0])
+		t := p.newTerminal(parsetree.KindBindParameter, p.tok[0])
 		p.advance()
 		return t
-	} else if p.tok[0].Kind == token.KindIdentifier && p.tok[1].Kind == token.KindLeftParen {
+	} else if p.isColumnName(0) && p.tok[1].Kind == token.KindLeftParen {
 		return p.functionCall()
-	} else if p.tok[0].Kind == token.KindIdentifier {
+	} else if p.isStringFallback(0) {
+		t := p.newFallbackTerminal(parsetree.KindToken, p.tok[0], parsetree.FallbackString)
+		p.advance()
+		return t
+	} else if p.isColumnName(0) {
 		return p.columnReference()
 	} else if p.tok[0].Kind == token.KindLeftParen {
 		return p.parenExpression()
 	} else if p.tok[0].Kind == token.KindCast {
 		return p.castExpression()
 	} else if p.tok[0].Kind == token.KindNot {
-		nt := parsetree.NewNonTerminal(parsetree.KindNot)
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt := p.newNonTerminal(parsetree.KindNot)
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		// can only be token.KindExists, see expression3
@@ -2606,7 +2713,11 @@ func (p *Parser) isStartOfExpression(pos int) bool {
 		return true
 	}
 
-	return p.isAnyOfPos(pos, token.KindIdentifier, token.KindTilde, token.KindPlus, token.KindMinus,
+	if p.isColumnName(pos) {
+		return true
+	}
+
+	return p.isAnyOfPos(pos, token.KindTilde, token.KindPlus, token.KindMinus,
 		token.KindNot, token.KindLeftParen,
 		token.KindCast, token.KindExists, token.KindCase, token.KindRaise)
 }
@@ -2635,49 +2746,49 @@ func (p *Parser) isBindParameter(pos int) bool {
 
 // columnReference parses a column reference.
 func (p *Parser) columnReference() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindColumnReference)
+	nt := p.newNonTerminal(parsetree.KindColumnReference)
 
 	var tokens []*token.Token
 	tokens = append(tokens, p.tok[0])
 	p.advance()
 
-	if p.tok[0].Kind == token.KindDot && p.tok[1].Kind == token.KindIdentifier {
+	if p.tok[0].Kind == token.KindDot && p.isName(1) {
 		tokens = append(tokens, p.tok[0], p.tok[1])
 		p.advance()
 		p.advance()
 	} else {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindColumnName, tokens[0]))
+		nt.AddChild(p.nameTerminal(parsetree.KindColumnName, tokens[0]))
 		return nt
 	}
 
-	if p.tok[0].Kind == token.KindDot && p.tok[1].Kind == token.KindIdentifier {
+	if p.tok[0].Kind == token.KindDot && p.isName(1) {
 		tokens = append(tokens, p.tok[0], p.tok[1])
 		p.advance()
 		p.advance()
 	} else {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, tokens[0]))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, tokens[1]))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindColumnName, tokens[2]))
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, tokens[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, tokens[1]))
+		nt.AddChild(p.nameTerminal(parsetree.KindColumnName, tokens[2]))
 		return nt
 	}
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, tokens[0]))
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, tokens[1]))
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, tokens[2]))
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, tokens[3]))
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindColumnName, tokens[4]))
+	nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, tokens[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, tokens[1]))
+	nt.AddChild(p.nameTerminal(parsetree.KindTableName, tokens[2]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, tokens[3]))
+	nt.AddChild(p.nameTerminal(parsetree.KindColumnName, tokens[4]))
 
 	return nt
 }
 
 // functionCall parses a function call.
 func (p *Parser) functionCall() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindFunctionCall)
+	nt := p.newNonTerminal(parsetree.KindFunctionCall)
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindFunctionName, p.tok[0]))
+	nt.AddChild(p.nameTerminal(parsetree.KindFunctionName, p.tok[0]))
 	p.advance()
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindDistinct || p.isStartOfExpression(0) || p.tok[0].Kind == token.KindAsterisk {
@@ -2685,7 +2796,7 @@ func (p *Parser) functionCall() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -2704,16 +2815,16 @@ func (p *Parser) functionCall() parsetree.NonTerminal {
 
 // functionCall parses the arguments of a function call.
 func (p *Parser) functionArguments() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindFunctionArguments)
+	nt := p.newNonTerminal(parsetree.KindFunctionArguments)
 
 	if p.tok[0].Kind == token.KindAsterisk {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 		return nt
 	}
 
 	if p.tok[0].Kind == token.KindDistinct {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
@@ -2734,12 +2845,12 @@ func (p *Parser) functionArguments() parsetree.NonTerminal {
 
 // orderByClause parses an order by clause.
 func (p *Parser) orderByClause(isInFollowSet func(*token.Token) bool) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindOrderByClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindOrderByClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindBy {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpression(0) || isInFollowSet(p.tok[0]) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "BY"`)))
@@ -2768,20 +2879,20 @@ func (p *Parser) orderingTermList() parsetree.NonTerminal {
 
 // orderingTerm parses an ordering term.
 func (p *Parser) orderingTerm() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindOrderingTerm)
+	nt := p.newNonTerminal(parsetree.KindOrderingTerm)
 	nt.AddChild(p.expression())
 
 	if p.tok[0].Kind == token.KindAsc || p.tok[0].Kind == token.KindDesc {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindNulls {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindFirst || p.tok[0].Kind == token.KindLast {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "FIRST", or "LAST"`)))
@@ -2793,19 +2904,19 @@ func (p *Parser) orderingTerm() parsetree.NonTerminal {
 
 // filterClause parses a filter clause.
 func (p *Parser) filterClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindFilterClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindFilterClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindWhere {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "("`)))
 	}
 
 	if p.tok[0].Kind == token.KindWhere {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpression(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "WHERE"`)))
@@ -2818,7 +2929,7 @@ func (p *Parser) filterClause() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -2829,12 +2940,12 @@ func (p *Parser) filterClause() parsetree.NonTerminal {
 
 // overClause parses a over clause.
 func (p *Parser) overClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindOverClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindOverClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindWindowName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindWindowName, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindLeftParen {
 		nt.AddChild(p.windowDefinition())
@@ -2847,23 +2958,23 @@ func (p *Parser) overClause() parsetree.NonTerminal {
 
 // windowDefinition parses a window definition.
 func (p *Parser) windowDefinition() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindWindowDefinition)
+	nt := p.newNonTerminal(parsetree.KindWindowDefinition)
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindWindowName, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindWindowName, p.tok[0]))
 		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindPartition {
-		pb := parsetree.NewNonTerminal(parsetree.KindPartitionBy)
-		pb.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		pb := p.newNonTerminal(parsetree.KindPartitionBy)
+		pb.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindBy {
-			pb.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			pb.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.isStartOfExpression(0) {
 			pb.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "BY"`)))
@@ -2891,7 +3002,7 @@ func (p *Parser) windowDefinition() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -2902,28 +3013,28 @@ func (p *Parser) windowDefinition() parsetree.NonTerminal {
 
 // frameSpec parses a frame spec clause.
 func (p *Parser) frameSpec() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindFrameSpec)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindFrameSpec)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindBetween {
 		nt.AddChild(p.frameSpecBetween())
 	} else if p.tok[0].Kind == token.KindUnbounded {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindPreceding {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "PRECEDING"`)))
 		}
 	} else if p.tok[0].Kind == token.KindCurrent {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindRow {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "ROW"`)))
@@ -2932,7 +3043,7 @@ func (p *Parser) frameSpec() parsetree.NonTerminal {
 		nt.AddChild(p.expression())
 
 		if p.tok[0].Kind == token.KindPreceding {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "PRECEDING"`)))
@@ -2942,34 +3053,34 @@ func (p *Parser) frameSpec() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindExclude {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindNo {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.tok[0].Kind == token.KindOthers {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "OTHERS"`)))
 			}
 		} else if p.tok[0].Kind == token.KindCurrent {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.tok[0].Kind == token.KindRow {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "ROW"`)))
 			}
 		} else if p.tok[0].Kind == token.KindGroup {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindTies {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "NO", "CURRENT", "GROUP", or "TIES"`)))
@@ -2981,93 +3092,93 @@ func (p *Parser) frameSpec() parsetree.NonTerminal {
 
 // frameSpecBetween parses the between part of a frame spec .
 func (p *Parser) frameSpecBetween() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindFrameSpecBetween)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindFrameSpecBetween)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindUnbounded {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindPreceding {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "PRECEDING"`)))
 		}
 	} else if p.tok[0].Kind == token.KindCurrent {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindRow {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindAnd {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "ROW"`)))
 		}
 	} else if p.isStartOfExpressionAtLeast4(0) {
-		exp := parsetree.NewNonTerminal(parsetree.KindExpression)
+		exp := p.newNonTerminal(parsetree.KindExpression)
 		exp.AddChild(p.expression4())
 
 		nt.AddChild(exp)
 
 		if p.tok[0].Kind == token.KindPreceding || p.tok[0].Kind == token.KindFollowing {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindAnd {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "PRECEDING", or "FOLLOWING"`)))
 		}
 	} else if p.tok[0].Kind == token.KindPreceding {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "UNBOUNDED", or an expression`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindRow {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "CURRENT"`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindFollowing {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing expression`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindAnd {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "UNBOUNDED", "CURRENT", or a expression`)))
 	}
 
 	if p.tok[0].Kind == token.KindAnd {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "AND"`)))
 	}
 
 	if p.tok[0].Kind == token.KindUnbounded {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindFollowing {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "FOLLOWING"`)))
 		}
 	} else if p.tok[0].Kind == token.KindCurrent {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindRow {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "ROW"`)))
 		}
 	} else if p.isStartOfExpressionAtLeast4(0) {
-		exp := parsetree.NewNonTerminal(parsetree.KindExpression)
+		exp := p.newNonTerminal(parsetree.KindExpression)
 		exp.AddChild(p.expression4())
 
 		nt.AddChild(exp)
 
 		if p.tok[0].Kind == token.KindPreceding || p.tok[0].Kind == token.KindFollowing {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "PRECEDING", or "FOLLOWING"`)))
@@ -3081,8 +3192,8 @@ func (p *Parser) frameSpecBetween() parsetree.NonTerminal {
 
 // parenExpression parses a expression enclosed in parenthesis.
 func (p *Parser) parenExpression() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindParenExpression)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindParenExpression)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) || p.tok[0].Kind == token.KindComma {
@@ -3092,7 +3203,7 @@ func (p *Parser) parenExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3102,12 +3213,12 @@ func (p *Parser) parenExpression() parsetree.NonTerminal {
 
 // castExpression parses a cast expression.
 func (p *Parser) castExpression() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCast)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindCast)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "("`)))
@@ -3120,7 +3231,7 @@ func (p *Parser) castExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindAs {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "AS"`)))
@@ -3133,7 +3244,7 @@ func (p *Parser) castExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3144,12 +3255,12 @@ func (p *Parser) castExpression() parsetree.NonTerminal {
 
 // exists parses a exists expression.
 func (p *Parser) exists() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindExists)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindExists)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindSelect {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "("`)))
@@ -3171,7 +3282,7 @@ func (p *Parser) exists() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3182,8 +3293,8 @@ func (p *Parser) exists() parsetree.NonTerminal {
 
 // caseExpression parses a case expression.
 func (p *Parser) caseExpression() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCase)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindCase)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -3208,7 +3319,7 @@ func (p *Parser) caseExpression() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindEnd {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "END"`)))
@@ -3219,8 +3330,8 @@ func (p *Parser) caseExpression() parsetree.NonTerminal {
 
 // when parses a when part of a case expression.
 func (p *Parser) when() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindWhen)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindWhen)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -3230,7 +3341,7 @@ func (p *Parser) when() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindThen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpression(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "THEN"`)))
@@ -3247,8 +3358,8 @@ func (p *Parser) when() parsetree.NonTerminal {
 
 // caseElse parses an else part of a case expression.
 func (p *Parser) caseElse() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindElse)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindElse)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -3262,12 +3373,12 @@ func (p *Parser) caseElse() parsetree.NonTerminal {
 
 // raise parses a raise function call.
 func (p *Parser) raise() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindRaise)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindRaise)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindIgnore || p.tok[0].Kind == token.KindRollback || p.tok[0].Kind == token.KindAbort ||
 		p.tok[0].Kind == token.KindFail {
@@ -3282,11 +3393,11 @@ func (p *Parser) raise() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindIgnore {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3295,26 +3406,26 @@ func (p *Parser) raise() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRollback || p.tok[0].Kind == token.KindAbort || p.tok[0].Kind == token.KindFail {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindComma {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "IGNORE", "ROLLBACK", "ABORT", or "FAIL"`)))
 	} else if p.tok[0].Kind == token.KindRightParen {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "IGNORE", "ROLLBACK", "ABORT", or "FAIL"`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 		return nt
 	}
 
 	if p.tok[0].Kind == token.KindComma {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpression(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ","`)))
 	}
 
 	if p.isStartOfExpression(0) {
-		em := parsetree.NewNonTerminal(parsetree.KindErrorMessage)
+		em := p.newNonTerminal(parsetree.KindErrorMessage)
 		em.AddChild(p.expression())
 		nt.AddChild(em)
 	} else if p.tok[0].Kind == token.KindRightParen {
@@ -3322,7 +3433,7 @@ func (p *Parser) raise() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3333,83 +3444,81 @@ func (p *Parser) raise() parsetree.NonTerminal {
 
 // insert parses a insert statement.
 func (p *Parser) insert(withClause parsetree.NonTerminal) parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindInsert)
+	nt := p.newNonTerminal(parsetree.KindInsert)
 
 	if withClause != nil {
 		nt.AddChild(withClause)
 	}
 
 	if p.tok[0].Kind == token.KindInsert {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindOr {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			switch p.tok[0].Kind {
 			case token.KindAbort, token.KindFail, token.KindIgnore, token.KindReplace, token.KindRollback:
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			case token.KindInto:
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting "ABORT", "FAIL", "IGNORE", "REPLACE", or "ROLLBACK"`)))
 			}
 		}
 	} else {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindInto {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindIdentifier {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "INTO"`)))
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	switch p.tok[0].Kind {
-	case token.KindIdentifier:
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
-		p.advance()
-	case token.KindAs, token.KindLeftParen, token.KindValues, token.KindWith, token.KindSelect, token.KindDefault:
+	if p.isAnyOf(token.KindAs, token.KindLeftParen, token.KindValues, token.KindWith, token.KindSelect, token.KindDefault) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table name`)))
+	} else if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
+		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindAs {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
-		switch p.tok[0].Kind {
-		case token.KindIdentifier:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
-			p.advance()
-		case token.KindLeftParen, token.KindValues, token.KindWith, token.KindSelect, token.KindDefault:
+		if p.isAnyOf(token.KindLeftParen, token.KindValues, token.KindWith, token.KindSelect, token.KindDefault) {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table alias`)))
+		} else if p.isName(0) {
+			nt.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
+			p.advance()
 		}
 	}
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
-		if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindComma {
+		if p.isName(0) || p.tok[0].Kind == token.KindComma {
 			nt.AddChild(p.columnNameList(token.KindRightParen, token.KindValues, token.KindWith, token.KindSelect,
 				token.KindDefault, token.KindSemicolon, token.KindEOF))
 		} else if p.tok[0].Kind == token.KindRightParen {
@@ -3417,7 +3526,7 @@ func (p *Parser) insert(withClause parsetree.NonTerminal) parsetree.NonTerminal
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindValues || p.tok[0].Kind == token.KindWith || p.tok[0].Kind == token.KindSelect ||
 			p.tok[0].Kind == token.KindDefault {
@@ -3426,7 +3535,7 @@ func (p *Parser) insert(withClause parsetree.NonTerminal) parsetree.NonTerminal
 	}
 
 	if p.tok[0].Kind == token.KindValues {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindLeftParen {
@@ -3455,11 +3564,11 @@ func (p *Parser) insert(withClause parsetree.NonTerminal) parsetree.NonTerminal
 			nt.AddChild(p.upsertClause())
 		}
 	} else if p.tok[0].Kind == token.KindDefault {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindValues {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "VALUES"`)))
@@ -3477,7 +3586,7 @@ func (p *Parser) insert(withClause parsetree.NonTerminal) parsetree.NonTerminal
 
 // insertValues parses the values list of a insert.
 func (p *Parser) insertValuesList() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindInsertValuesList)
+	nt := p.newNonTerminal(parsetree.KindInsertValuesList)
 	nt.AddChild(p.insertValuesItemList())
 	return nt
 }
@@ -3498,10 +3607,10 @@ func (p *Parser) insertValuesItemList() parsetree.NonTerminal {
 
 // insertValuesItem parses a item in a insert values list.
 func (p *Parser) insertValuesItem() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindInsertValuesItem)
+	nt := p.newNonTerminal(parsetree.KindInsertValuesItem)
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else { // expression
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "("`)))
@@ -3510,7 +3619,7 @@ func (p *Parser) insertValuesItem() parsetree.NonTerminal {
 	nt.AddChild(p.expressionList())
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
@@ -3519,7 +3628,7 @@ func (p *Parser) insertValuesItem() parsetree.NonTerminal {
 
 // upsertClause parses a upsert clause.
 func (p *Parser) upsertClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindUpsertClause)
+	nt := p.newNonTerminal(parsetree.KindUpsertClause)
 
 	for p.tok[0].Kind == token.KindOn {
 		nt.AddChild(p.upsertClauseItem())
@@ -3530,25 +3639,25 @@ func (p *Parser) upsertClause() parsetree.NonTerminal {
 
 // upsertClauseItem parses a item of an upsert clause.
 func (p *Parser) upsertClauseItem() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindUpsertClauseItem)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindUpsertClauseItem)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindConflict {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindLeftParen || p.tok[0].Kind == token.KindDo {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "CONFLICT"`)))
 	}
 
 	if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		nt.AddChild(p.indexedColumnList(true))
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindWhere || p.tok[0].Kind == token.KindDo {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3560,27 +3669,27 @@ func (p *Parser) upsertClauseItem() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindDo {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindNothing || p.tok[0].Kind == token.KindUpdate {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "DO"`)))
 	}
 
 	if p.tok[0].Kind == token.KindNothing {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindUpdate {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindSet {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
-		} else if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindLeftParen {
+		} else if p.isName(0) || p.tok[0].Kind == token.KindLeftParen {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "SET"`)))
 		}
 
-		if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindLeftParen {
+		if p.isName(0) || p.tok[0].Kind == token.KindLeftParen {
 			nt.AddChild(p.updateSetItemList())
 		} else if p.tok[0].Kind == token.KindWhere {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting column name, or "("`)))
@@ -3614,11 +3723,10 @@ func (p *Parser) updateSetItemList() parsetree.NonTerminal {
 func (p *Parser) updateSetItem() parsetree.NonTerminal {
 	p.pushTree(parsetree.KindUpdateSetItem)
 
-	switch p.token(token.KindIdentifier, token.KindLeftParen) {
-	case token.KindIdentifier:
-		p.termKind(parsetree.KindColumnName)
-	default: //token.KindLeftParen
-		p.term()
+	if p.isName(0) {
+		p.name(parsetree.KindColumnName)
+	} else {
+		p.term(token.KindIdentifier, token.KindLeftParen)
 		p.addChild(p.columnNameList(token.KindRightParen, token.KindEqual, token.KindSemicolon, token.KindEOF))
 		p.term(token.KindRightParen)
 	}
@@ -3645,37 +3753,37 @@ func (p *Parser) with() parsetree.NonTerminal {
 
 // pragma parses a pragma statement.
 func (p *Parser) pragma() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindPragma)
+	nt := p.newNonTerminal(parsetree.KindPragma)
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindPragmaName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindPragmaName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing pragma name`)))
 	}
 
 	if p.tok[0].Kind == token.KindEqual {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindPlus || p.tok[0].Kind == token.KindMinus || p.tok[0].Kind == token.KindNumeric || p.tok[0].Kind.IsKeyword() || p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindString {
@@ -3684,7 +3792,7 @@ func (p *Parser) pragma() parsetree.NonTerminal {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing pragma value`)))
 		}
 	} else if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindPlus || p.tok[0].Kind == token.KindMinus || p.tok[0].Kind == token.KindNumeric || p.tok[0].Kind.IsKeyword() || p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindString {
@@ -3694,7 +3802,7 @@ func (p *Parser) pragma() parsetree.NonTerminal {
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -3706,15 +3814,15 @@ func (p *Parser) pragma() parsetree.NonTerminal {
 
 // pragmaValue parses a pragma value.
 func (p *Parser) pragmaValue() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindPragmaValue)
+	nt := p.newNonTerminal(parsetree.KindPragmaValue)
 
 	if p.tok[0].Kind == token.KindPlus || p.tok[0].Kind == token.KindMinus {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindNumeric || p.tok[0].Kind.IsKeyword() || p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindString {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting number, keyword, identifier, or string`)))
@@ -3725,35 +3833,35 @@ func (p *Parser) pragmaValue() parsetree.NonTerminal {
 
 // reindex parses a reindex statement.
 func (p *Parser) reindex() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindReindex)
+	nt := p.newNonTerminal(parsetree.KindReindex)
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	var hasSchema bool
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
 			hasSchema = true
 
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[1].Kind == token.KindIdentifier {
 			hasSchema = true
 
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing dot`)))
 		}
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
+	if p.isName(0) {
 		k := parsetree.KindCollationTableOrIndexName
 		if hasSchema {
 			k = parsetree.KindTableOrIndexName
 		}
-		nt.AddChild(parsetree.NewTerminal(k, p.tok[0]))
+		nt.AddChild(p.nameTerminal(k, p.tok[0]))
 		p.advance()
 	} else if hasSchema {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table name, or index name`)))
@@ -3764,17 +3872,17 @@ func (p *Parser) reindex() parsetree.NonTerminal {
 
 // release parses a release statement.
 func (p *Parser) release() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindRelease)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindRelease)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindSavepoint {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindSavepointName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindSavepointName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing savepoint name`)))
@@ -3785,12 +3893,12 @@ func (p *Parser) release() parsetree.NonTerminal {
 
 // savepoint parses a savepoint statement.
 func (p *Parser) savepoint() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindSavepoint)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindSavepoint)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindSavepointName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindSavepointName, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing savepoint name`)))
@@ -3805,7 +3913,7 @@ func (p *Parser) selectStatement(withClause parsetree.NonTerminal) parsetree.Non
 
 	sc := p.selectCore()
 	if p.tok[0].Kind == token.KindUnion || p.tok[0].Kind == token.KindIntersect || p.tok[0].Kind == token.KindExcept {
-		nt = parsetree.NewNonTerminal(parsetree.KindCompoundSelect)
+		nt = p.newNonTerminal(parsetree.KindCompoundSelect)
 		if withClause != nil {
 			nt.AddChild(withClause)
 		}
@@ -3821,7 +3929,7 @@ func (p *Parser) selectStatement(withClause parsetree.NonTerminal) parsetree.Non
 			}
 		}
 	} else {
-		nt = parsetree.NewNonTerminal(parsetree.KindSimpleSelect)
+		nt = p.newNonTerminal(parsetree.KindSimpleSelect)
 		if withClause != nil {
 			nt.AddChild(withClause)
 		}
@@ -3844,13 +3952,13 @@ func (p *Parser) selectStatement(withClause parsetree.NonTerminal) parsetree.Non
 
 // selectCore parses a select core.
 func (p *Parser) selectCore() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindSelectCore)
+	nt := p.newNonTerminal(parsetree.KindSelectCore)
 	if p.tok[0].Kind == token.KindSelect {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindAll || p.tok[0].Kind == token.KindDistinct {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 
@@ -3888,12 +3996,12 @@ func (p *Parser) selectCore() parsetree.NonTerminal {
 
 // resultColumnList parses a list of result columns.
 func (p *Parser) resultColumnList() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCommaList)
+	nt := p.newNonTerminal(parsetree.KindCommaList)
 
 	nt.AddChild(p.resultColumn())
 
 	for p.tok[0].Kind == token.KindComma {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) || p.tok[0].Kind == token.KindAsterisk {
@@ -3908,31 +4016,31 @@ func (p *Parser) resultColumnList() parsetree.NonTerminal {
 
 // resultColumn parses a result column of a select statement.
 func (p *Parser) resultColumn() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindResultColumn)
+	nt := p.newNonTerminal(parsetree.KindResultColumn)
 	if p.tok[0].Kind == token.KindAsterisk {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
-	} else if p.tok[0].Kind == token.KindIdentifier && p.tok[1].Kind == token.KindDot && p.tok[2].Kind == token.KindAsterisk {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+	} else if p.isName(0) && p.tok[1].Kind == token.KindDot && p.tok[2].Kind == token.KindAsterisk {
+		nt.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpression(0) {
 		nt.AddChild(p.expression())
 		if p.tok[0].Kind == token.KindAs {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
-			if p.tok[0].Kind == token.KindIdentifier {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindColumnAlias, p.tok[0]))
+			if p.isName(0) {
+				nt.AddChild(p.nameTerminal(parsetree.KindColumnAlias, p.tok[0]))
 				p.advance()
 			} else {
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing column alias`)))
 			}
-		} else if p.tok[0].Kind == token.KindIdentifier {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindColumnAlias, p.tok[0]))
+		} else if p.isAlias(0) {
+			nt.AddChild(p.nameTerminal(parsetree.KindColumnAlias, p.tok[0]))
 			p.advance()
 		}
 	} else {
@@ -3943,11 +4051,11 @@ func (p *Parser) resultColumn() parsetree.NonTerminal {
 
 // fromClause parses a from clause.
 func (p *Parser) fromClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindFromClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindFromClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindLeftParen {
+	if p.isName(0) || p.tok[0].Kind == token.KindLeftParen {
 		nt.AddChild(p.joinClause())
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting identifier, or "("`)))
@@ -3958,43 +4066,43 @@ func (p *Parser) fromClause() parsetree.NonTerminal {
 
 // tableOrSubquery parses a table-or-subquery clause.
 func (p *Parser) tableOrSubquery() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindTableOrSubquery)
+	nt := p.newNonTerminal(parsetree.KindTableOrSubquery)
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp || p.tok[0].Kind == token.KindDot {
+	if p.isName(0) || p.tok[0].Kind == token.KindDot {
 		p.tableOrSubquery_table(nt)
 	} else if p.tok[0].Kind == token.KindLeftParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindWith || p.tok[0].Kind == token.KindSelect {
 			nt.AddChild(p.selectStatement(nil))
 
 			if p.tok[0].Kind == token.KindRightParen {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
 			}
 
 			if p.tok[0].Kind == token.KindAs {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 
-				if p.tok[0].Kind == token.KindIdentifier {
-					nt.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+				if p.isName(0) {
+					nt.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 					p.advance()
 				} else {
 					nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table alias`)))
 				}
-			} else if p.tok[0].Kind == token.KindIdentifier {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+			} else if p.isAlias(0) {
+				nt.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 				p.advance()
 			}
-		} else if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp || p.tok[0].Kind == token.KindLeftParen {
+		} else if p.isName(0) || p.tok[0].Kind == token.KindLeftParen {
 			nt.AddChild(p.joinClause())
 
 			if p.tok[0].Kind == token.KindRightParen {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -4003,7 +4111,7 @@ func (p *Parser) tableOrSubquery() parsetree.NonTerminal {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting select statement, schema name, table name, or "("`)))
 
 			if p.tok[0].Kind == token.KindRightParen {
-				nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			}
 		}
@@ -4014,76 +4122,76 @@ func (p *Parser) tableOrSubquery() parsetree.NonTerminal {
 
 // tableOrSubquery_table parses a table-or-subquery that is a table.
 func (p *Parser) tableOrSubquery_table(tableOrSubquery parsetree.NonTerminal) {
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindDot {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+			tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 			p.advance()
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 	} else if p.tok[0].Kind == token.KindDot {
 		tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing schema name`)))
-		tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
-	if p.tok[0].Kind == token.KindIdentifier {
+	if p.isName(0) {
 		if p.tok[1].Kind == token.KindLeftParen {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindTableFunctionName, p.tok[0]))
+			tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindTableFunctionName, p.tok[0]))
 			p.advance()
 		} else {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindTableName, p.tok[0]))
+			tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindTableName, p.tok[0]))
 			p.advance()
 		}
 	} else {
 		tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting table name, or table-function name`)))
 	}
 
-	if p.tok[0].Kind == token.KindAs || p.tok[0].Kind == token.KindIdentifier ||
+	if p.tok[0].Kind == token.KindAs || p.isAlias(0) ||
 		p.tok[0].Kind == token.KindIndexed || p.tok[0].Kind == token.KindNot {
 		if p.tok[0].Kind == token.KindAs {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
-			if p.tok[0].Kind == token.KindIdentifier {
-				tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+			if p.isName(0) {
+				tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 				p.advance()
 			} else {
 				tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table alias`)))
 			}
-		} else if p.tok[0].Kind == token.KindIdentifier {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+		} else if p.isAlias(0) {
+			tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 			p.advance()
 		}
 
 		if p.tok[0].Kind == token.KindIndexed {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.tok[0].Kind == token.KindBy {
-				tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else if p.tok[0].Kind == token.KindIdentifier {
 				tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "BY"`)))
 			}
 
-			if p.tok[0].Kind == token.KindIdentifier {
-				tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindIndexName, p.tok[0]))
+			if p.isName(0) {
+				tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindIndexName, p.tok[0]))
 				p.advance()
 			}
 		} else if p.tok[0].Kind == token.KindNot {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
 			if p.tok[0].Kind == token.KindIndexed {
-				tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+				tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 				p.advance()
 			} else {
 				tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "INDEXED"`)))
 			}
 		}
 	} else if p.tok[0].Kind == token.KindLeftParen {
-		tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
@@ -4093,24 +4201,24 @@ func (p *Parser) tableOrSubquery_table(tableOrSubquery parsetree.NonTerminal) {
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
 		}
 
 		if p.tok[0].Kind == token.KindAs {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			tableOrSubquery.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 
-			if p.tok[0].Kind == token.KindIdentifier {
-				tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+			if p.isName(0) {
+				tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 				p.advance()
 			} else {
 				tableOrSubquery.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing table alias`)))
 			}
-		} else if p.tok[0].Kind == token.KindIdentifier {
-			tableOrSubquery.AddChild(parsetree.NewTerminal(parsetree.KindTableAlias, p.tok[0]))
+		} else if p.isAlias(0) {
+			tableOrSubquery.AddChild(p.nameTerminal(parsetree.KindTableAlias, p.tok[0]))
 			p.advance()
 		}
 	}
@@ -4118,7 +4226,7 @@ func (p *Parser) tableOrSubquery_table(tableOrSubquery parsetree.NonTerminal) {
 
 // joinClause parses a join clause.
 func (p *Parser) joinClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindJoinClause)
+	nt := p.newNonTerminal(parsetree.KindJoinClause)
 	nt.AddChild(p.tableOrSubquery())
 
 	joinOpStart := []token.Kind{token.KindComma, token.KindCross, token.KindFull, token.KindInner, token.KindLeft,
@@ -4129,7 +4237,7 @@ func (p *Parser) joinClause() parsetree.NonTerminal {
 			break
 		}
 		nt.AddChild(p.joinOperator())
-		if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindLeftParen {
+		if p.isName(0) || p.tok[0].Kind == token.KindLeftParen {
 			nt.AddChild(p.tableOrSubquery())
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorExpecting, errors.New(`expecting identifier, or "("`)))
@@ -4145,9 +4253,9 @@ func (p *Parser) joinClause() parsetree.NonTerminal {
 
 // joinOperator parses a join operator.
 func (p *Parser) joinOperator() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindJoinOperator)
+	nt := p.newNonTerminal(parsetree.KindJoinOperator)
 	if p.tok[0].Kind == token.KindComma {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 		return nt
 	}
@@ -4157,7 +4265,7 @@ FOR:
 		switch p.tok[0].Kind {
 		case token.KindCross, token.KindFull, token.KindInner, token.KindLeft, token.KindNatural,
 			token.KindOuter, token.KindRight:
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		default:
 			break FOR
@@ -4165,7 +4273,7 @@ FOR:
 	}
 
 	if p.tok[0].Kind == token.KindJoin {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "JOIN"`)))
@@ -4176,9 +4284,9 @@ FOR:
 
 // joinConstraint parses a join constraint.
 func (p *Parser) joinConstraint() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindJoinConstraint)
+	nt := p.newNonTerminal(parsetree.KindJoinConstraint)
 	if p.tok[0].Kind == token.KindOn {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
@@ -4187,24 +4295,24 @@ func (p *Parser) joinConstraint() parsetree.NonTerminal {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing expression`)))
 		}
 	} else { // USING
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindLeftParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else if p.tok[0].Kind == token.KindIdentifier {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "("`)))
 		}
 
-		if p.tok[0].Kind == token.KindIdentifier {
+		if p.isName(0) {
 			nt.AddChild(p.columnNameList(token.KindRightParen, token.KindSemicolon, token.KindEOF))
 		} else if p.tok[0].Kind == token.KindRightParen {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing column name`)))
 		}
 
 		if p.tok[0].Kind == token.KindRightParen {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		} else {
 			nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing ")"`)))
@@ -4215,13 +4323,13 @@ func (p *Parser) joinConstraint() parsetree.NonTerminal {
 
 // groupByClause parses a group by clause.
 func (p *Parser) groupByClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindGroupByClause)
+	nt := p.newNonTerminal(parsetree.KindGroupByClause)
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindBy {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.isStartOfExpression(0) {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "BY"`)))
@@ -4238,8 +4346,8 @@ func (p *Parser) groupByClause() parsetree.NonTerminal {
 
 // havingClause parses a having clause.
 func (p *Parser) havingClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindHavingClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindHavingClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -4253,12 +4361,12 @@ func (p *Parser) havingClause() parsetree.NonTerminal {
 
 // windowClause parses a window clause.
 func (p *Parser) windowClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindWindowClause)
+	nt := p.newNonTerminal(parsetree.KindWindowClause)
 
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier {
+	if p.isName(0) {
 		nt.AddChild(p.windowClauseItemList())
 	} else {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing window declaration`)))
@@ -4283,12 +4391,12 @@ func (p *Parser) windowClauseItemList() parsetree.NonTerminal {
 
 // windowClauseItem parses a window declaration.
 func (p *Parser) windowClauseItem() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindWindowClauseItem)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindWindowName, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindWindowClauseItem)
+	nt.AddChild(p.nameTerminal(parsetree.KindWindowName, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindAs {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	} else if p.tok[0].Kind == token.KindLeftParen {
 		nt.AddChild(parsetree.NewError(parsetree.KindErrorMissing, errors.New(`missing "AS"`)))
@@ -4305,8 +4413,8 @@ func (p *Parser) windowClauseItem() parsetree.NonTerminal {
 
 // valuesClause parses a values clause.
 func (p *Parser) valuesClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindValuesClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindValuesClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.tok[0].Kind == token.KindLeftParen {
@@ -4334,8 +4442,8 @@ func (p *Parser) valuesItemList() parsetree.NonTerminal {
 
 // valuesItem parses a item of a values clause.
 func (p *Parser) valuesItem() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindValuesItem)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindValuesItem)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -4345,7 +4453,7 @@ func (p *Parser) valuesItem() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindRightParen {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
@@ -4354,17 +4462,17 @@ func (p *Parser) valuesItem() parsetree.NonTerminal {
 
 // compoundOperator parses a item of a compound operator.
 func (p *Parser) compoundOperator() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindCompoundOperator)
+	nt := p.newNonTerminal(parsetree.KindCompoundOperator)
 	if p.tok[0].Kind == token.KindUnion {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.tok[0].Kind == token.KindAll {
-			nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+			nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 			p.advance()
 		}
 	} else { // INSTERSECT, EXCEPT
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 
@@ -4373,8 +4481,8 @@ func (p *Parser) compoundOperator() parsetree.NonTerminal {
 
 // limitClause parses a limit clause.
 func (p *Parser) limitClause() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindLimitClause)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindLimitClause)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
 	if p.isStartOfExpression(0) {
@@ -4384,7 +4492,7 @@ func (p *Parser) limitClause() parsetree.NonTerminal {
 	}
 
 	if p.tok[0].Kind == token.KindOffset || p.tok[0].Kind == token.KindComma {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
@@ -4443,21 +4551,21 @@ func (p *Parser) update(withClause parsetree.NonTerminal) parsetree.NonTerminal
 
 // vacuum parses a vacuum statement.
 func (p *Parser) vacuum() parsetree.NonTerminal {
-	nt := parsetree.NewNonTerminal(parsetree.KindVacuum)
-	nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+	nt := p.newNonTerminal(parsetree.KindVacuum)
+	nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 	p.advance()
 
-	if p.tok[0].Kind == token.KindIdentifier || p.tok[0].Kind == token.KindTemp {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindSchemaName, p.tok[0]))
+	if p.isName(0) {
+		nt.AddChild(p.nameTerminal(parsetree.KindSchemaName, p.tok[0]))
 		p.advance()
 	}
 
 	if p.tok[0].Kind == token.KindInto {
-		nt.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		nt.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 
 		if p.isStartOfExpression(0) {
-			fn := parsetree.NewNonTerminal(parsetree.KindFileName)
+			fn := p.newNonTerminal(parsetree.KindFileName)
 			fn.AddChild(p.expression())
 			nt.AddChild(fn)
 		} else {
@@ -4468,8 +4576,47 @@ func (p *Parser) vacuum() parsetree.NonTerminal {
 	return nt
 }
 
+// newNonTerminal creates a NonTerminal in the arena of p, if any.
+func (p *Parser) newNonTerminal(kind parsetree.Kind) parsetree.NonTerminal {
+	if p.arena != nil {
+		return p.arena.NewNonTerminal(kind)
+	}
+	return parsetree.NewNonTerminal(kind)
+}
+
+// newTerminal creates a Terminal in the arena of p, if any.
+func (p *Parser) newTerminal(kind parsetree.Kind, tok *token.Token) parsetree.Terminal {
+	if p.arena != nil {
+		return p.arena.NewTerminal(kind, tok)
+	}
+	return parsetree.NewTerminal(kind, tok)
+}
+
+// newFallbackTerminal creates a Terminal with a fallback in the arena of p, if any.
+func (p *Parser) newFallbackTerminal(kind parsetree.Kind, tok *token.Token, fallback parsetree.Fallback) parsetree.Terminal {
+	if p.arena != nil {
+		return p.arena.NewFallbackTerminal(kind, tok, fallback)
+	}
+	return parsetree.NewFallbackTerminal(kind, tok, fallback)
+}
+
+// tokenInto is implemented by the token providers that can store the next token in a given token, like lexer.Lexer.
+type tokenInto interface {
+	NextInto(tok *token.Token)
+}
+
+// next returns the next token of p.tp. If p has an arena the token is allocated in it when possible.
+func (p *Parser) next() *token.Token {
+	if ti, ok := p.tp.(tokenInto); ok && p.arena != nil {
+		tok := p.arena.NewToken()
+		ti.NextInto(tok)
+		return tok
+	}
+	return p.tp.Next()
+}
+
 func (p *Parser) pushTree(k parsetree.Kind) {
-	p.treeStack = append(p.treeStack, parsetree.NewNonTerminal(k))
+	p.treeStack = append(p.treeStack, p.newNonTerminal(k))
 }
 
 func (p *Parser) addChild(t parsetree.Construction) {
@@ -4524,7 +4671,7 @@ func (p *Parser) termKind(treeKind parsetree.Kind, tokKinds ...token.Kind) {
 	if len(tokKinds) > 0 {
 		p.token(tokKinds[0], tokKinds[1:]...)
 	}
-	t := parsetree.NewTerminal(treeKind, p.tok[0])
+	t := p.newTerminal(treeKind, p.tok[0])
 	p.addChild(t)
 	p.advance()
 }
@@ -4540,16 +4687,83 @@ func (p *Parser) tokenPos(pos int, k token.Kind, ks ...token.Kind) token.Kind {
 	return p.tok[pos].Kind
 }
 
-// advance advances the lexer and put the next comments in p.comments
+// joinKinds contains the kinds of the keywords that the grammar of SQLite accepts as names, but not as aliases, since
+// an alias can be followed by them.
+var joinKinds = map[token.Kind]bool{
+	token.KindCross: true, token.KindFull: true, token.KindIndexed: true, token.KindInner: true,
+	token.KindLeft: true, token.KindNatural: true, token.KindOuter: true, token.KindRight: true,
+}
+
+// isName reports whether the token at pos can be a name, that is, whether it is an identifier, a keyword that can be
+// used as a name or a string literal. A string literal is a name where a string literal is not allowed.
+func (p *Parser) isName(pos int) bool {
+	k := p.tok[pos].Kind
+	return k == token.KindIdentifier || k == token.KindString || token.IsFallback(k) || joinKinds[k]
+}
+
+// isColumnName reports whether the token at pos can be the name of a column, or of a function, in an expression. Unlike
+// in isName, a string literal is not accepted, since it is a literal value in an expression, CAST and RAISE are not
+// accepted, since they start expressions, and the keywords that end the constructions around expressions, like END
+// and PRECEDING, are not accepted, so a missing expression before them is reported as missing.
+func (p *Parser) isColumnName(pos int) bool {
+	if p.isAnyOfPos(pos, token.KindCast, token.KindRaise, token.KindEnd, token.KindDo, token.KindRow,
+		token.KindPreceding, token.KindFollowing) {
+		return false
+	}
+	return p.isPos(pos, token.KindIdentifier) || token.IsFallback(p.tok[pos].Kind) || joinKinds[p.tok[pos].Kind]
+}
+
+// isAlias reports whether the token at pos can be an alias not preceded by AS. The keywords rejected by isColumnName
+// are rejected too, since they can follow an expression.
+func (p *Parser) isAlias(pos int) bool {
+	if p.isAnyOfPos(pos, token.KindIdentifier, token.KindString) {
+		return true
+	}
+	return token.IsFallback(p.tok[pos].Kind) && p.isColumnName(pos)
+}
+
+// isStringFallback reports whether the token at pos is an identifier quoted with double quotes that must be parsed as a
+// string literal, see WithColumns.
+func (p *Parser) isStringFallback(pos int) bool {
+	tok := p.tok[pos]
+	if p.isColumn == nil || tok.Kind != token.KindIdentifier || len(tok.Lexeme) == 0 || tok.Lexeme[0] != '"' ||
+		p.isPos(pos+1, token.KindDot) {
+		return false
+	}
+	name, err := literal.Identifier(tok.Lexeme)
+	return err == nil && !p.isColumn(name)
+}
+
+// name adds to the current tree a terminal of kind treeKind with the name in p.tok[0], or panics if there is no name.
+func (p *Parser) name(treeKind parsetree.Kind) {
+	if !p.isName(0) {
+		panic(&syntaxError{expected: []token.Kind{token.KindIdentifier}, got: p.tok[0]})
+	}
+	p.addChild(p.nameTerminal(treeKind, p.tok[0]))
+	p.advance()
+}
+
+// nameTerminal creates a terminal of kind treeKind for the name tok. If tok is a keyword or a string literal the
+// fallback is marked in the terminal.
+func (p *Parser) nameTerminal(treeKind parsetree.Kind, tok *token.Token) parsetree.Terminal {
+	if tok.Kind == token.KindString {
+		return p.newFallbackTerminal(treeKind, tok, parsetree.FallbackIdentifier)
+	} else if tok.Kind.IsKeyword() {
+		return p.newFallbackTerminal(treeKind, tok, parsetree.FallbackKeyword)
+	}
+	return p.newTerminal(treeKind, tok)
+}
+
+// advance advances the token provider and put the next comments in p.comments
 // and the token after the comments in p.tok.
 func (p *Parser) advance() {
 	var tok *token.Token
 	var comments []*token.Token
 	for {
-		tok = p.l.Next()
+		tok = p.next()
 		if tok.Kind == token.KindSQLComment || tok.Kind == token.KindCComment {
 			comments = append(comments, tok)
-		} else if tok.Kind != token.KindWhiteSpace {
+		} else if tok.Kind != token.KindWhiteSpace && tok.Kind != token.KindByteOrderMark {
 			if len(comments) > 0 {
 				p.comments[tok] = comments
 			}
@@ -4583,9 +4797,9 @@ func (p *Parser) skipToFunc(father parsetree.NonTerminal, predicate func(*token.
 		father.AddChild(parsetree.NewError(parsetree.KindErrorUnexpectedEOF, errors.New(`unexpected EOF`)))
 		return false
 	}
-	skipped := parsetree.NewNonTerminal(parsetree.KindSkipped)
+	skipped := p.newNonTerminal(parsetree.KindSkipped)
 	for !predicate(p.tok[0]) && p.tok[0].Kind != token.KindEOF {
-		skipped.AddChild(parsetree.NewTerminal(parsetree.KindToken, p.tok[0]))
+		skipped.AddChild(p.newTerminal(parsetree.KindToken, p.tok[0]))
 		p.advance()
 	}
 	father.AddChild(skipped)
@@ -17,6 +17,7 @@ import (
 	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
 )
 
 type testCase struct {
@@ -54,6 +55,8 @@ func TestSQLStatement(t *testing.T) {
 		"SQLStatement {AlterTable {TT TableName RenameTo {TT TableName}} T}",
 		`ANALYZE schema_name`,
 		"SQLStatement {Analyze {T SchemaIndexOrTableName} T}",
+		"\uFEFFANALYZE schema_name",
+		"SQLStatement {Analyze {T SchemaIndexOrTableName} T}",
 		`ATTACH DATABASE ':memory' AS schema_name`,
 		"SQLStatement {Attach {TT E{T} T SchemaName} T}",
 		`BEGIN`,
@@ -81,6 +84,9 @@ func TestSQLStatement(t *testing.T) {
 		"SQLStatement{CreateView{TT ViewName T SimpleSelect{SelectCore{T CommaList{ResultColumn{E{T}}}}}} T}",
 		`CREATE TEMP VIEW IF NOT EXISTS view_name AS SELECT 10;`,
 		"SQLStatement{CreateView{TTT TTT ViewName T SimpleSelect{SelectCore{T CommaList{ResultColumn{E{T}}}}}} T}",
+		`CREATE VIEW view_name AS WITH cte AS (SELECT 10) SELECT 10;`,
+		`SQLStatement{CreateView{TT ViewName T SimpleSelect{WithClause{T CommaList{CommonTableExpression{TableName T T
+			SimpleSelect{SelectCore{T CommaList{ResultColumn{E{T}}}}} T}}} SelectCore{T CommaList{ResultColumn{E{T}}}}}} T}`,
 		`CREATE VIRTUAL TABLE table_name USING module_name`,
 		"SQLStatement{CreateVirtualTable{TTT TableName T ModuleName} T}",
 		`DELETE FROM tableName`,
@@ -126,6 +132,8 @@ func TestSQLStatement(t *testing.T) {
 		`VACUUM;`, "SQLStatement{Vacuum{T} T}",
 	)
 
+	arena := parsetree.NewArena()
+	arenaParser := New(nil, WithArena(arena))
 	for i, c := range cases {
 		c := c
 		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
@@ -149,6 +157,17 @@ func TestSQLStatement(t *testing.T) {
 				t.Log("\n" + str)
 				t.Fail()
 			}
+
+			// the parser with an arena is reused by all the cases.
+			arena.Release()
+			arenaParser.Reset(lexer.New([]byte(c.code)))
+			parsed, comments = arenaParser.SQLStatement()
+
+			if str, equals := compare(c.code, comments, parsed, expected); !equals {
+				t.Log("with arena: " + c.code)
+				t.Log("\n" + str)
+				t.Fail()
+			}
 		})
 	}
 }
@@ -180,6 +199,148 @@ func TestSQLStatementError(t *testing.T) {
 	}
 }
 
+func TestErrorToken(t *testing.T) {
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			tok, ok := ErrorToken(err)
+			if !ok {
+				t.Fatalf("ErrorToken(%v) not ok", err)
+			}
+			if tok.Kind != token.KindAlter || string(tok.Lexeme) != "ALTER" {
+				t.Errorf("got token %s %q, want Alter %q", tok.Kind, tok.Lexeme, "ALTER")
+			}
+		}()
+		New(lexer.New([]byte("EXPLAIN QUERY ALTER TABLE t RENAME TO u;"))).SQLStatement()
+	}()
+
+	if _, ok := ErrorToken(errors.New("other")); ok {
+		t.Error("ErrorToken ok for an error that isn't of the parser")
+	}
+}
+
+func TestTokenProvider(t *testing.T) {
+	withoutComments := lexical.NewWindow(0, 0, lexical.WindowTransformerFunc(func(w *lexical.Window) []*token.Token {
+		if k := w.Current().Kind; k == token.KindSQLComment || k == token.KindCComment {
+			return nil
+		}
+		return []*token.Token{w.Current()}
+	}))
+	cases := []struct {
+		code string
+		// transformer is applied to the tokens of code.
+		transformer lexical.Transformer
+		// transformed is the code after the transformation.
+		transformed string
+		tree        string
+	}{
+		{
+			code:        `delete from tableName`,
+			transformer: lexical.KeywordToUppercase(),
+			transformed: `DELETE FROM tableName`,
+			tree:        "SQLStatement{Delete{TT QualifiedTableName{TableName}} T}",
+		}, {
+			code:        `delete /* a */ from -- b` + "\n" + `tableName`,
+			transformer: lexical.Chain(withoutComments, lexical.KeywordToUppercase()),
+			transformed: `DELETE FROM tableName`,
+			tree:        "SQLStatement{Delete{TT QualifiedTableName{TableName}} T}",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			tp := newTestParser(newTestLexer(c.tree))
+			expected := tp.tree()
+
+			p := New(lexical.NewTokenProvider(lexer.New([]byte(c.code)), c.transformer))
+			parsed, comments := p.SQLStatement()
+
+			if str, equals := compare(c.transformed, comments, parsed, expected); !equals {
+				t.Log(c.code)
+				t.Log("\n" + str)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestFallback(t *testing.T) {
+	isColumn := func(name string) bool { return name == "a" }
+	cases := []struct {
+		code string
+		opts []Option
+		// fallbacks are the terminals with fallback, as lexeme:fallback.
+		fallbacks []string
+	}{
+		{
+			code:      `CREATE TABLE t(key INTEGER PRIMARY KEY, action TEXT, replace, temp)`,
+			fallbacks: []string{"key:Keyword", "action:Keyword", "replace:Keyword", "temp:Keyword"},
+		}, {
+			code:      `CREATE TABLE t(rollback INTEGER, temporary, b NOT NULL ON CONFLICT ROLLBACK)`,
+			fallbacks: []string{"rollback:Keyword", "temporary:Keyword"},
+		}, {
+			code:      `SELECT key, replace(a, 'b', 'c') AS row FROM temp.t`,
+			fallbacks: []string{"key:Keyword", "replace:Keyword", "row:Keyword", "temp:Keyword"},
+		}, {
+			code:      `SELECT t.key FROM t AS 'alias' WHERE t.action = 1`,
+			fallbacks: []string{"key:Keyword", "'alias':Identifier", "action:Keyword"},
+		}, {
+			code:      `CREATE TABLE 'quoted'(a)`,
+			fallbacks: []string{"'quoted':Identifier"},
+		}, {
+			code:      `UPDATE t SET key = 1, action = 2`,
+			fallbacks: []string{"key:Keyword", "action:Keyword"},
+		}, {
+			code:      `SELECT CAST(1 AS INTEGER), current_date, a LIKE 'b' FROM x LEFT JOIN y ON x.a = y.a`,
+			fallbacks: nil,
+		}, {
+			code:      `SELECT "a", "b", t."b" FROM t`,
+			fallbacks: nil,
+		}, {
+			code:      `SELECT "a", "b", t."b" FROM t WHERE "c" = a`,
+			opts:      []Option{WithColumns(isColumn)},
+			fallbacks: []string{`"b":String`, `"c":String`},
+		},
+	}
+
+	var walk func(c parsetree.Construction, fallbacks *[]string) error
+	walk = func(c parsetree.Construction, fallbacks *[]string) error {
+		switch c := c.(type) {
+		case parsetree.Error:
+			return c
+		case parsetree.Terminal:
+			if c.Fallback() != parsetree.FallbackNone {
+				*fallbacks = append(*fallbacks, string(c.Token().Lexeme)+":"+c.Fallback().String())
+			}
+		case parsetree.NonTerminal:
+			if c.Kind() == parsetree.KindSkipped {
+				return errors.New("skipped tokens")
+			}
+			for child := range c.Children {
+				if err := walk(child, fallbacks); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			p := New(lexer.New([]byte(c.code)), c.opts...)
+			parsed, _ := p.SQLStatement()
+
+			var fallbacks []string
+			if err := walk(parsed, &fallbacks); err != nil {
+				t.Fatalf("%q: %v", c.code, err)
+			}
+			if !slices.Equal(fallbacks, c.fallbacks) {
+				t.Errorf("%q: want fallbacks %q, got %q", c.code, c.fallbacks, fallbacks)
+			}
+		})
+	}
+}
+
 func TestAlterTable(t *testing.T) {
 	cases := testCases(
 		`ALTER TABLE table_a RENAME TO table_b`,
@@ -207,8 +368,8 @@ func TestAlterTable(t *testing.T) {
 
 func TestAlterTableError(t *testing.T) {
 	cases := []testCaseError{
-		{code: `ALTER TABLE RENAME TO table_b`, msg: "expecting Identifier, got Rename"},
-		{code: `ALTER TABLE schema_a. RENAME TO table_b`, msg: "expecting Identifier, got Rename"},
+		{code: `ALTER TABLE RENAME TO table_b`, msg: "expecting [Rename Add Drop], got To"},
+		{code: `ALTER TABLE schema_a. RENAME TO table_b`, msg: "expecting [Rename Add Drop], got To"},
 		{code: `ALTER TABLE table_a RENAME TO `, msg: "expecting Identifier, got EOF"},
 		{code: `ALTER TABLE table_a RENAME column_a TO `, msg: "expecting Identifier, got EOF"},
 		{code: `ALTER TABLE table_a 10 RENAME column_a TO `, msg: "expecting [Rename Add Drop], got Numeric"},
@@ -652,7 +813,7 @@ func TestIndexedColumn(t *testing.T) {
 
 func TestIndexedColumnError(t *testing.T) {
 	cases := []testCaseError{
-		{code: `column_name COLLATE ASC`, msg: "expecting Identifier, got Asc"},
+		{code: `column_name COLLATE ORDER`, msg: "expecting Identifier, got Order"},
 	}
 
 	fn := func(p *Parser) parsetree.NonTerminal {
@@ -891,7 +1052,7 @@ func TestCreateTrigger(t *testing.T) {
 func TestCreateTriggerError(t *testing.T) {
 	cases := []testCaseError{
 		{code: `CREATE TRIGGER trigger_name DELETE table_name BEGIN SELECT 10; END`, msg: "expecting On, got Identifier"},
-		{code: `CREATE TRIGGER trigger_name DELETE ON BEGIN SELECT 10; END`, msg: "expecting Identifier, got Begin"},
+		{code: `CREATE TRIGGER trigger_name DELETE ON BEGIN SELECT 10; END`, msg: "expecting Begin, got Select"},
 		{code: `CREATE TRIGGER trigger_name DELETE ON table_name BEGIN SELECT 10 END`, msg: "expecting Semicolon, got End"},
 		{code: `CREATE TRIGGER trigger_name DELETE ON table_name BEGIN SELECT 10; `, msg: "expecting [Delete Insert Select Update], got EOF"},
 		{code: `CREATE TRIGGER trigger_name DELETE ON table_name BEGIN ; END`, msg: "expecting [Delete Insert Select Update], got Semicolon"},
@@ -905,7 +1066,7 @@ func TestCreateTriggerError(t *testing.T) {
 		{code: `CREATE TRIGGER trigger_name UPDATE OF a b ON table_name BEGIN SELECT 10; END`, msg: "expecting On, got Identifier"},
 		//TODO: {code: `CREATE TRIGGER trigger_name INSTEAD OF UPDATE ON table_name WHEN BEGIN SELECT 10; END`, msg: ""},
 		{code: `CREATE TRIGGER trigger_name INSTEAD UPDATE ON table_name BEGIN SELECT 10; END`, msg: "expecting Of, got Update"},
-		{code: `CREATE TRIGGER INSTEAD OF UPDATE ON table_name BEGIN SELECT 10; END`, msg: "expecting Identifier, got Instead"},
+		{code: `CREATE TRIGGER INSTEAD OF UPDATE ON table_name BEGIN SELECT 10; END`, msg: "expecting [Delete Insert Update], got Of"},
 		{code: `CREATE TRIGGER trigger_name DELETE ON table_name`, msg: "expecting Begin, got EOF"},
 		{code: `CREATE TRIGGER trigger_name DELETE ON table_name BEGIN WITH cte AS (SELECT 10) ; END`, msg: "expecting [Delete Insert Select Update], got Semicolon"},
 		{code: `CREATE TRIGGER trigger_name DELETE ON table_name BEGIN WITH cte AS (SELECT 10) END`, msg: "expecting [Delete Insert Select Update], got End"},
@@ -2603,7 +2764,7 @@ func (c *comparator) compareTerminals(parsed, expected parsetree.Terminal) bool
 		tok = c.l.Next()
 		if tok.Kind == token.KindSQLComment || tok.Kind == token.KindCComment {
 			comments = append(comments, tok)
-		} else if tok.Kind != token.KindWhiteSpace {
+		} else if tok.Kind != token.KindWhiteSpace && tok.Kind != token.KindByteOrderMark {
 			break
 		}
 	}
@@ -2874,3 +3035,101 @@ func init() {
 	treeKinds["JoinOp"] = parsetree.KindJoinOperator
 	treeKinds["JoinConstr"] = parsetree.KindJoinConstraint
 }
+
+// benchmarkCode is a script used by the benchmarks of the parser.
+var benchmarkCode = strings.Repeat(`-- a comment
+WITH cte AS (SELECT 10) INSERT INTO table_name(name) VALUES('Go');
+UPDATE table_name SET a=10 /* another comment */;
+ALTER TABLE table_a ADD COLUMN column_b NOT NULL AS (10) VIRTUAL;
+CREATE TABLE table_a(column_a INTEGER PRIMARY KEY, column_b TEXT NOT NULL);
+DELETE FROM tableName;
+`, 20)
+
+// parseAll parses all the statements of p.
+func parseAll(p *Parser) (n int) {
+	for p.tok[0] == nil || p.tok[0].Kind != token.KindEOF {
+		p.SQLStatement()
+		n++
+	}
+	return
+}
+
+// TestArenaAllocs tests that a parser reused with an arena almost doesn't allocate.
+func TestArenaAllocs(t *testing.T) {
+	arena := parsetree.NewArena()
+	p := New(nil, WithArena(arena))
+	var statements int
+	allocs := testing.AllocsPerRun(10, func() {
+		p.Reset(lexer.New([]byte(benchmarkCode)))
+		statements = parseAll(p)
+		arena.Release()
+	})
+	if allocs/float64(statements) > 1 {
+		t.Errorf("%v allocations for %d statements", allocs, statements)
+	}
+}
+
+// TestArenaReleaseBetweenStatements tests that releasing the arena between the statements doesn't change the
+// statements not parsed yet, whose first tokens were already read by the parser.
+func TestArenaReleaseBetweenStatements(t *testing.T) {
+	code := "SELECT 1; -- two\nSELECT 2; /* three */ SELECT 3;"
+	arena := parsetree.NewArena()
+	p := New(lexer.New([]byte(code)), WithArena(arena))
+	want := []string{"SELECT 1 ;", "SELECT 2 ;", "SELECT 3 ;", ""}
+	wantComments := []string{"", "-- two", "/* three */", ""}
+	for i := range want {
+		tree, comments := p.SQLStatement()
+		var lexemes []string
+		var comment string
+		walkTerminals(tree, func(term parsetree.Terminal) {
+			if term.Token().Kind == token.KindEOF {
+				return
+			}
+			lexemes = append(lexemes, string(term.Token().Lexeme))
+			for _, c := range comments[term.Token()] {
+				comment += string(c.Lexeme)
+			}
+		})
+		if got := strings.Join(lexemes, " "); got != want[i] {
+			t.Errorf("statement %d: got %q, want %q", i+1, got, want[i])
+		}
+		if comment != wantComments[i] {
+			t.Errorf("statement %d: got comment %q, want %q", i+1, comment, wantComments[i])
+		}
+		arena.Release()
+	}
+}
+
+// walkTerminals calls f for the terminals of c, in order.
+func walkTerminals(c parsetree.Construction, f func(parsetree.Terminal)) {
+	switch c := c.(type) {
+	case parsetree.Terminal:
+		f(c)
+	case parsetree.NonTerminal:
+		c.Children(func(child parsetree.Construction) bool {
+			walkTerminals(child, f)
+			return true
+		})
+	}
+}
+
+func BenchmarkSQLStatement(b *testing.B) {
+	b.Run("heap", func(b *testing.B) {
+		b.SetBytes(int64(len(benchmarkCode)))
+		b.ReportAllocs()
+		for b.Loop() {
+			parseAll(New(lexer.New([]byte(benchmarkCode))))
+		}
+	})
+	b.Run("arena", func(b *testing.B) {
+		b.SetBytes(int64(len(benchmarkCode)))
+		b.ReportAllocs()
+		arena := parsetree.NewArena()
+		p := New(nil, WithArena(arena))
+		for b.Loop() {
+			p.Reset(lexer.New([]byte(benchmarkCode)))
+			parseAll(p)
+			arena.Release()
+		}
+	})
+}
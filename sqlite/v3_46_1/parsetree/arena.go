@@ -0,0 +1,112 @@
+package parsetree
+
+import (
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// arenaBlockSize is the number of values of each block of an Arena.
+const arenaBlockSize = 256
+
+// Arena allocates the nodes of parse trees, and the tokens of their terminals, in blocks that are reused after
+// Release. After some parses the blocks are enough for the trees and the allocation of nodes stops.
+//
+// An Arena is not safe for concurrent use.
+type Arena struct {
+	nonTerminals blocks[nonTerminal]
+	terminals    blocks[terminal]
+	tokens       blocks[token.Token]
+	// comments is the map of comments of the trees.
+	comments map[*token.Token][]*token.Token
+}
+
+// NewArena creates an Arena.
+func NewArena() *Arena {
+	return &Arena{comments: make(map[*token.Token][]*token.Token)}
+}
+
+// NewNonTerminal creates a NonTerminal in a. It is valid until a is released.
+func (a *Arena) NewNonTerminal(kind Kind) NonTerminal {
+	nt := a.nonTerminals.next()
+	nt.kind = kind
+	nt.children = nt.children[:0]
+	return nt
+}
+
+// NewTerminal creates a Terminal in a. It is valid until a is released.
+func (a *Arena) NewTerminal(kind Kind, tok *token.Token) Terminal {
+	t := a.terminals.next()
+	t.kind = kind
+	t.tok = tok
+	t.fallback = FallbackNone
+	return t
+}
+
+// NewFallbackTerminal creates in a a Terminal whose token was reinterpreted as indicated by fallback. It is valid until
+// a is released.
+func (a *Arena) NewFallbackTerminal(kind Kind, tok *token.Token, fallback Fallback) Terminal {
+	t := a.terminals.next()
+	t.kind = kind
+	t.tok = tok
+	t.fallback = fallback
+	return t
+}
+
+// NewToken creates a token in a. It is valid until a is released.
+func (a *Arena) NewToken() *token.Token {
+	tok := a.tokens.next()
+	*tok = token.Token{}
+	return tok
+}
+
+// Comments returns a map for the comments of the trees created in a. It is the same map until a is released, when it
+// is cleared.
+func (a *Arena) Comments() map[*token.Token][]*token.Token {
+	return a.comments
+}
+
+// Release releases all the values created in a, that must not be used anymore. Their memory will be reused.
+func (a *Arena) Release() {
+	a.nonTerminals.release(func(nt *nonTerminal) { clear(nt.children) })
+	a.terminals.release(func(t *terminal) { t.tok = nil })
+	a.tokens.release(func(tok *token.Token) { *tok = token.Token{} })
+	clear(a.comments)
+}
+
+// blocks are blocks of values of type T.
+type blocks[T any] struct {
+	// blocks are the blocks allocated, all with length arenaBlockSize.
+	blocks [][]T
+	// block is the position on blocks of the block of the next value.
+	block int
+	// pos is the position on the block of the next value.
+	pos int
+}
+
+// next returns the next value, allocating a block if necessary.
+func (b *blocks[T]) next() *T {
+	if b.pos == arenaBlockSize {
+		b.block++
+		b.pos = 0
+	}
+	if b.block == len(b.blocks) {
+		b.blocks = append(b.blocks, make([]T, arenaBlockSize))
+	}
+	v := &b.blocks[b.block][b.pos]
+	b.pos++
+	return v
+}
+
+// release calls reset for the values used, so they don't keep references, and makes all the values available.
+func (b *blocks[T]) release(reset func(*T)) {
+	for i := 0; i <= b.block && i < len(b.blocks); i++ {
+		n := arenaBlockSize
+		if i == b.block {
+			n = b.pos
+		}
+		for j := range n {
+			reset(&b.blocks[i][j])
+		}
+	}
+	b.block = 0
+	b.pos = 0
+}
@@ -0,0 +1,62 @@
+package parsetree
+
+import (
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+func TestArena(t *testing.T) {
+	a := NewArena()
+
+	var nts []NonTerminal
+	var ts []Terminal
+	for i := range arenaBlockSize + 10 {
+		nt := a.NewNonTerminal(KindSimpleSelect)
+		tok := a.NewToken()
+		tok.Lexeme, tok.Kind = []byte("SELECT"), token.KindSelect
+		var term Terminal
+		if i == 0 {
+			term = a.NewFallbackTerminal(KindColumnName, tok, FallbackKeyword)
+		} else {
+			term = a.NewTerminal(KindToken, tok)
+		}
+		nt.AddChild(term)
+		if i > 0 {
+			nts[i-1].AddChild(nt)
+		}
+		nts = append(nts, nt)
+		ts = append(ts, term)
+	}
+	a.Comments()[ts[0].Token()] = []*token.Token{token.New([]byte("-- c"), token.KindSQLComment)}
+
+	if nts[0].NumberOfChildren() != 2 || nts[len(nts)-1].NumberOfChildren() != 1 {
+		t.Errorf("wrong number of children")
+	}
+	if ts[0].Fallback() != FallbackKeyword {
+		t.Errorf("want fallback %s, got %s", FallbackKeyword, ts[0].Fallback())
+	}
+	if ts[len(ts)-1].Token().Kind != token.KindSelect {
+		t.Errorf("wrong token")
+	}
+
+	firstToken := ts[0].Token()
+	a.Release()
+
+	if len(a.Comments()) != 0 {
+		t.Errorf("the comments were not cleared")
+	}
+	nt := a.NewNonTerminal(KindDelete)
+	if nt != nts[0] {
+		t.Errorf("the memory was not reused")
+	}
+	if nt.Kind() != KindDelete || nt.NumberOfChildren() != 0 {
+		t.Errorf("the non terminal was not reset")
+	}
+	if tok := a.NewToken(); tok != firstToken || tok.Lexeme != nil || tok.Kind != nil {
+		t.Errorf("the token was not reset")
+	}
+	if term := a.NewTerminal(KindToken, nil); term != ts[0] || term.Token() != nil || term.Fallback() != FallbackNone {
+		t.Errorf("the terminal was not reset")
+	}
+}
@@ -2,6 +2,7 @@
 package parsetree
 
 import (
+	"slices"
 	"strconv"
 
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
@@ -194,6 +195,12 @@ func (k Kind) String() string {
 	return kindStrings[k]
 }
 
+// ParseKind returns the kind whose string representation is s. The ok result is false if there is no such kind.
+func ParseKind(s string) (k Kind, ok bool) {
+	i, found := slices.BinarySearch(kindStrings, s)
+	return Kind(i), found
+}
+
 // kindStrings contains the string representation of the kinds. Note that the value of a kind is the index of your string
 // representation.
 var kindStrings = []string{
@@ -278,6 +285,8 @@ type Terminal interface {
 	Construction
 	// Token returns the token of the terminal.
 	Token() *token.Token
+	// Fallback returns how the token was reinterpreted, if it was.
+	Fallback() Fallback
 }
 
 // NewLeaf creates a Terminal.
@@ -285,12 +294,50 @@ func NewTerminal(kind Kind, tok *token.Token) Terminal {
 	return &terminal{kind: kind, tok: tok}
 }
 
+// NewFallbackTerminal creates a Terminal whose token was reinterpreted as indicated by fallback.
+func NewFallbackTerminal(kind Kind, tok *token.Token, fallback Fallback) Terminal {
+	return &terminal{kind: kind, tok: tok, fallback: fallback}
+}
+
+// Fallback indicates how the token of a terminal was reinterpreted by the parser, following the keyword fallback and
+// the quote rule exceptions of SQLite. See lang_keywords.html.
+type Fallback int
+
+const (
+	// FallbackNone indicates that the token was not reinterpreted.
+	FallbackNone Fallback = iota
+	// FallbackKeyword indicates that a keyword was used as an identifier, like KEY in "CREATE TABLE t(key)".
+	FallbackKeyword
+	// FallbackIdentifier indicates that a string literal was used as an identifier, like 't' in "CREATE TABLE 't'(a)".
+	FallbackIdentifier
+	// FallbackString indicates that an identifier quoted with double quotes was used as a string literal, because it
+	// doesn't match a column.
+	FallbackString
+)
+
+// String returns a string representation of f.
+func (f Fallback) String() string {
+	switch f {
+	case FallbackNone:
+		return "None"
+	case FallbackKeyword:
+		return "Keyword"
+	case FallbackIdentifier:
+		return "Identifier"
+	case FallbackString:
+		return "String"
+	}
+	return strconv.Itoa(int(f))
+}
+
 // terminal is a terminal of a tree.
 type terminal struct {
 	// kind is the kind of the terminal.
 	kind Kind
 	// tok is the token of the leaf.
 	tok *token.Token
+	// fallback indicates how tok was reinterpreted.
+	fallback Fallback
 }
 
 // Kind returns the kind of the terminal.
@@ -303,6 +350,11 @@ func (t *terminal) Token() *token.Token {
 	return t.tok
 }
 
+// Fallback returns how the token of the terminal was reinterpreted.
+func (t *terminal) Fallback() Fallback {
+	return t.fallback
+}
+
 // Error is a error found in the parsing.
 type Error interface {
 	Construction
@@ -67,8 +67,49 @@ func TestKindString(t *testing.T) {
 	}
 }
 
+func TestParseKind(t *testing.T) {
+	cases := []struct {
+		str string
+		k   Kind
+		ok  bool
+	}{
+		{"Add", KindAdd, true}, {"FunctionName", KindFunctionName, true}, {"WithClause", KindWithClause, true},
+		{"Unknown", 0, false}, {"", 0, false},
+	}
+
+	for _, c := range cases {
+		k, ok := ParseKind(c.str)
+		if ok != c.ok || (ok && k != c.k) {
+			t.Errorf("ParseKind(%q) = %s, %t, want %s, %t", c.str, k, ok, c.k, c.ok)
+		}
+	}
+}
+
 func TestKindStringsIsSorted(t *testing.T) {
 	if !slices.IsSorted(kindStrings) {
 		t.Error("kindStrings isn't sorted")
 	}
 }
+
+func TestFallback(t *testing.T) {
+	tok := token.New([]byte("key"), token.KindKey)
+	if f := NewTerminal(KindColumnName, tok).Fallback(); f != FallbackNone {
+		t.Errorf("want %s, got %s", FallbackNone, f)
+	}
+	if f := NewFallbackTerminal(KindColumnName, tok, FallbackKeyword).Fallback(); f != FallbackKeyword {
+		t.Errorf("want %s, got %s", FallbackKeyword, f)
+	}
+
+	cases := []struct {
+		f   Fallback
+		str string
+	}{
+		{FallbackNone, "None"}, {FallbackKeyword, "Keyword"}, {FallbackIdentifier, "Identifier"},
+		{FallbackString, "String"}, {-1, "-1"},
+	}
+	for _, c := range cases {
+		if c.f.String() != c.str {
+			t.Errorf("want %q, got %q", c.str, c.f.String())
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package repl
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/internal/syntax"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Diagnostic is an error found in a statement.
+type Diagnostic struct {
+	// Offset is the offset on the statement of the token where the error was found.
+	Offset int
+	// Message describes the error.
+	Message string
+}
+
+// Diagnose parses the statement and returns the first syntax error, if any. A statement with only white spaces,
+// comments and a semicolon has no errors.
+func Diagnose(stmt []byte) (d Diagnostic, found bool) {
+	rec := syntax.NewRecorder(lexer.New(stmt))
+	tree, err := parse(rec)
+	rec.Drain()
+	if len(rec.Significant) == 0 || len(rec.Significant) == 1 && rec.Significant[0].Kind == token.KindSemicolon {
+		return Diagnostic{}, false
+	}
+
+	if err != nil {
+		if tok, ok := parser.ErrorToken(err); ok {
+			return Diagnostic{Offset: rec.Offsets[tok], Message: err.Error()}, true
+		}
+		return Diagnostic{Offset: len(stmt), Message: err.Error()}, true
+	}
+
+	w := &errorWalker{}
+	w.walk(tree)
+	if w.err != nil {
+		offset := len(stmt)
+		if w.next != nil {
+			offset = rec.Offsets[w.next]
+		}
+		return Diagnostic{Offset: offset, Message: w.err.Error()}, true
+	}
+	for _, tok := range rec.Significant {
+		if !w.parsed[tok] && tok.Kind != token.KindEOF {
+			return Diagnostic{Offset: rec.Offsets[tok], Message: fmt.Sprintf("unexpected %s", tok.Kind)}, true
+		}
+	}
+	return Diagnostic{}, false
+}
+
+// parse parses a statement, recovering from the panics of the parser.
+func parse(tp *syntax.Recorder) (tree parsetree.Construction, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok {
+				panic(r)
+			}
+			err = e
+		}
+	}()
+	tree, _ = parser.New(tp).SQLStatement()
+	return tree, nil
+}
+
+// errorWalker finds the first error of a parse tree and the terminal after it.
+type errorWalker struct {
+	// err is the first error or skipped tree found.
+	err error
+	// next is the token of the first terminal after err.
+	next *token.Token
+	// parsed are the tokens of the terminals.
+	parsed map[*token.Token]bool
+}
+
+// walk walks c in order.
+func (w *errorWalker) walk(c parsetree.Construction) {
+	if w.parsed == nil {
+		w.parsed = make(map[*token.Token]bool)
+	}
+	switch c := c.(type) {
+	case parsetree.Error:
+		if w.err == nil {
+			w.err = c
+		}
+	case parsetree.Terminal:
+		w.parsed[c.Token()] = true
+		if w.err != nil && w.next == nil {
+			w.next = c.Token()
+		}
+	case parsetree.NonTerminal:
+		if c.Kind() == parsetree.KindSkipped && w.err == nil {
+			c.Children(func(child parsetree.Construction) bool {
+				if t, ok := child.(parsetree.Terminal); ok {
+					w.err = fmt.Errorf("unexpected %s", t.Token().Kind)
+					return false
+				}
+				return true
+			})
+		}
+		c.Children(func(child parsetree.Construction) bool {
+			w.walk(child)
+			return true
+		})
+	}
+}
+
+// Caret returns the line of stmt that contains the offset and a line with a caret under the offset, followed by the
+// message. The tabs before the offset are kept, so the caret is aligned.
+func Caret(stmt []byte, d Diagnostic) string {
+	start := strings.LastIndexByte(string(stmt[:d.Offset]), '\n') + 1
+	end := strings.IndexByte(string(stmt[d.Offset:]), '\n')
+	if end < 0 {
+		end = len(stmt)
+	} else {
+		end += d.Offset
+	}
+
+	var b strings.Builder
+	b.Write(stmt[start:end])
+	b.WriteByte('\n')
+	for i := start; i < d.Offset; {
+		r, size := utf8.DecodeRune(stmt[i:])
+		if r == '\t' {
+			b.WriteByte('\t')
+		} else {
+			b.WriteByte(' ')
+		}
+		i += size
+	}
+	b.WriteString("^ ")
+	b.WriteString(d.Message)
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package repl
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestDiagnose(t *testing.T) {
+	cases := []struct {
+		stmt   string
+		found  bool
+		offset int
+	}{
+		{stmt: "SELECT 1;", found: false},
+		{stmt: " ;", found: false},
+		{stmt: "-- comment", found: false},
+		{stmt: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;", found: false},
+		{stmt: "SELEC 1;", found: true, offset: 0},
+		{stmt: "SELECT 1 2;", found: true, offset: 9},
+		{stmt: "SELECT * FORM t;", found: true, offset: 9},
+		{stmt: "DROP;", found: true, offset: 4},
+		{stmt: "DROP", found: true, offset: 4},
+		{stmt: "EXPLAIN QUERY ALTER TABLE t RENAME TO u;", found: true, offset: 14},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			d, found := Diagnose([]byte(c.stmt))
+			if found != c.found {
+				t.Fatalf("got found %t (%+v), want %t", found, d, c.found)
+			}
+			if found && d.Offset != c.offset {
+				t.Errorf("got offset %d (%s), want %d", d.Offset, d.Message, c.offset)
+			}
+			if found && d.Message == "" {
+				t.Error("empty message")
+			}
+		})
+	}
+}
+
+func TestCaret(t *testing.T) {
+	cases := []struct {
+		stmt string
+		d    Diagnostic
+		want string
+	}{
+		{stmt: "SELEC 1;", d: Diagnostic{Offset: 0, Message: "m"}, want: "SELEC 1;\n^ m"},
+		{stmt: "SELECT\n\t1 2;\n", d: Diagnostic{Offset: 10, Message: "m"}, want: "\t1 2;\n\t  ^ m"},
+		{stmt: "SELECT 'á' 2;", d: Diagnostic{Offset: 12, Message: "m"}, want: "SELECT 'á' 2;\n           ^ m"},
+		{stmt: "DROP", d: Diagnostic{Offset: 4, Message: "m"}, want: "DROP\n    ^ m"},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			if got := Caret([]byte(c.stmt), c.d); got != c.want {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/statement"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// The control keys handled by the editor.
+const (
+	keyCtrlA     = 0x01
+	keyCtrlB     = 0x02
+	keyCtrlC     = 0x03
+	keyCtrlD     = 0x04
+	keyCtrlE     = 0x05
+	keyCtrlF     = 0x06
+	keyBackspace = 0x08
+	keyCtrlK     = 0x0B
+	keyCtrlN     = 0x0E
+	keyCtrlP     = 0x10
+	keyCtrlU     = 0x15
+	keyEscape    = 0x1B
+	keyDelete    = 0x7F
+)
+
+// editor reads the input of the REPL. On a terminal in raw mode it edits the lines, highlighting them as they are typed,
+// and browses the history. In line mode it only reads the lines, since they were edited by the terminal.
+type editor struct {
+	in  *bufio.Reader
+	out io.Writer
+	// prompt is shown before the first line of an input and continuation before the others.
+	prompt, continuation string
+	highlighter          *Highlighter
+	history              *History
+	lineMode             bool
+	// lines are the lines of the input already entered.
+	lines []string
+	// line is the line being edited and cursor is the position of the cursor on it.
+	line   []rune
+	cursor int
+	// historyIndex is the index of the line of the history being shown, or history.Len() if it is the line being
+	// edited, that is kept in saved.
+	historyIndex int
+	saved        []rune
+	// eof reports whether the input ended.
+	eof bool
+	// cr reports whether the last key was a carriage return, so a following line feed is ignored.
+	cr bool
+}
+
+// readInput reads lines until they end a statement, and returns them joined by new lines. The lines with only white
+// spaces and comments are discarded if they don't start an input. If the input ends, or Ctrl-D is typed on an empty
+// input, the lines read are returned, if any, and io.EOF is returned in the next call.
+func (e *editor) readInput() (string, error) {
+	if e.eof {
+		return "", io.EOF
+	}
+	e.historyIndex = e.historyLen()
+	if e.lineMode {
+		return e.readLines()
+	}
+
+	e.render()
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return e.end(err)
+		}
+		if r == '\n' && e.cr {
+			e.cr = false
+			continue
+		}
+		e.cr = r == '\r'
+
+		switch r {
+		case '\r', '\n':
+			e.cursor = len(e.line)
+			e.render()
+			io.WriteString(e.out, "\r\n")
+			if input, ok := e.accept(string(e.line)); ok {
+				return input, nil
+			}
+		case keyCtrlC:
+			io.WriteString(e.out, "^C\r\n")
+			e.lines, e.line, e.cursor = nil, nil, 0
+			e.historyIndex = e.historyLen()
+		case keyCtrlD:
+			if len(e.lines) == 0 && len(e.line) == 0 {
+				io.WriteString(e.out, "\r\n")
+				return e.end(io.EOF)
+			}
+			e.deleteAt(e.cursor)
+		case keyBackspace, keyDelete:
+			if e.cursor > 0 {
+				e.cursor--
+				e.deleteAt(e.cursor)
+			}
+		case keyCtrlA:
+			e.cursor = 0
+		case keyCtrlE:
+			e.cursor = len(e.line)
+		case keyCtrlB:
+			e.cursor = max(e.cursor-1, 0)
+		case keyCtrlF:
+			e.cursor = min(e.cursor+1, len(e.line))
+		case keyCtrlK:
+			e.line = e.line[:e.cursor]
+		case keyCtrlU:
+			e.line = slices.Delete(e.line, 0, e.cursor)
+			e.cursor = 0
+		case keyCtrlP:
+			e.previous()
+		case keyCtrlN:
+			e.next()
+		case keyEscape:
+			e.escape()
+		default:
+			if r >= ' ' {
+				e.line = slices.Insert(e.line, e.cursor, r)
+				e.cursor++
+			}
+		}
+		e.render()
+	}
+}
+
+// readLines reads the input in line mode.
+func (e *editor) readLines() (string, error) {
+	for {
+		if len(e.lines) == 0 {
+			io.WriteString(e.out, e.prompt)
+		} else {
+			io.WriteString(e.out, e.continuation)
+		}
+		line, err := e.in.ReadString('\n')
+		if err != nil && line == "" {
+			return e.end(err)
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if input, ok := e.accept(line); ok {
+			return input, nil
+		}
+		if err != nil {
+			return e.end(err)
+		}
+	}
+}
+
+// accept adds line to the input. If the input ends a statement it is returned and the editor is ready for the next one.
+func (e *editor) accept(line string) (input string, ok bool) {
+	if e.history != nil {
+		e.history.Add(line)
+	}
+	e.lines = append(e.lines, line)
+	e.line, e.cursor = nil, 0
+	e.historyIndex = e.historyLen()
+
+	input = strings.Join(e.lines, "\n")
+	if statement.Complete([]byte(input)) {
+		e.lines = nil
+		return input, true
+	}
+	if blank(input) {
+		e.lines = nil
+	}
+	return "", false
+}
+
+// end ends the input because of err. The lines read are returned, if any, and the error is returned in the next call.
+func (e *editor) end(err error) (string, error) {
+	if err != io.EOF {
+		return "", err
+	}
+	e.eof = true
+	input := strings.Join(append(e.lines, string(e.line)), "\n")
+	e.lines, e.line, e.cursor = nil, nil, 0
+	if blank(input) {
+		return "", io.EOF
+	}
+	return input, nil
+}
+
+// escape handles the escape sequences of the arrows, home, end and delete keys. The others are ignored.
+func (e *editor) escape() {
+	r, _, err := e.in.ReadRune()
+	if err != nil || r != '[' && r != 'O' {
+		return
+	}
+	var param []rune
+	for {
+		r, _, err = e.in.ReadRune()
+		if err != nil {
+			return
+		}
+		if r < '0' || r > '9' {
+			break
+		}
+		param = append(param, r)
+	}
+
+	switch {
+	case r == 'A':
+		e.previous()
+	case r == 'B':
+		e.next()
+	case r == 'C':
+		e.cursor = min(e.cursor+1, len(e.line))
+	case r == 'D':
+		e.cursor = max(e.cursor-1, 0)
+	case r == 'H', r == '~' && (string(param) == "1" || string(param) == "7"):
+		e.cursor = 0
+	case r == 'F', r == '~' && (string(param) == "4" || string(param) == "8"):
+		e.cursor = len(e.line)
+	case r == '~' && string(param) == "3":
+		e.deleteAt(e.cursor)
+	}
+}
+
+// deleteAt deletes the rune at i, if any.
+func (e *editor) deleteAt(i int) {
+	if i < len(e.line) {
+		e.line = slices.Delete(e.line, i, i+1)
+	}
+}
+
+// previous shows the previous line of the history.
+func (e *editor) previous() {
+	if e.historyIndex == 0 {
+		return
+	}
+	if e.historyIndex == e.historyLen() {
+		e.saved = slices.Clone(e.line)
+	}
+	e.historyIndex--
+	e.line = []rune(e.history.At(e.historyIndex))
+	e.cursor = len(e.line)
+}
+
+// next shows the next line of the history, or the line being edited after the last one.
+func (e *editor) next() {
+	if e.historyIndex >= e.historyLen() {
+		return
+	}
+	e.historyIndex++
+	if e.historyIndex == e.historyLen() {
+		e.line = e.saved
+	} else {
+		e.line = []rune(e.history.At(e.historyIndex))
+	}
+	e.cursor = len(e.line)
+}
+
+// historyLen returns the number of lines of the history, that is zero if there is no history.
+func (e *editor) historyLen() int {
+	if e.history == nil {
+		return 0
+	}
+	return e.history.Len()
+}
+
+// render redraws the line being edited, highlighted in the context of the previous lines of the input, and puts the
+// cursor on its position.
+func (e *editor) render() {
+	prompt := e.prompt
+	if len(e.lines) > 0 {
+		prompt = e.continuation
+	}
+
+	var code strings.Builder
+	for _, line := range e.lines {
+		code.WriteString(line)
+		code.WriteByte('\n')
+	}
+	code.WriteString(string(e.line))
+	highlighted := string(e.highlighter.Highlight([]byte(code.String())))
+	highlighted = highlighted[strings.LastIndexByte(highlighted, '\n')+1:]
+
+	fmt.Fprintf(e.out, "\r%s%s\x1B[K", prompt, highlighted)
+	if e.cursor < len(e.line) {
+		io.WriteString(e.out, "\r")
+		if column := utf8.RuneCountInString(prompt) + e.cursor; column > 0 {
+			fmt.Fprintf(e.out, "\x1B[%dC", column)
+		}
+	}
+}
+
+// blank reports whether code has only white spaces and complete comments.
+func blank(code string) bool {
+	l := lexer.New([]byte(code))
+	for tok := l.Next(); tok.Kind != token.KindEOF; tok = l.Next() {
+		switch tok.Kind {
+		case token.KindWhiteSpace, token.KindByteOrderMark, token.KindSQLComment:
+		case token.KindCComment:
+			if len(tok.Lexeme) < 4 || !strings.HasSuffix(string(tok.Lexeme), "*/") {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
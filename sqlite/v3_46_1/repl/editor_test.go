@@ -0,0 +1,103 @@
+package repl
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEditor(t *testing.T) {
+	cases := []struct {
+		history []string
+		keys    string
+		want    []string
+	}{
+		{keys: "SELECT 1;\r", want: []string{"SELECT 1;"}},
+		{keys: "SELECT 1;\r\nSELECT 2;\n", want: []string{"SELECT 1;", "SELECT 2;"}},
+		{keys: "SELECT\r1;\r", want: []string{"SELECT\n1;"}},
+		{keys: "-- comment\r\rSELECT 1;\r", want: []string{"SELECT 1;"}},
+		{keys: "SELECT 12\x7F\x08\x08;\r", want: []string{"SELECT;"}},
+		{keys: "ELECT 1;\x01S\x05 \r", want: []string{"SELECT 1; "}},
+		{keys: "SELECT 1;\x02\x02\x02\x06X\r", want: []string{"SELECT X1;"}},
+		{keys: "SELECT 1;\x1B[D\x1B[D\x1B[C2\x1B[H\x1B[3~s\x1B[F\r", want: []string{"sELECT 12;"}},
+		{keys: "SELECT 1;\x1B[1~\x1B[3~\x1B[4~\x1BOH\x04s\r", want: []string{"sLECT 1;"}},
+		{keys: "DROP TABLE t;\x01\x06\x06\x0B;\x01\x15\x05\r", want: []string{"DR;"}},
+		{keys: "SELECT 1\x03SELECT 2;\r", want: []string{"SELECT 2;"}},
+		{keys: "SELECT\r1\x03SELECT 2;\r", want: []string{"SELECT 2;"}},
+		{keys: "SELECT 1;\r\x04SELECT 2;\r", want: []string{"SELECT 1;"}},
+		{keys: "SELECT\r1", want: []string{"SELECT\n1"}},
+		{keys: "SELECT\r\x041\x04", want: []string{"SELECT\n1"}},
+		{
+			keys: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN\r  SELECT 1;\rEND;\r",
+			want: []string{"CREATE TRIGGER tr AFTER INSERT ON t BEGIN\n  SELECT 1;\nEND;"},
+		},
+		{history: []string{"SELECT 1;", "SELECT 2;"}, keys: "\x1B[A\r", want: []string{"SELECT 2;"}},
+		{history: []string{"SELECT 1;", "SELECT 2;"}, keys: "\x1B[A\x1B[A\x1B[A\r", want: []string{"SELECT 1;"}},
+		{history: []string{"SELECT 1;", "SELECT 2;"}, keys: "\x10\x10\x0E\r", want: []string{"SELECT 2;"}},
+		{history: []string{"SELECT 1;"}, keys: "VACUUM\x10\x0E\x0E;\r", want: []string{"VACUUM;"}},
+		{keys: "SELECT\r\x1B[A 1;\r", want: []string{"SELECT\nSELECT 1;"}},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.FormatInt(int64(i), 10), func(t *testing.T) {
+			h := NewHistory(0)
+			for _, line := range c.history {
+				h.Add(line)
+			}
+			e := &editor{in: bufio.NewReader(strings.NewReader(c.keys)), out: io.Discard, history: h}
+			var got []string
+			for {
+				input, err := e.readInput()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+				got = append(got, input)
+			}
+			if !slices.Equal(got, c.want) {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEditorHistory(t *testing.T) {
+	h := NewHistory(0)
+	e := &editor{in: bufio.NewReader(strings.NewReader("SELECT\r1;\r\r-- c\rSELECT\r")), out: io.Discard, history: h}
+	for {
+		if _, err := e.readInput(); err != nil {
+			break
+		}
+	}
+	if got := lines(h); got != "SELECT 1; -- c SELECT" {
+		t.Errorf("got history %q, want %q", got, "SELECT 1; -- c SELECT")
+	}
+}
+
+func TestEditorRender(t *testing.T) {
+	var out strings.Builder
+	e := &editor{
+		in:           bufio.NewReader(strings.NewReader("SELECT\r1\x02")),
+		out:          &out,
+		prompt:       "> ",
+		continuation: ". ",
+	}
+	for {
+		if _, err := e.readInput(); err != nil {
+			break
+		}
+	}
+	want := "\r> \x1B[K" +
+		"\r> S\x1B[K\r> SE\x1B[K\r> SEL\x1B[K\r> SELE\x1B[K\r> SELEC\x1B[K\r> SELECT\x1B[K" +
+		"\r> SELECT\x1B[K\r\n" +
+		"\r. \x1B[K" +
+		"\r. 1\x1B[K" +
+		"\r. 1\x1B[K\r\x1B[2C"
+	if out.String() != want {
+		t.Errorf("got output %q, want %q", out.String(), want)
+	}
+}
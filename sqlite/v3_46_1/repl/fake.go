@@ -0,0 +1,31 @@
+package repl
+
+import (
+	"context"
+)
+
+// Fake is an in-memory Executor for tests. It records the statements executed and returns the results and errors set
+// for them. A statement without a result or an error set has an empty result.
+type Fake struct {
+	// Results maps the statements to their results.
+	Results map[string]*Result
+	// Errors maps the statements to the errors returned for them.
+	Errors map[string]error
+	// Executed are the statements executed, in order.
+	Executed []string
+}
+
+// Execute implements Executor.
+func (f *Fake) Execute(ctx context.Context, stmt string) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	f.Executed = append(f.Executed, stmt)
+	if err, ok := f.Errors[stmt]; ok {
+		return nil, err
+	}
+	if res, ok := f.Results[stmt]; ok {
+		return res, nil
+	}
+	return &Result{}, nil
+}
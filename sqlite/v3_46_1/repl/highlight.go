@@ -0,0 +1,93 @@
+package repl
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal/rgb"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/theme"
+)
+
+// Highlighter highlights SQL code with the colors of a theme.
+type Highlighter struct {
+	theme *theme.Theme
+	mode  rgb.Mode
+}
+
+// NewHighlighter creates a Highlighter that uses the colors of th in the color mode m.
+func NewHighlighter(th *theme.Theme, m rgb.Mode) *Highlighter {
+	return &Highlighter{theme: th, mode: m}
+}
+
+// Highlight returns code with the ANSI escape codes of the colors. The colors are reset before each new line, so each
+// line of the result can be printed alone. If h is nil, code is returned unchanged.
+func (h *Highlighter) Highlight(code []byte) []byte {
+	if h == nil {
+		return code
+	}
+	tr := lexical.Chain(splitLines{}, h.theme.Transformers(), rgb.NewTransformerMode(h.mode))
+	tp := lexical.NewTokenProvider(lexer.New(code), tr)
+	var b bytes.Buffer
+	for tok := tp.Next(); tok.Kind != token.KindEOF; tok = tp.Next() {
+		b.Write(tok.Lexeme)
+	}
+	return b.Bytes()
+}
+
+// splitLines is a lexical.Transformer that splits the tokens on the new lines. The new lines become tokens of kind
+// tokenKindNewLine, that are never colored.
+type splitLines struct{}
+
+// Transform implements lexical.Transformer.
+func (splitLines) Transform(tok *token.Token) []*token.Token {
+	if bytes.IndexByte(tok.Lexeme, '\n') < 0 {
+		return []*token.Token{tok}
+	}
+	var result []*token.Token
+	lexeme := tok.Lexeme
+	for {
+		i := bytes.IndexByte(lexeme, '\n')
+		if i < 0 {
+			break
+		}
+		if i > 0 {
+			result = append(result, token.New(lexeme[:i], tok.Kind))
+		}
+		result = append(result, token.New(lexeme[i:i+1], TokenKindNewLine))
+		lexeme = lexeme[i+1:]
+	}
+	if len(lexeme) > 0 {
+		result = append(result, token.New(lexeme, tok.Kind))
+	}
+	return result
+}
+
+// tokenKind is a type for token kinds speceific to this package.
+type tokenKind int
+
+var (
+	tokenKindNewLine            = tokenKind(0)
+	TokenKindNewLine token.Kind = &tokenKindNewLine
+)
+
+// String returns a string representation of k.
+func (k *tokenKind) String() string {
+	if *k < 0 || int(*k) >= len(tokenKindStrings) {
+		return strconv.Itoa(int(*k))
+	}
+	return tokenKindStrings[*k]
+}
+
+// IsKeyword reports whether this kind is of a keyword.
+func (k *tokenKind) IsKeyword() bool {
+	return false
+}
+
+// tokenKindStrings contains the string representation of the token kinds specific to this package.
+// Note that the value of a tokenKind is the index of your string representation.
+var tokenKindStrings = []string{
+	"NewLine",
+}
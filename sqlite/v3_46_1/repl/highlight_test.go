@@ -0,0 +1,27 @@
+package repl
+
+import (
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal/rgb"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/theme"
+)
+
+func TestHighlight(t *testing.T) {
+	code := "SELECT /* a\nb */ 'x\ny';"
+	got := string(NewHighlighter(theme.Dark(), rgb.Mode16).Highlight([]byte(code)))
+	want := "\x1b[90m\x1b[1mSELECT\x1b[0m \x1b[90m\x1b[3m/* a\x1b[0m\n\x1b[90m\x1b[3mb */\x1b[0m \x1b[90m'x\x1b[0m\n" +
+		"\x1b[90my'\x1b[0m\x1b[37m;\x1b[0m"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got := string(NewHighlighter(theme.Dark(), rgb.ModeNone).Highlight([]byte(code))); got != code {
+		t.Errorf("got %q in mode none, want %q", got, code)
+	}
+
+	var h *Highlighter
+	if got := string(h.Highlight([]byte(code))); got != code {
+		t.Errorf("got %q from a nil Highlighter, want %q", got, code)
+	}
+}
@@ -0,0 +1,66 @@
+package repl
+
+import (
+	"bufio"
+	"io"
+)
+
+// DefaultHistorySize is the number of lines kept by a History created with a size that isn't positive.
+const DefaultHistorySize = 1000
+
+// History is the history of the lines entered in the REPL. Like the sqlite3 shell, it has each line, not each
+// statement.
+type History struct {
+	lines []string
+	size  int
+}
+
+// NewHistory creates a History that keeps the last size lines. If size is not positive DefaultHistorySize is used.
+func NewHistory(size int) *History {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &History{size: size}
+}
+
+// Add adds line to the history, unless it is empty or equal to the last line.
+func (h *History) Add(line string) {
+	if line == "" || len(h.lines) > 0 && h.lines[len(h.lines)-1] == line {
+		return
+	}
+	if len(h.lines) == h.size {
+		h.lines = append(h.lines[:0], h.lines[1:]...)
+	}
+	h.lines = append(h.lines, line)
+}
+
+// Len returns the number of lines of the history.
+func (h *History) Len() int {
+	return len(h.lines)
+}
+
+// At returns the line i of the history. The oldest line is the line 0.
+func (h *History) At(i int) string {
+	return h.lines[i]
+}
+
+// ReadFrom adds the lines read from r to the history. It implements io.ReaderFrom.
+func (h *History) ReadFrom(r io.Reader) (n int64, err error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		n += int64(len(s.Bytes())) + 1
+		h.Add(s.Text())
+	}
+	return n, s.Err()
+}
+
+// WriteTo writes the lines of the history to w, one per line. It implements io.WriterTo.
+func (h *History) WriteTo(w io.Writer) (n int64, err error) {
+	bw := bufio.NewWriter(w)
+	for _, line := range h.lines {
+		m, _ := bw.WriteString(line)
+		bw.WriteByte('\n')
+		n += int64(m) + 1
+	}
+	return n, bw.Flush()
+}
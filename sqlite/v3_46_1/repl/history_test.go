@@ -0,0 +1,44 @@
+package repl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	h := NewHistory(3)
+	for _, line := range []string{"a", "", "b", "b", "c", "d"} {
+		h.Add(line)
+	}
+	if got := lines(h); got != "b c d" {
+		t.Errorf("got lines %q, want %q", got, "b c d")
+	}
+
+	var b strings.Builder
+	if _, err := h.WriteTo(&b); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if b.String() != "b\nc\nd\n" {
+		t.Errorf("got written %q, want %q", b.String(), "b\nc\nd\n")
+	}
+
+	h = NewHistory(0)
+	if _, err := h.ReadFrom(strings.NewReader("x\ny\ny\n\nz")); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if got := lines(h); got != "x y z" {
+		t.Errorf("got lines %q, want %q", got, "x y z")
+	}
+	if h.size != DefaultHistorySize {
+		t.Errorf("got size %d, want %d", h.size, DefaultHistorySize)
+	}
+}
+
+// lines returns the lines of h separated by spaces.
+func lines(h *History) string {
+	var ls []string
+	for i := range h.Len() {
+		ls = append(ls, h.At(i))
+	}
+	return strings.Join(ls, " ")
+}
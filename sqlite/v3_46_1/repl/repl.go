@@ -0,0 +1,156 @@
+// This package implements a read-eval-print loop for SQL. It reads statements with a line editor that highlights the
+// code as it is typed and continues the lines until the statement is complete, reports the syntax errors with a caret
+// under the token where they were found and, if it has an Executor, executes the statements.
+package repl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/statement"
+)
+
+// Executor executes the statements of a REPL.
+type Executor interface {
+	// Execute executes stmt, that has no syntax errors.
+	Execute(ctx context.Context, stmt string) (*Result, error)
+}
+
+// Result is the result of a statement.
+type Result struct {
+	// Columns are the names of the columns.
+	Columns []string
+	// Rows are the values of the rows, as text.
+	Rows [][]string
+}
+
+// REPL is a read-eval-print loop for SQL.
+type REPL struct {
+	editor   *editor
+	out      io.Writer
+	executor Executor
+}
+
+// Option is an option of a REPL.
+type Option func(*REPL)
+
+// WithExecutor makes the REPL execute the statements with ex. Without it the statements are only checked.
+func WithExecutor(ex Executor) Option {
+	return func(r *REPL) {
+		r.executor = ex
+	}
+}
+
+// WithHighlighter makes the REPL highlight the code with h.
+func WithHighlighter(h *Highlighter) Option {
+	return func(r *REPL) {
+		r.editor.highlighter = h
+	}
+}
+
+// WithHistory makes the REPL add the lines entered to h and browse them with the up and down keys.
+func WithHistory(h *History) Option {
+	return func(r *REPL) {
+		r.editor.history = h
+	}
+}
+
+// WithPrompts sets the prompt shown before the first line of a statement and the one shown before the others. They must
+// not have escape codes. The defaults are "mel> " and "...> ".
+func WithPrompts(prompt, continuation string) Option {
+	return func(r *REPL) {
+		r.editor.prompt = prompt
+		r.editor.continuation = continuation
+	}
+}
+
+// WithLineMode makes the REPL read the input line by line, as from a pipe or from a terminal that is not in raw mode.
+// The lines are neither edited nor highlighted, since it is done by the terminal.
+func WithLineMode() Option {
+	return func(r *REPL) {
+		r.editor.lineMode = true
+	}
+}
+
+// New creates a REPL that reads from in and writes to out. Unless WithLineMode is given, in must be a terminal in raw
+// mode, see MakeRaw, that still processes the output.
+func New(in io.Reader, out io.Writer, opts ...Option) *REPL {
+	r := &REPL{
+		editor: &editor{
+			in:           bufio.NewReader(in),
+			out:          out,
+			prompt:       "mel> ",
+			continuation: "...> ",
+		},
+		out: out,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run runs the loop until the input ends, Ctrl-D is typed on an empty line or ctx is done. The errors of the
+// statements are written to the output, so the error returned is of the input or of ctx.
+func (r *REPL) Run(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		input, err := r.editor.readInput()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		statements, rest := statement.Split([]byte(input))
+		if !blank(string(rest)) {
+			// the input ended before the statement.
+			statements = append(statements, rest)
+		}
+		for _, stmt := range statements {
+			r.eval(ctx, stmt)
+		}
+	}
+}
+
+// eval checks and executes stmt, printing the errors and the result.
+func (r *REPL) eval(ctx context.Context, stmt []byte) {
+	if blank(string(stmt)) {
+		return
+	}
+	if d, found := Diagnose(stmt); found {
+		fmt.Fprintf(r.out, "%s\n", Caret(stmt, d))
+		return
+	}
+	if r.executor == nil {
+		return
+	}
+
+	res, err := r.executor.Execute(ctx, strings.TrimSpace(string(stmt)))
+	if err != nil {
+		fmt.Fprintf(r.out, "Error: %s\n", err)
+		return
+	}
+	if res != nil {
+		r.print(res)
+	}
+}
+
+// print prints res as a table with a header.
+func (r *REPL) print(res *Result) {
+	if len(res.Columns) == 0 {
+		return
+	}
+	tw := tabwriter.NewWriter(r.out, 1, 1, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(res.Columns, "\t"))
+	for _, row := range res.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+}
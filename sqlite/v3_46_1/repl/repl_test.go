@@ -0,0 +1,74 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	fake := &Fake{
+		Results: map[string]*Result{
+			"SELECT a, b FROM t;": {Columns: []string{"a", "b"}, Rows: [][]string{{"1", "one"}, {"22", "two"}}},
+		},
+		Errors: map[string]error{"DROP TABLE u;": errors.New("no such table: u")},
+	}
+	in := "SELECT a, b FROM t;\n" +
+		"-- comment\n" +
+		"SELEC 1;\n" +
+		"DROP TABLE u; CREATE TRIGGER tr AFTER INSERT ON t BEGIN\n" +
+		"  SELECT 1;\n" +
+		"END;\n" +
+		"SELECT * FORM t;\n" +
+		"VACUUM"
+	var out strings.Builder
+	err := New(strings.NewReader(in), &out, WithLineMode(), WithPrompts("", ""), WithExecutor(fake)).Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	want := "a   b\n" +
+		"1   one\n" +
+		"22  two\n" +
+		"SELEC 1;\n" +
+		"^ unexpected Identifier\n" +
+		"Error: no such table: u\n" +
+		"SELECT * FORM t;\n" +
+		"         ^ unexpected Identifier\n"
+	if out.String() != want {
+		t.Errorf("got output\n%s\nwant\n%s", out.String(), want)
+	}
+
+	wantExecuted := []string{
+		"SELECT a, b FROM t;",
+		"DROP TABLE u;",
+		"CREATE TRIGGER tr AFTER INSERT ON t BEGIN\n  SELECT 1;\nEND;",
+		"VACUUM",
+	}
+	if !slices.Equal(fake.Executed, wantExecuted) {
+		t.Errorf("got executed %q, want %q", fake.Executed, wantExecuted)
+	}
+}
+
+func TestRunPrompts(t *testing.T) {
+	var out strings.Builder
+	err := New(strings.NewReader("SELECT\n1;\n"), &out, WithLineMode()).Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if want := "mel> ...> mel> "; out.String() != want {
+		t.Errorf("got output %q, want %q", out.String(), want)
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var out strings.Builder
+	err := New(strings.NewReader("SELECT 1;\n"), &out, WithLineMode()).Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !linux
+
+package repl
+
+import (
+	"errors"
+)
+
+// IsTerminal reports whether fd is a terminal. On this platform it always reports false, so the REPL is used in line
+// mode.
+func IsTerminal(fd int) bool {
+	return false
+}
+
+// MakeRaw puts the terminal fd in raw mode. It is not supported on this platform.
+func MakeRaw(fd int) (restore func() error, err error) {
+	return nil, errors.New("repl: raw mode is not supported on this platform")
+}
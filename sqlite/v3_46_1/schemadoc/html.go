@@ -0,0 +1,167 @@
+package schemadoc
+
+import (
+	"html"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	htmlcolor "github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/html"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/theme"
+)
+
+// style is the style sheet of the HTML documentation.
+const style = `body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+pre { background: #f8f8f8; padding: 1em; overflow-x: auto; }
+`
+
+// HTML returns the documentation of the schema of c as a HTML page. The DDL is highlighted with the theme of opts.
+func HTML(c *catalog.Catalog, opts Options) string {
+	p := newPage(c, opts)
+	th := opts.Theme
+	if th == nil {
+		th = theme.Light()
+	}
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>" + html.EscapeString(p.title) + "</title>\n")
+	b.WriteString("<style>\n" + style + "</style>\n</head>\n<body>\n")
+	b.WriteString("<h1>" + html.EscapeString(p.title) + "</h1>\n")
+	if len(p.tables) > 0 {
+		var links []string
+		for _, td := range p.tables {
+			links = append(links, htmlLink(catalog.ObjectTable, td.table.Name))
+		}
+		b.WriteString("<p>Tables: " + strings.Join(links, ", ") + "</p>\n")
+	}
+	if len(p.views) > 0 {
+		var links []string
+		for _, vd := range p.views {
+			links = append(links, htmlLink(catalog.ObjectView, vd.view.Name))
+		}
+		b.WriteString("<p>Views: " + strings.Join(links, ", ") + "</p>\n")
+	}
+
+	for _, td := range p.tables {
+		t := td.table
+		b.WriteString("<section id=\"" + anchor(catalog.ObjectTable, t.Name) + "\">\n")
+		b.WriteString("<h2>Table " + html.EscapeString(t.Name) + "</h2>\n")
+		htmlDescription(&b, t.Comment)
+		if len(t.Columns) > 0 {
+			b.WriteString("<table>\n<tr><th>Column</th><th>Type</th><th>Constraints</th><th>Description</th></tr>\n")
+			for _, col := range t.Columns {
+				htmlRow(&b, htmlText(col.Name), htmlText(col.Type), htmlCode(constraints(col)), htmlText(col.Comment))
+			}
+			b.WriteString("</table>\n")
+		}
+		if len(t.Constraints) > 0 {
+			b.WriteString("<p>Table constraints:</p>\n<ul>\n")
+			for _, cons := range t.Constraints {
+				b.WriteString("<li>" + htmlCode(cons.SQL()) + "</li>\n")
+			}
+			b.WriteString("</ul>\n")
+		}
+		if len(td.indexes) > 0 {
+			b.WriteString("<h3>Indexes</h3>\n<table>\n<tr><th>Index</th><th>Definition</th><th>Description</th></tr>\n")
+			for _, i := range td.indexes {
+				htmlRow(&b, htmlText(i.Name), htmlCode(i.SQL()), htmlText(i.Comment))
+			}
+			b.WriteString("</table>\n")
+		}
+		htmlTriggers(&b, td.triggers)
+		if len(td.views) > 0 {
+			b.WriteString("<h3>Views</h3>\n<ul>\n")
+			for _, v := range td.views {
+				b.WriteString("<li>" + htmlLink(catalog.ObjectView, v.Name) + "</li>\n")
+			}
+			b.WriteString("</ul>\n")
+		}
+		htmlDefinition(&b, td.definition(), th)
+		b.WriteString("</section>\n")
+	}
+
+	for _, vd := range p.views {
+		v := vd.view
+		b.WriteString("<section id=\"" + anchor(catalog.ObjectView, v.Name) + "\">\n")
+		b.WriteString("<h2>View " + html.EscapeString(v.Name) + "</h2>\n")
+		htmlDescription(&b, v.Comment)
+		if len(vd.reads) > 0 {
+			var links []string
+			for _, o := range vd.reads {
+				links = append(links, htmlLink(o.Kind, o.Name))
+			}
+			b.WriteString("<p>Reads: " + strings.Join(links, ", ") + "</p>\n")
+		}
+		htmlTriggers(&b, vd.triggers)
+		htmlDefinition(&b, vd.definition(), th)
+		b.WriteString("</section>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// htmlDescription writes the comment of an object as a paragraph, if it is not empty.
+func htmlDescription(b *strings.Builder, comment string) {
+	if comment != "" {
+		b.WriteString("<p>" + htmlText(comment) + "</p>\n")
+	}
+}
+
+// htmlTriggers writes the table of the triggers, if there are any.
+func htmlTriggers(b *strings.Builder, triggers []*catalog.Trigger) {
+	if len(triggers) == 0 {
+		return
+	}
+	b.WriteString("<h3>Triggers</h3>\n<table>\n<tr><th>Trigger</th><th>Event</th><th>Description</th></tr>\n")
+	for _, t := range triggers {
+		htmlRow(b, htmlText(t.Name), htmlText(event(t)), htmlText(t.Comment))
+	}
+	b.WriteString("</table>\n")
+}
+
+// htmlDefinition writes the DDL highlighted with th.
+func htmlDefinition(b *strings.Builder, ddl string, th *theme.Theme) {
+	b.WriteString("<h3>Definition</h3>\n<pre><code>" + highlight(ddl, th) + "</code></pre>\n")
+}
+
+// highlight returns code in HTML, highlighted with th.
+func highlight(code string, th *theme.Theme) string {
+	tr := lexical.Chain(th.Transformers(), htmlcolor.NewTransformer())
+	tp := lexical.NewTokenProvider(lexer.New([]byte(code)), tr)
+	var b strings.Builder
+	for tok := tp.Next(); tok.Kind != token.KindEOF; tok = tp.Next() {
+		b.Write(tok.Lexeme)
+	}
+	return b.String()
+}
+
+// htmlRow writes a row of a table whose cells are already in HTML.
+func htmlRow(b *strings.Builder, cells ...string) {
+	b.WriteString("<tr>")
+	for _, cell := range cells {
+		b.WriteString("<td>" + cell + "</td>")
+	}
+	b.WriteString("</tr>\n")
+}
+
+// htmlText returns s escaped, with the new lines as line breaks.
+func htmlText(s string) string {
+	return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+}
+
+// htmlCode returns s escaped in a code element, or the empty string if s is empty.
+func htmlCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "<code>" + html.EscapeString(s) + "</code>"
+}
+
+// htmlLink returns a link to the section of the object of the given kind.
+func htmlLink(k catalog.ObjectKind, name string) string {
+	return "<a href=\"#" + anchor(k, name) + "\">" + html.EscapeString(name) + "</a>"
+}
@@ -0,0 +1,50 @@
+package schemadoc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/theme"
+)
+
+// TestHTML tests the documentation in HTML.
+func TestHTML(t *testing.T) {
+	got := HTML(load(t, schema), Options{Title: "A & B"})
+	wants := []string{
+		"<title>A &amp; B</title>",
+		`<p>Tables: <a href="#table-users">users</a></p>`,
+		`<section id="table-users">`,
+		"<p>The users<br>of the system.</p>",
+		"<tr><td>email</td><td>TEXT</td><td><code>NOT NULL UNIQUE</code></td><td>the *e-mail*, | unique</td></tr>",
+		"<li><code>CHECK (email LIKE &#39;%@%&#39;)</code></li>",
+		"<tr><td>users_email</td><td><code>CREATE INDEX users_email ON users(lower(email))</code></td><td>by e-mail</td></tr>",
+		"<tr><td>users_touch</td><td>AFTER UPDATE OF email</td><td></td></tr>",
+		`<li><a href="#view-emails">emails</a></li>`,
+		`<pre><code><span style="color:#0000ff;font-weight:bold">CREATE</span> <span style="color:#0000ff;font-weight:bold">TABLE</span>`,
+		`<span style="color:#a31515">&#39;%@%&#39;</span>`,
+		`<section id="view-emails">`,
+		`<p>Reads: <a href="#table-users">users</a></p>`,
+		"<tr><td>emails_insert</td><td>INSTEAD OF INSERT</td><td></td></tr>",
+	}
+	for _, want := range wants {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q not in\n%s", want, got)
+		}
+	}
+	if !strings.HasSuffix(got, "</body>\n</html>\n") {
+		t.Errorf("the page is not closed")
+	}
+}
+
+// TestHTMLTheme tests the theme of the DDL.
+func TestHTMLTheme(t *testing.T) {
+	th := theme.Dark()
+	c := load(t, "CREATE TABLE t(a);")
+	got := HTML(c, Options{Theme: th})
+	if want := highlight(c.Table("t").SQL()+";", th); !strings.Contains(got, want) || strings.Contains(got, "#0000ff") {
+		t.Errorf("the DDL is not highlighted with the theme:\n%s", got)
+	}
+	if got := HTML(load(t, ""), Options{}); strings.Contains(got, "<section") || strings.Contains(got, "Tables:") {
+		t.Errorf("unexpected sections:\n%s", got)
+	}
+}
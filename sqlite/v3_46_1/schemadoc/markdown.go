@@ -0,0 +1,145 @@
+package schemadoc
+
+import (
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// Markdown returns the documentation of the schema of c in Markdown, as a single page.
+func Markdown(c *catalog.Catalog, opts Options) string {
+	p := newPage(c, opts)
+	var b strings.Builder
+	b.WriteString("# " + markdownText(p.title) + "\n")
+	if len(p.tables) > 0 || len(p.views) > 0 {
+		b.WriteString("\n")
+	}
+	if len(p.tables) > 0 {
+		var links []string
+		for _, td := range p.tables {
+			links = append(links, markdownLink(catalog.ObjectTable, td.table.Name))
+		}
+		b.WriteString("- Tables: " + strings.Join(links, ", ") + "\n")
+	}
+	if len(p.views) > 0 {
+		var links []string
+		for _, vd := range p.views {
+			links = append(links, markdownLink(catalog.ObjectView, vd.view.Name))
+		}
+		b.WriteString("- Views: " + strings.Join(links, ", ") + "\n")
+	}
+
+	for _, td := range p.tables {
+		t := td.table
+		b.WriteString("\n## Table " + markdownText(t.Name) + "\n")
+		markdownDescription(&b, t.Comment)
+		if len(t.Columns) > 0 {
+			b.WriteString("\n| Column | Type | Constraints | Description |\n| --- | --- | --- | --- |\n")
+			for _, col := range t.Columns {
+				markdownRow(&b, markdownText(col.Name), markdownText(col.Type), markdownCode(constraints(col)), markdownText(col.Comment))
+			}
+		}
+		if len(t.Constraints) > 0 {
+			b.WriteString("\nTable constraints:\n\n")
+			for _, cons := range t.Constraints {
+				b.WriteString("- " + markdownCode(cons.SQL()) + "\n")
+			}
+		}
+		if len(td.indexes) > 0 {
+			b.WriteString("\n### Indexes\n\n| Index | Definition | Description |\n| --- | --- | --- |\n")
+			for _, i := range td.indexes {
+				markdownRow(&b, markdownText(i.Name), markdownCode(i.SQL()), markdownText(i.Comment))
+			}
+		}
+		markdownTriggers(&b, td.triggers)
+		if len(td.views) > 0 {
+			b.WriteString("\n### Views\n\n")
+			for _, v := range td.views {
+				b.WriteString("- " + markdownLink(catalog.ObjectView, v.Name) + "\n")
+			}
+		}
+		markdownDefinition(&b, td.definition())
+	}
+
+	for _, vd := range p.views {
+		v := vd.view
+		b.WriteString("\n## View " + markdownText(v.Name) + "\n")
+		markdownDescription(&b, v.Comment)
+		if len(vd.reads) > 0 {
+			var links []string
+			for _, o := range vd.reads {
+				links = append(links, markdownLink(o.Kind, o.Name))
+			}
+			b.WriteString("\nReads: " + strings.Join(links, ", ") + "\n")
+		}
+		markdownTriggers(&b, vd.triggers)
+		markdownDefinition(&b, vd.definition())
+	}
+	return b.String()
+}
+
+// markdownDescription writes the comment of an object as a paragraph, if it is not empty.
+func markdownDescription(b *strings.Builder, comment string) {
+	if comment != "" {
+		b.WriteString("\n" + markdownText(comment) + "\n")
+	}
+}
+
+// markdownTriggers writes the table of the triggers, if there are any.
+func markdownTriggers(b *strings.Builder, triggers []*catalog.Trigger) {
+	if len(triggers) == 0 {
+		return
+	}
+	b.WriteString("\n### Triggers\n\n| Trigger | Event | Description |\n| --- | --- | --- |\n")
+	for _, t := range triggers {
+		markdownRow(b, markdownText(t.Name), markdownText(event(t)), markdownText(t.Comment))
+	}
+}
+
+// markdownDefinition writes the DDL in a code block of SQL.
+func markdownDefinition(b *strings.Builder, ddl string) {
+	fence := "```"
+	for strings.Contains(ddl, fence) {
+		fence += "`"
+	}
+	b.WriteString("\n### Definition\n\n" + fence + "sql\n" + ddl + "\n" + fence + "\n")
+}
+
+// markdownRow writes a row of a table whose cells are already in Markdown.
+func markdownRow(b *strings.Builder, cells ...string) {
+	b.WriteString("|")
+	for _, cell := range cells {
+		b.WriteString(" " + strings.NewReplacer("|", `\|`, "\n", "<br>").Replace(cell) + " |")
+	}
+	b.WriteString("\n")
+}
+
+// markdownText returns s with the characters that start the formatting of Markdown, or HTML, escaped.
+func markdownText(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// markdownEscaper escapes the characters of markdownText.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "#", `\#`, "<", "&lt;", ">", "&gt;", "&", "&amp;",
+)
+
+// markdownCode returns s as a code span, or the empty string if s is empty.
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if len(fence) > 1 {
+		return fence + " " + s + " " + fence
+	}
+	return fence + s + fence
+}
+
+// markdownLink returns a link to the section of the object of the given kind.
+func markdownLink(k catalog.ObjectKind, name string) string {
+	return "[" + markdownText(name) + "](#" + anchor(k, name) + ")"
+}
@@ -0,0 +1,99 @@
+package schemadoc
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestMarkdown tests the documentation in Markdown.
+func TestMarkdown(t *testing.T) {
+	got := Markdown(load(t, schema), Options{})
+	want := "# Schema\n" + `
+- Tables: [users](#table-users)
+- Views: [emails](#view-emails)
+
+## Table users
+
+The users
+of the system.
+
+| Column | Type | Constraints | Description |
+| --- | --- | --- | --- |
+| id | INTEGER | ` + "`PRIMARY KEY`" + ` | the identifier |
+| email | TEXT | ` + "`NOT NULL UNIQUE`" + ` | the \*e-mail\*, \| unique |
+
+Table constraints:
+
+- ` + "`CHECK (email LIKE '%@%')`" + `
+
+### Indexes
+
+| Index | Definition | Description |
+| --- | --- | --- |
+| users\_email | ` + "`CREATE INDEX users_email ON users(lower(email))`" + ` | by e-mail |
+
+### Triggers
+
+| Trigger | Event | Description |
+| --- | --- | --- |
+| users\_touch | AFTER UPDATE OF email |  |
+
+### Views
+
+- [emails](#view-emails)
+
+### Definition
+
+` + "```sql" + `
+CREATE TABLE users(
+  id INTEGER PRIMARY KEY,
+  email TEXT NOT NULL UNIQUE,
+  CHECK (email LIKE '%@%')
+);
+
+CREATE INDEX users_email ON users(lower(email));
+
+CREATE TRIGGER users_touch AFTER UPDATE OF email ON users BEGIN
+  SELECT 1;
+END;
+` + "```" + `
+
+## View emails
+
+the e-mails
+
+Reads: [users](#table-users)
+
+### Triggers
+
+| Trigger | Event | Description |
+| --- | --- | --- |
+| emails\_insert | INSTEAD OF INSERT |  |
+
+### Definition
+
+` + "```sql" + `
+CREATE VIEW emails AS SELECT email FROM users;
+
+CREATE TRIGGER emails_insert INSTEAD OF INSERT ON emails BEGIN
+  INSERT INTO users(email) VALUES (new.email);
+END;
+` + "```" + `
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestMarkdownCode tests the code that has backticks.
+func TestMarkdownCode(t *testing.T) {
+	got := Markdown(load(t, "CREATE TABLE `a``b`(x DEFAULT '```');"), Options{Title: "The *title*"})
+	for _, want := range []string{"# The \\*title\\*\n", "## Table a\\`b\n", "| x |  | ```` DEFAULT '```' ```` |  |", "````sql\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q not in\n%s", want, got)
+		}
+	}
+	if got := markdownCode(""); got != "" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,128 @@
+// This package generates the documentation of the schema of a catalog, in Markdown and in HTML. The descriptions of the
+// tables, columns, indexes, triggers and views are the comments kept by the catalog, that is, the comments before the
+// CREATE statements and before or after the definitions of the columns. Each table is documented with your columns,
+// constraints, indexes and triggers, the views that read it and your DDL.
+package schemadoc
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/theme"
+)
+
+// Options are the options of the documentation.
+type Options struct {
+	// Title is the title of the documentation. If it is empty, "Schema" is used.
+	Title string
+	// Theme is the theme that highlights the DDL in HTML. If it is nil, the light theme is used. In Markdown the DDL is
+	// in code blocks of SQL, highlighted by the renderer.
+	Theme *theme.Theme
+}
+
+// page is the documentation of a catalog.
+type page struct {
+	title  string
+	tables []*tableDoc
+	views  []*viewDoc
+}
+
+// tableDoc is the documentation of a table.
+type tableDoc struct {
+	table    *catalog.Table
+	indexes  []*catalog.Index
+	triggers []*catalog.Trigger
+	// views are the views that read the table.
+	views []*catalog.View
+}
+
+// viewDoc is the documentation of a view.
+type viewDoc struct {
+	view     *catalog.View
+	triggers []*catalog.Trigger
+	// reads are the tables and views that the view reads.
+	reads []catalog.Object
+}
+
+// newPage creates the documentation of c. The objects are in the order of c.
+func newPage(c *catalog.Catalog, opts Options) *page {
+	p := &page{title: opts.Title}
+	if p.title == "" {
+		p.title = "Schema"
+	}
+	g := c.Graph()
+	for _, t := range c.Tables {
+		schema := t.SchemaName()
+		td := &tableDoc{table: t, indexes: c.IndexesOf(schema, t.Name), triggers: c.TriggersOf(schema, t.Name)}
+		for _, d := range g.Dependents(catalog.Object{Kind: catalog.ObjectTable, Schema: schema, Name: t.Name}) {
+			if d.Object.Kind == catalog.ObjectView {
+				td.views = append(td.views, c.ViewIn(d.Object.SchemaName(), d.Object.Name))
+			}
+		}
+		p.tables = append(p.tables, td)
+	}
+	for _, v := range c.Views {
+		vd := &viewDoc{view: v, triggers: c.TriggersOf(v.SchemaName(), v.Name)}
+		for _, d := range g.Dependencies(catalog.Object{Kind: catalog.ObjectView, Schema: v.SchemaName(), Name: v.Name}) {
+			vd.reads = append(vd.reads, d.On)
+		}
+		p.views = append(p.views, vd)
+	}
+	return p
+}
+
+// constraints returns the constraints of col, as in a CREATE TABLE statement.
+func constraints(col *catalog.Column) string {
+	var cs []string
+	for _, cons := range col.Constraints {
+		cs = append(cs, cons.SQL())
+	}
+	return strings.Join(cs, " ")
+}
+
+// event returns the time and the event of t, like "AFTER UPDATE OF a, b".
+func event(t *catalog.Trigger) string {
+	s := strings.TrimSpace(t.Time + " " + t.Event)
+	if len(t.Columns) > 0 {
+		s += " OF " + strings.Join(t.Columns, ", ")
+	}
+	return s
+}
+
+// definition returns the DDL of a table, with your indexes and triggers.
+func (td *tableDoc) definition() string {
+	stmts := []string{td.table.SQL()}
+	for _, i := range td.indexes {
+		stmts = append(stmts, i.SQL())
+	}
+	for _, t := range td.triggers {
+		stmts = append(stmts, t.SQL())
+	}
+	return strings.Join(stmts, ";\n\n") + ";"
+}
+
+// definition returns the DDL of a view, with your triggers.
+func (vd *viewDoc) definition() string {
+	stmts := []string{vd.view.SQL()}
+	for _, t := range vd.triggers {
+		stmts = append(stmts, t.SQL())
+	}
+	return strings.Join(stmts, ";\n\n") + ";"
+}
+
+// anchor returns the identifier of the section of the object of the given kind, like "table-users". It is the one that
+// GitHub generates for the heading "Table users": the letters in lower case, the digits, the hyphens and the
+// underscores are kept and the spaces become hyphens.
+func anchor(k catalog.ObjectKind, name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(k.String() + " " + name) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package schemadoc
+
+import (
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/catalog"
+)
+
+// schema is the schema of the tests.
+const schema = `-- The users
+-- of the system.
+CREATE TABLE users(
+  id INTEGER PRIMARY KEY, -- the identifier
+  -- the *e-mail*, | unique
+  email TEXT NOT NULL UNIQUE,
+  CHECK (email LIKE '%@%')
+);
+-- by e-mail
+CREATE INDEX users_email ON users(lower(email));
+CREATE TRIGGER users_touch AFTER UPDATE OF email ON users BEGIN SELECT 1; END;
+-- the e-mails
+CREATE VIEW emails AS SELECT email FROM users;
+CREATE TRIGGER emails_insert INSTEAD OF INSERT ON emails BEGIN INSERT INTO users(email) VALUES (new.email); END;
+`
+
+// load loads code in a catalog, failing the test on error.
+func load(t *testing.T, code string) *catalog.Catalog {
+	t.Helper()
+	c, err := catalog.Load([]byte(code))
+	if err != nil {
+		t.Fatalf("Load(%q): %v", code, err)
+	}
+	return c
+}
+
+// TestNewPage tests the objects of the documentation.
+func TestNewPage(t *testing.T) {
+	p := newPage(load(t, schema), Options{})
+	if p.title != "Schema" || len(p.tables) != 1 || len(p.views) != 1 {
+		t.Fatalf("unexpected page %+v", p)
+	}
+	td := p.tables[0]
+	if len(td.indexes) != 1 || len(td.triggers) != 1 || len(td.views) != 1 || td.views[0].Name != "emails" {
+		t.Errorf("unexpected table %+v", td)
+	}
+	vd := p.views[0]
+	if len(vd.triggers) != 1 || len(vd.reads) != 1 || vd.reads[0].Name != "users" {
+		t.Errorf("unexpected view %+v", vd)
+	}
+	if got := event(td.triggers[0]); got != "AFTER UPDATE OF email" {
+		t.Errorf("got event %q", got)
+	}
+	if got := event(vd.triggers[0]); got != "INSTEAD OF INSERT" {
+		t.Errorf("got event %q", got)
+	}
+}
+
+// TestAnchor tests anchor.
+func TestAnchor(t *testing.T) {
+	cases := []struct {
+		kind catalog.ObjectKind
+		name string
+		want string
+	}{
+		{catalog.ObjectTable, "users", "table-users"},
+		{catalog.ObjectView, "Order Items", "view-order-items"},
+		{catalog.ObjectTable, "a.b_c-d!", "table-ab_c-d"},
+		{catalog.ObjectTable, "ação", "table-ação"},
+	}
+	for _, c := range cases {
+		if got := anchor(c.kind, c.name); got != c.want {
+			t.Errorf("anchor(%s, %q) = %q, want %q", c.kind, c.name, got, c.want)
+		}
+	}
+}
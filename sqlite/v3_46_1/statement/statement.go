@@ -0,0 +1,158 @@
+// This package decides where the SQL statements end, like the function sqlite3_complete of SQLite. A statement ends on
+// a semicolon that is not inside a string, an identifier, a comment or the body of a CREATE TRIGGER statement.
+package statement
+
+import (
+	"bytes"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// state is a state of the state machine of sqlite3_complete.
+type state int
+
+const (
+	// stateInvalid is the state before any token, except white spaces and comments.
+	stateInvalid state = iota
+	// stateStart is the state after a statement ends.
+	stateStart
+	// stateNormal is the state inside a statement that is not a CREATE TRIGGER.
+	stateNormal
+	// stateExplain is the state after EXPLAIN at the start of a statement.
+	stateExplain
+	// stateCreate is the state after CREATE at the start of a statement.
+	stateCreate
+	// stateTrigger is the state inside a CREATE TRIGGER statement.
+	stateTrigger
+	// stateSemi is the state after a semicolon inside a CREATE TRIGGER statement.
+	stateSemi
+	// stateEnd is the state after a semicolon and END inside a CREATE TRIGGER statement.
+	stateEnd
+)
+
+// class is the class of a token for the state machine of sqlite3_complete.
+type class int
+
+const (
+	classSemi class = iota
+	classWhiteSpace
+	classOther
+	classExplain
+	classCreate
+	classTemp
+	classTrigger
+	classEnd
+)
+
+// transitions is the table of transitions of the state machine, indexed by the state and by the class of the token.
+var transitions = [...][8]state{
+	stateInvalid: {stateStart, stateInvalid, stateNormal, stateExplain, stateCreate, stateNormal, stateNormal, stateNormal},
+	stateStart:   {stateStart, stateStart, stateNormal, stateExplain, stateCreate, stateNormal, stateNormal, stateNormal},
+	stateNormal:  {stateStart, stateNormal, stateNormal, stateNormal, stateNormal, stateNormal, stateNormal, stateNormal},
+	stateExplain: {stateStart, stateExplain, stateExplain, stateNormal, stateCreate, stateNormal, stateNormal, stateNormal},
+	stateCreate:  {stateStart, stateCreate, stateNormal, stateNormal, stateNormal, stateCreate, stateTrigger, stateNormal},
+	stateTrigger: {stateSemi, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateTrigger},
+	stateSemi:    {stateSemi, stateSemi, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateEnd},
+	stateEnd:     {stateStart, stateEnd, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateTrigger, stateTrigger},
+}
+
+// classOf returns the class of the token of kind k.
+func classOf(k token.Kind) class {
+	switch k {
+	case token.KindSemicolon:
+		return classSemi
+	case token.KindWhiteSpace, token.KindByteOrderMark, token.KindSQLComment, token.KindCComment:
+		return classWhiteSpace
+	case token.KindExplain:
+		return classExplain
+	case token.KindCreate:
+		return classCreate
+	case token.KindTemp, token.KindTemporary:
+		return classTemp
+	case token.KindTrigger:
+		return classTrigger
+	case token.KindEnd:
+		return classEnd
+	default:
+		return classOther
+	}
+}
+
+// scan runs the state machine on code and calls end, if it is not nil, with the offset after each semicolon that ends
+// a statement, stopping if end returns false. It returns the final state and whether code ends inside a string, an
+// identifier, a blob or a comment.
+func scan(code []byte, end func(offset int) bool) (s state, unterminated bool) {
+	var (
+		base int
+		tok  token.Token
+	)
+	l := lexer.New(code, lexer.WithEncoding(lexer.EncodingUTF8))
+	for {
+		offset := base + int(l.Offset())
+		l.NextInto(&tok)
+		switch tok.Kind {
+		case token.KindEOF:
+			return s, false
+		case token.KindErrorUnexpectedEOF:
+			return s, true
+		case token.KindCComment:
+			if len(tok.Lexeme) < 4 || !bytes.HasSuffix(tok.Lexeme, []byte("*/")) {
+				return s, true
+			}
+		case token.KindErrorBlobNotHexadecimal:
+			// like SQLite, the blob goes until the closing quote.
+			i := bytes.IndexByte(code[offset+len(tok.Lexeme):], '\'')
+			if i < 0 {
+				return s, true
+			}
+			base = offset + len(tok.Lexeme) + i + 1
+			l = lexer.New(code[base:], lexer.WithEncoding(lexer.EncodingUTF8))
+		}
+
+		s = transitions[s][classOf(tok.Kind)]
+		if s == stateStart && tok.Kind == token.KindSemicolon && end != nil && !end(base+int(l.Offset())) {
+			return s, false
+		}
+	}
+}
+
+// Complete reports whether sql ends a statement, that is, whether it contains a statement and ends on a semicolon that
+// ends a statement, optionally followed by white spaces and comments. It is equivalent to sqlite3_complete.
+func Complete(sql []byte) bool {
+	s, unterminated := scan(sql, nil)
+	return !unterminated && s == stateStart
+}
+
+// Split splits sql in the statements it contains. Each statement goes until the semicolon that ends it, inclusive, so
+// the white spaces and comments between two statements are part of the second one. The code after the last statement
+// is returned in rest. The statements and rest are subslices of sql.
+func Split(sql []byte) (statements [][]byte, rest []byte) {
+	start := 0
+	scan(sql, func(offset int) bool {
+		statements = append(statements, sql[start:offset])
+		start = offset
+		return true
+	})
+	return statements, sql[start:]
+}
+
+// ScanStatements is a split function for a bufio.Scanner that returns each statement, as Split does. At EOF the code
+// after the last statement is returned if it has something besides white spaces and comments.
+func ScanStatements(data []byte, atEOF bool) (advance int, tok []byte, err error) {
+	end := 0
+	scan(data, func(offset int) bool {
+		end = offset
+		return false
+	})
+	if end > 0 {
+		return end, data[:end], nil
+	}
+	if atEOF && len(data) > 0 {
+		if s, unterminated := scan(data, nil); s == stateInvalid && !unterminated {
+			return len(data), nil, nil
+		}
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
@@ -0,0 +1,118 @@
+package statement
+
+import (
+	"bufio"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestComplete tests Complete, mostly with the cases of the tests of sqlite3_complete.
+func TestComplete(t *testing.T) {
+	cases := []struct {
+		sql  string
+		want bool
+	}{
+		{sql: "", want: false},
+		{sql: " \n-- comment\n", want: false},
+		{sql: ";", want: true},
+		{sql: "SELECT 1", want: false},
+		{sql: "SELECT 1;", want: true},
+		{sql: "SELECT 1; ", want: true},
+		{sql: "SELECT 1; -- comment", want: true},
+		{sql: "SELECT 1; /* comment */", want: true},
+		{sql: "SELECT 1; /* comment", want: false},
+		{sql: "SELECT 1; /*/", want: false},
+		{sql: "SELECT 1; SELECT 2", want: false},
+		{sql: "SELECT 1 -- ;", want: false},
+		{sql: "SELECT 1 /* ; */", want: false},
+		{sql: "SELECT 'a;b'", want: false},
+		{sql: "SELECT 'a;b';", want: true},
+		{sql: "SELECT 'a;b", want: false},
+		{sql: `SELECT "a;b";`, want: true},
+		{sql: "SELECT [a;b];", want: true},
+		{sql: "SELECT `a;b`;", want: true},
+		{sql: "SELECT `a;b", want: false},
+		{sql: "SELECT x'CA;FE';", want: true},
+		{sql: "SELECT x'CA;FE'", want: false},
+		{sql: "SELECT x'CA;FE", want: false},
+		{sql: "CREATE TABLE end(x);", want: true},
+		{sql: "CREATE TEMP TABLE t(x);", want: true},
+		{sql: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1;", want: false},
+		{sql: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END", want: false},
+		{sql: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;", want: true},
+		{sql: "create temporary trigger tr after insert on t begin select 'end;'; end;", want: true},
+		{sql: "CREATE TEMP TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; /* c */ END /* ; */;", want: true},
+		{sql: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT CASE WHEN 1 THEN 2 END;", want: false},
+		{sql: "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT CASE WHEN 1 THEN 2 END; END;", want: true},
+		{sql: "EXPLAIN CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1;", want: false},
+		{sql: "EXPLAIN QUERY PLAN CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;", want: true},
+		{sql: "EXPLAIN EXPLAIN CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1;", want: true},
+		{sql: "SELECT trigger FROM t;", want: true},
+		{sql: "\uFEFFSELECT 1;", want: true},
+	}
+
+	for _, c := range cases {
+		if got := Complete([]byte(c.sql)); got != c.want {
+			t.Errorf("Complete(%q) = %t, want %t", c.sql, got, c.want)
+		}
+	}
+}
+
+// TestSplit tests the splitting of the code in statements.
+func TestSplit(t *testing.T) {
+	sql := "SELECT ';'; -- a\nCREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END; ;\nSELECT"
+	want := []string{"SELECT ';';", " -- a\nCREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;", " ;"}
+
+	statements, rest := Split([]byte(sql))
+	var got []string
+	for _, s := range statements {
+		got = append(got, string(s))
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if string(rest) != "\nSELECT" {
+		t.Errorf("got rest %q", rest)
+	}
+
+	if statements, rest := Split(nil); len(statements) != 0 || len(rest) != 0 {
+		t.Errorf("got %q and rest %q", statements, rest)
+	}
+}
+
+// TestScanStatements tests the split function for bufio.Scanner.
+func TestScanStatements(t *testing.T) {
+	cases := []struct {
+		sql  string
+		want []string
+	}{
+		{
+			sql:  "SELECT 1; SELECT 'a;\nb';\n-- end\n",
+			want: []string{"SELECT 1;", " SELECT 'a;\nb';"},
+		}, {
+			sql:  "SELECT 1;\nSELECT 2",
+			want: []string{"SELECT 1;", "\nSELECT 2"},
+		}, {
+			sql:  "CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;",
+			want: []string{"CREATE TRIGGER tr AFTER INSERT ON t BEGIN SELECT 1; END;"},
+		},
+	}
+
+	for _, c := range cases {
+		// a small buffer, so the statements are split on more than one read.
+		s := bufio.NewScanner(strings.NewReader(c.sql))
+		s.Buffer(make([]byte, 4), 1024)
+		s.Split(ScanStatements)
+		var got []string
+		for s.Scan() {
+			got = append(got, s.Text())
+		}
+		if s.Err() != nil {
+			t.Errorf("%q: %s", c.sql, s.Err())
+		}
+		if !slices.Equal(got, c.want) {
+			t.Errorf("%q: got %q, want %q", c.sql, got, c.want)
+		}
+	}
+}
@@ -205,10 +205,12 @@ const (
 	kindPipePipe
 	kindDot
 	kindWhiteSpace
+	kindByteOrderMark
 	kindErrorUnexpectedEOF
 	kindErrorBlobNotHexadecimal
 	kindErrorInvalidCharacter
 	kindErrorInvalidCharacterAfter
+	kindErrorInvalidUTF8
 	kindEOF
 )
 
@@ -225,6 +227,37 @@ func (k kind) IsKeyword() bool {
 	return k >= kindAbort && k <= kindWithout
 }
 
+// IsFallback reports whether k is of a keyword that can be used as a name. They are the keywords of the directive
+// %fallback in the file parse.y of SQLite, that are identifiers when they make no sense as keywords, and ROWID and
+// STRICT, that are not keywords in SQLite.
+func IsFallback(k Kind) bool {
+	return fallbackKinds[k]
+}
+
+// fallbackKinds contains the kinds of the keywords that can be used as names, see IsFallback.
+var fallbackKinds = map[Kind]bool{
+	KindAbort: true, KindAction: true, KindAfter: true, KindAlways: true,
+	KindAnalyze: true, KindAsc: true, KindAttach: true, KindBefore: true,
+	KindBegin: true, KindBy: true, KindCascade: true, KindCast: true,
+	KindColumn: true, KindConflict: true, KindCurrent: true, KindCurrentDate: true,
+	KindCurrentTime: true, KindCurrentTimestamp: true, KindDatabase: true, KindDeferred: true,
+	KindDesc: true, KindDetach: true, KindDo: true, KindEach: true,
+	KindEnd: true, KindExclude: true, KindExclusive: true, KindExplain: true,
+	KindFail: true, KindFirst: true, KindFollowing: true, KindFor: true,
+	KindGenerated: true, KindGlob: true, KindGroups: true, KindIf: true,
+	KindIgnore: true, KindImmediate: true, KindInitially: true, KindInstead: true,
+	KindKey: true, KindLast: true, KindLike: true, KindMatch: true,
+	KindMaterialized: true, KindNo: true, KindNulls: true, KindOf: true,
+	KindOffset: true, KindOthers: true, KindPartition: true, KindPlan: true,
+	KindPragma: true, KindPreceding: true, KindQuery: true, KindRaise: true,
+	KindRange: true, KindRecursive: true, KindRegexp: true, KindReindex: true,
+	KindRelease: true, KindRename: true, KindReplace: true, KindRestrict: true,
+	KindRollback: true, KindRow: true, KindRowId: true, KindRows: true,
+	KindSavepoint: true, KindStrict: true, KindTemp: true, KindTemporary: true,
+	KindTies: true, KindTrigger: true, KindUnbounded: true, KindVacuum: true,
+	KindView: true, KindVirtual: true, KindWith: true, KindWithout: true,
+}
+
 // kindStrings contains the string representation of the kinds. Note that the value of a kind is the index of your string
 // representation.
 var kindStrings = []string{
@@ -242,7 +275,8 @@ var kindStrings = []string{
 	"CComment", "QuestionVariable", "ColonVariable", "AtVariable", "DollarVariable", "Minus", "MinusGreaterThan", "MinusGreaterThanGreaterThan",
 	"LeftParen", "RightParen", "Semicolon", "Plus", "Asterisk", "Slash", "Percent", "Equal", "EqualEqual", "LessThanOrEqual", "LessThanGreaterThan",
 	"LessThanLessThan", "LessThan", "GreaterThanEqual", "GreaterThanGreaterThan", "GreaterThan", "ExclamationEqual", "Comma", "Ampersand", "Tilde",
-	"Pipe", "PipePipe", "Dot", "WhiteSpace", "ErrorUnexpectedEOF", "ErrorBlobNotHexadecimal", "ErrorInvalidCharacter", "ErrorInvalidCharacterAfter", "EOF",
+	"Pipe", "PipePipe", "Dot", "WhiteSpace", "ByteOrderMark", "ErrorUnexpectedEOF", "ErrorBlobNotHexadecimal", "ErrorInvalidCharacter",
+	"ErrorInvalidCharacterAfter", "ErrorInvalidUTF8", "EOF",
 }
 
 var (
@@ -434,9 +468,11 @@ var (
 	KindPipePipe                    Kind = kindPipePipe
 	KindDot                         Kind = kindDot
 	KindWhiteSpace                  Kind = kindWhiteSpace
+	KindByteOrderMark               Kind = kindByteOrderMark
 	KindErrorUnexpectedEOF          Kind = kindErrorUnexpectedEOF
 	KindErrorBlobNotHexadecimal     Kind = kindErrorBlobNotHexadecimal
 	KindErrorInvalidCharacter       Kind = kindErrorInvalidCharacter
 	KindErrorInvalidCharacterAfter  Kind = kindErrorInvalidCharacterAfter
+	KindErrorInvalidUTF8            Kind = kindErrorInvalidUTF8
 	KindEOF                         Kind = kindEOF
 )
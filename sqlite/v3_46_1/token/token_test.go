@@ -2,6 +2,8 @@ package token
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -39,3 +41,66 @@ func TestIsKeyword(t *testing.T) {
 		}
 	}
 }
+
+func TestIsFallback(t *testing.T) {
+	cases := []struct {
+		in   Kind
+		want bool
+	}{
+		{in: KindKey, want: true}, {in: KindRowId, want: true}, {in: KindStrict, want: true},
+		{in: KindRollback, want: true}, {in: KindTemporary, want: true},
+		{in: KindSelect, want: false}, {in: KindIdentifier, want: false},
+	}
+
+	for _, c := range cases {
+		if got := IsFallback(c.in); got != c.want {
+			t.Errorf("IsFallback(%s) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+// TestIsFallbackParseY compares IsFallback with the directive %fallback ID of the file parse.y of SQLite 3.46.1,
+// without the tokens that are fallbacks only when the compound selects are omitted.
+func TestIsFallbackParseY(t *testing.T) {
+	const fallback = `
+		ABORT ACTION AFTER ANALYZE ASC ATTACH BEFORE BEGIN BY CASCADE CAST COLUMNKW
+		CONFLICT DATABASE DEFERRED DESC DETACH DO
+		EACH END EXCLUSIVE EXPLAIN FAIL FOR
+		IGNORE IMMEDIATE INITIALLY INSTEAD LIKE_KW MATCH NO PLAN
+		QUERY KEY OF OFFSET PRAGMA RAISE RECURSIVE RELEASE REPLACE RESTRICT ROW ROWS
+		ROLLBACK SAVEPOINT TEMP TRIGGER VACUUM VIEW VIRTUAL WITH WITHOUT
+		NULLS FIRST LAST
+		CURRENT FOLLOWING PARTITION PRECEDING RANGE UNBOUNDED
+		EXCLUDE GROUPS OTHERS TIES
+		GENERATED ALWAYS
+		MATERIALIZED
+		REINDEX RENAME CTIME_KW IF`
+	// tokens are the kinds of the tokens of parse.y that are not named like the kinds.
+	tokens := map[string][]Kind{
+		"COLUMNKW": {KindColumn},
+		"LIKE_KW":  {KindLike, KindGlob, KindRegexp},
+		"CTIME_KW": {KindCurrentDate, KindCurrentTime, KindCurrentTimestamp},
+		"TEMP":     {KindTemp, KindTemporary},
+	}
+	// want has the fallbacks of parse.y and the names that are not keywords in SQLite.
+	want := map[Kind]bool{KindRowId: true, KindStrict: true}
+	for _, name := range strings.Fields(fallback) {
+		if ks, ok := tokens[name]; ok {
+			for _, k := range ks {
+				want[k] = true
+			}
+			continue
+		}
+		i := slices.IndexFunc(kindStrings, func(s string) bool { return strings.EqualFold(s, name) })
+		if i < 0 {
+			t.Fatalf("no kind for the token %s of parse.y", name)
+		}
+		want[kind(i)] = true
+	}
+
+	for i := range kindStrings {
+		if k := kind(i); IsFallback(k) != want[k] {
+			t.Errorf("IsFallback(%s) = %v, want %v", k, IsFallback(k), want[k])
+		}
+	}
+}
@@ -0,0 +1,292 @@
+// This package tracks the nesting of brackets in a sequence of tokens. The brackets are the parentheses, the CASE ... END
+// expressions and the BEGIN ... END blocks of the CREATE TRIGGER statements. A BEGIN outside a CREATE TRIGGER starts a
+// transaction and an END that doesn't close a CASE or a BEGIN block ends one, so they aren't brackets.
+//
+// The Tracker is the base of the transformers of this package, that need to know the depth of the tokens, and of the
+// function Match, that returns the offsets of the matching pairs of brackets.
+package bracket
+
+import (
+	"strconv"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Kind is the kind of a bracket.
+type Kind int
+
+const (
+	// KindParen is the kind of the parentheses.
+	KindParen Kind = iota
+	// KindCase is the kind of the CASE ... END expressions.
+	KindCase
+	// KindBegin is the kind of the BEGIN ... END blocks of the triggers.
+	KindBegin
+)
+
+// String returns a string representation of k.
+func (k Kind) String() string {
+	switch k {
+	case KindParen:
+		return "Paren"
+	case KindCase:
+		return "Case"
+	case KindBegin:
+		return "Begin"
+	}
+	return strconv.Itoa(int(k))
+}
+
+// Role is the role of a token in the nesting of brackets.
+type Role int
+
+const (
+	// RoleNone is the role of the tokens that aren't brackets.
+	RoleNone Role = iota
+	// RoleOpen is the role of an opening bracket.
+	RoleOpen
+	// RoleClose is the role of a closing bracket that matches an opening bracket.
+	RoleClose
+	// RoleUnmatched is the role of a closing bracket without an opening bracket.
+	RoleUnmatched
+)
+
+// Bracket is an opening bracket.
+type Bracket struct {
+	// Kind is the kind of the bracket.
+	Kind Kind
+	// Token is the opening token.
+	Token *token.Token
+	// Offset is the offset of Token, that is, the sum of the lengths of the lexemes of the previous tokens.
+	Offset int
+	// Depth is the number of brackets enclosing this bracket.
+	Depth int
+}
+
+// Event describes the effect of a token on the nesting of brackets.
+type Event struct {
+	// Role is the role of the token.
+	Role Role
+	// Kind is the kind of the bracket if Role is not RoleNone.
+	Kind Kind
+	// Depth is the depth of the bracket if Role is RoleOpen or RoleClose. A pair of matching brackets have the same depth.
+	Depth int
+	// Open is the opening bracket matched if Role is RoleClose.
+	Open Bracket
+	// Unclosed are the opening brackets that will never be closed, because the token ends the context where they could be
+	// closed. The innermost bracket comes first. This happens, for example, on a semicolon or on a closing parenthesis
+	// that follows an unclosed CASE.
+	Unclosed []Bracket
+}
+
+// Tracker tracks the nesting of brackets in a sequence of tokens.
+type Tracker struct {
+	// stack are the brackets not closed yet.
+	stack []Bracket
+	// offset is the offset of the next token.
+	offset int
+	// statementTokens is the number of tokens of the current statement, without white spaces and comments.
+	statementTokens int
+	// create reports whether the current statement starts with CREATE, optionally followed by TEMP, and the TRIGGER
+	// keyword was not seen yet.
+	create bool
+	// trigger reports whether the current statement is a CREATE TRIGGER.
+	trigger bool
+}
+
+// NewTracker creates a Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// Depth returns the number of brackets not closed yet.
+func (t *Tracker) Depth() int {
+	return len(t.stack)
+}
+
+// Offset returns the offset of the next token.
+func (t *Tracker) Offset() int {
+	return t.offset
+}
+
+// Track updates the tracker with the next token and returns its effect.
+func (t *Tracker) Track(tok *token.Token) (ev Event) {
+	offset := t.offset
+	t.offset += len(tok.Lexeme)
+
+	switch tok.Kind {
+	case token.KindWhiteSpace, token.KindByteOrderMark, token.KindSQLComment, token.KindCComment:
+		return
+	case token.KindEOF:
+		ev.Unclosed = t.unwind(0)
+		t.endStatement()
+		return
+	}
+
+	t.trackStatement(tok.Kind)
+
+	switch tok.Kind {
+	case token.KindLeftParen:
+		return t.open(KindParen, tok, offset)
+	case token.KindCase:
+		return t.open(KindCase, tok, offset)
+	case token.KindBegin:
+		if t.trigger && !t.inBlock() {
+			return t.open(KindBegin, tok, offset)
+		}
+	case token.KindRightParen:
+		return t.close(KindParen)
+	case token.KindEnd:
+		if i := t.find(KindCase, KindBegin); i >= 0 {
+			return t.close(t.stack[i].Kind)
+		}
+	case token.KindSemicolon:
+		if i := t.find(KindBegin); i >= 0 {
+			ev.Unclosed = t.unwind(i + 1)
+		} else {
+			ev.Unclosed = t.unwind(0)
+			t.endStatement()
+		}
+	}
+	return
+}
+
+// trackStatement detects the start of a CREATE TRIGGER statement.
+func (t *Tracker) trackStatement(k token.Kind) {
+	switch {
+	case t.statementTokens == 0 && k == token.KindCreate:
+		t.create = true
+	case t.create && (k == token.KindTemp || k == token.KindTemporary):
+	case t.create && k == token.KindTrigger:
+		t.create = false
+		t.trigger = true
+	default:
+		t.create = false
+	}
+	t.statementTokens++
+}
+
+// endStatement resets the state of the current statement.
+func (t *Tracker) endStatement() {
+	t.statementTokens = 0
+	t.create = false
+	t.trigger = false
+}
+
+// inBlock reports whether there is a BEGIN block not closed.
+func (t *Tracker) inBlock() bool {
+	return t.find(KindBegin) >= 0
+}
+
+// open pushes an opening bracket.
+func (t *Tracker) open(k Kind, tok *token.Token, offset int) Event {
+	b := Bracket{Kind: k, Token: tok, Offset: offset, Depth: len(t.stack)}
+	t.stack = append(t.stack, b)
+	return Event{Role: RoleOpen, Kind: k, Depth: b.Depth}
+}
+
+// close pops the innermost opening bracket with kind k. The brackets inside it are unclosed. If there is no opening
+// bracket with kind k the token is unmatched.
+func (t *Tracker) close(k Kind) Event {
+	i := t.find(k)
+	if i < 0 {
+		return Event{Role: RoleUnmatched, Kind: k}
+	}
+	open := t.stack[i]
+	unclosed := t.unwind(i + 1)
+	t.stack = t.stack[:i]
+	return Event{Role: RoleClose, Kind: k, Depth: open.Depth, Open: open, Unclosed: unclosed}
+}
+
+// find returns the position on t.stack of the innermost bracket with one of the kinds ks. A bracket can't be closed
+// from inside a BEGIN block, so the search stops on the innermost BEGIN if KindBegin is not in ks. The result is -1 if
+// there is no such bracket.
+func (t *Tracker) find(ks ...Kind) int {
+	for i := len(t.stack) - 1; i >= 0; i-- {
+		for _, k := range ks {
+			if t.stack[i].Kind == k {
+				return i
+			}
+		}
+		if t.stack[i].Kind == KindBegin {
+			return -1
+		}
+	}
+	return -1
+}
+
+// unwind removes the brackets of t.stack from the position i onwards and returns them, the innermost first.
+func (t *Tracker) unwind(i int) []Bracket {
+	var unclosed []Bracket
+	for j := len(t.stack) - 1; j >= i; j-- {
+		unclosed = append(unclosed, t.stack[j])
+	}
+	t.stack = t.stack[:i]
+	return unclosed
+}
+
+// Span is the position of a token in the code.
+type Span struct {
+	// Start is the offset of the first byte of the token.
+	Start int
+	// End is the offset after the last byte of the token.
+	End int
+}
+
+// Pair is a pair of matching brackets.
+type Pair struct {
+	// Kind is the kind of the brackets.
+	Kind Kind
+	// Depth is the number of pairs enclosing this pair.
+	Depth int
+	// Open is the position of the opening bracket. If the closing bracket is unmatched Open.Start is -1.
+	Open Span
+	// Close is the position of the closing bracket. If the opening bracket is unclosed Close.Start is -1.
+	Close Span
+}
+
+// Matched reports whether p has both the opening and the closing brackets.
+func (p Pair) Matched() bool {
+	return p.Open.Start >= 0 && p.Close.Start >= 0
+}
+
+// Match returns the pairs of brackets of code, ordered by the offset of the first bracket of the pair. The unmatched
+// closing brackets and the unclosed opening brackets are returned as pairs without one of the brackets.
+func Match(code []byte) []Pair {
+	var pairs []Pair
+	// index maps the offsets of the opening brackets to their pair on pairs.
+	index := make(map[int]int)
+	missing := Span{Start: -1, End: -1}
+
+	l := lexer.New(code)
+	t := NewTracker()
+	for {
+		offset := t.Offset()
+		tok := l.Next()
+		ev := t.Track(tok)
+		switch ev.Role {
+		case RoleOpen:
+			index[offset] = len(pairs)
+			pairs = append(pairs, Pair{Kind: ev.Kind, Depth: ev.Depth, Open: Span{Start: offset, End: t.Offset()}, Close: missing})
+		case RoleClose:
+			pairs[index[ev.Open.Offset]].Close = Span{Start: offset, End: t.Offset()}
+		case RoleUnmatched:
+			pairs = append(pairs, Pair{Kind: ev.Kind, Depth: t.Depth(), Open: missing, Close: Span{Start: offset, End: t.Offset()}})
+		}
+		if tok.Kind == token.KindEOF {
+			return pairs
+		}
+	}
+}
+
+// MatchAt returns the pair of brackets of code that has a bracket containing the offset. The result is false if there
+// is no such pair.
+func MatchAt(code []byte, offset int) (Pair, bool) {
+	for _, p := range Match(code) {
+		if p.Open.Start <= offset && offset < p.Open.End || p.Close.Start <= offset && offset < p.Close.End {
+			return p, true
+		}
+	}
+	return Pair{}, false
+}
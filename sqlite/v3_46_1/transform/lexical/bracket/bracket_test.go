@@ -0,0 +1,76 @@
+package bracket
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	missing := Span{Start: -1, End: -1}
+	cases := []struct {
+		code  string
+		pairs []Pair
+	}{
+		{
+			code: "select (1 + (2))",
+			pairs: []Pair{
+				{Kind: KindParen, Depth: 0, Open: Span{7, 8}, Close: Span{15, 16}},
+				{Kind: KindParen, Depth: 1, Open: Span{12, 13}, Close: Span{14, 15}},
+			},
+		}, {
+			code:  "select 1)",
+			pairs: []Pair{{Kind: KindParen, Depth: 0, Open: missing, Close: Span{8, 9}}},
+		}, {
+			code:  "select (1; select 2)",
+			pairs: []Pair{{Kind: KindParen, Depth: 0, Open: Span{7, 8}, Close: missing}, {Kind: KindParen, Depth: 0, Open: missing, Close: Span{19, 20}}},
+		}, {
+			code: "select case when (1 then 2 end",
+			pairs: []Pair{
+				{Kind: KindCase, Depth: 0, Open: Span{7, 11}, Close: Span{27, 30}},
+				{Kind: KindParen, Depth: 1, Open: Span{17, 18}, Close: missing},
+			},
+		}, {
+			code:  "begin; select 1; end;",
+			pairs: nil,
+		}, {
+			code: "create trigger t after insert on a begin select case when 1 then 2 end; end;",
+			pairs: []Pair{
+				{Kind: KindBegin, Depth: 0, Open: Span{35, 40}, Close: Span{72, 75}},
+				{Kind: KindCase, Depth: 1, Open: Span{48, 52}, Close: Span{67, 70}},
+			},
+		}, {
+			code:  "create temp trigger t after insert on a begin select (1; end",
+			pairs: []Pair{{Kind: KindBegin, Depth: 0, Open: Span{40, 45}, Close: Span{57, 60}}, {Kind: KindParen, Depth: 1, Open: Span{53, 54}, Close: missing}},
+		}, {
+			code:  "select (1",
+			pairs: []Pair{{Kind: KindParen, Depth: 0, Open: Span{7, 8}, Close: missing}},
+		},
+	}
+
+	for _, c := range cases {
+		pairs := Match([]byte(c.code))
+		if !slices.Equal(c.pairs, pairs) {
+			t.Errorf("%q: want %v, got %v", c.code, c.pairs, pairs)
+		}
+	}
+}
+
+func TestMatchAt(t *testing.T) {
+	code := []byte("select (1 + (2))")
+	p, ok := MatchAt(code, 14)
+	if !ok || p.Open != (Span{12, 13}) {
+		t.Errorf("want the inner pair, got %v, %v", p, ok)
+	}
+	if _, ok := MatchAt(code, 9); ok {
+		t.Errorf("want no pair at offset 9")
+	}
+}
+
+func TestKindString(t *testing.T) {
+	cases := map[Kind]string{KindParen: "Paren", KindCase: "Case", KindBegin: "Begin", Kind(7): "7"}
+	for k, s := range cases {
+		if k.String() != s {
+			t.Errorf("want %s, got %s", s, k)
+		}
+	}
+}
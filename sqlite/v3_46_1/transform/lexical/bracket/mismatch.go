@@ -0,0 +1,55 @@
+package bracket
+
+import (
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+)
+
+// Mismatch is a lexical.Transformer that highlights the unmatched closing brackets and the unclosed opening brackets.
+// Whether an opening bracket is unclosed is known only later, so Mismatch holds the tokens while there are opening
+// brackets not closed, and returns them all at once. The tokens given to Mismatch must not contain tokens of other
+// packages between the keywords of the statements.
+type Mismatch struct {
+	// tracker tracks the brackets.
+	tracker *Tracker
+	// style is applied to the mismatched brackets.
+	style lexical.Transformer
+	// held are the tokens held while there are opening brackets not closed.
+	held []*token.Token
+	// mismatched are the mismatched brackets among the held tokens.
+	mismatched map[*token.Token]bool
+}
+
+// NewMismatch creates a Mismatch that applies style to the mismatched brackets, for example a color.Transformer whose
+// kind predicate always returns true.
+func NewMismatch(style lexical.Transformer) *Mismatch {
+	return &Mismatch{tracker: NewTracker(), style: style, mismatched: make(map[*token.Token]bool)}
+}
+
+// Transform implements lexical.Transformer.
+func (m *Mismatch) Transform(tok *token.Token) []*token.Token {
+	ev := m.tracker.Track(tok)
+	for _, b := range ev.Unclosed {
+		m.mismatched[b.Token] = true
+	}
+	if ev.Role == RoleUnmatched {
+		m.mismatched[tok] = true
+	}
+
+	m.held = append(m.held, tok)
+	if m.tracker.Depth() > 0 {
+		return nil
+	}
+
+	var result []*token.Token
+	for _, held := range m.held {
+		if m.mismatched[held] {
+			result = append(result, m.style.Transform(held)...)
+		} else {
+			result = append(result, held)
+		}
+	}
+	m.held = nil
+	clear(m.mismatched)
+	return result
+}
@@ -0,0 +1,44 @@
+package bracket
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal/rgb"
+)
+
+func TestMismatch(t *testing.T) {
+	cases := []struct {
+		code     string
+		expected string
+	}{
+		{
+			code:     "select (1 + (2))",
+			expected: "select (1 + (2))",
+		}, {
+			code:     "select 1)",
+			expected: "select 1\x1B[38;2;255;0;0m)\x1B[0m",
+		}, {
+			code:     "select (1; select (2",
+			expected: "select \x1B[38;2;255;0;0m(\x1B[0m1; select \x1B[38;2;255;0;0m(\x1B[0m2",
+		}, {
+			code:     "select case when (1 then 2 end)",
+			expected: "select case when \x1B[38;2;255;0;0m(\x1B[0m1 then 2 end\x1B[38;2;255;0;0m)\x1B[0m",
+		}, {
+			code:     "begin; select 1; end;",
+			expected: "begin; select 1; end;",
+		},
+	}
+
+	for _, c := range cases {
+		style := color.NewTransformer(func(token.Kind) bool { return true }, color.NewRGB(255, 0, 0), color.Nil)
+		tr := lexical.Chain(NewMismatch(style), rgb.NewTransformer())
+		if got := transform(c.code, tr); c.expected != got {
+			fmt.Printf("want %q, got %q\n", c.expected, got)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package bracket
+
+import (
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+)
+
+// Rainbow is a lexical.Transformer that colors the matching parentheses by their depth. The tokens given to Rainbow must
+// not contain tokens of other packages between the keywords of the statements, so it must be applied before the
+// transformers of the package color.
+type Rainbow struct {
+	// tracker tracks the depth of the parentheses.
+	tracker *Tracker
+	// colors are the foreground colors of the depths. The colors repeat after the last one.
+	colors []color.RGB
+}
+
+// NewRainbow creates a Rainbow. The parentheses with depth d receive the foreground color colors[d%len(colors)]. The
+// unmatched closing parentheses don't receive a color. An opening parenthesis receives the color of your depth when it
+// is transformed, before it is known whether it is closed, so an unclosed one receives it too. Mismatch highlights the
+// unclosed ones.
+func NewRainbow(colors ...color.RGB) *Rainbow {
+	return &Rainbow{tracker: NewTracker(), colors: colors}
+}
+
+// Transform implements lexical.Transformer.
+func (r *Rainbow) Transform(tok *token.Token) []*token.Token {
+	ev := r.tracker.Track(tok)
+	if len(r.colors) == 0 || ev.Kind != KindParen || (ev.Role != RoleOpen && ev.Role != RoleClose) {
+		return []*token.Token{tok}
+	}
+	l := r.colors[ev.Depth%len(r.colors)].MarshalLexeme()
+	return []*token.Token{token.New(l[0:4], color.TokenKindForegroundColor), tok}
+}
@@ -0,0 +1,55 @@
+package bracket
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal/rgb"
+)
+
+func TestRainbow(t *testing.T) {
+	cases := []struct {
+		code     string
+		expected string
+	}{
+		{
+			code:     "select (1 + (2 * (3)))",
+			expected: "select \x1B[38;2;255;0;0m(\x1B[0m1 + \x1B[38;2;0;255;0m(\x1B[0m2 * \x1B[38;2;255;0;0m(\x1B[0m3\x1B[38;2;255;0;0m)\x1B[0m\x1B[38;2;0;255;0m)\x1B[0m\x1B[38;2;255;0;0m)\x1B[0m",
+		}, {
+			code:     "select 1)",
+			expected: "select 1)",
+		}, {
+			code:     "select (1",
+			expected: "select \x1B[38;2;255;0;0m(\x1B[0m1",
+		}, {
+			code:     "select case when (1) then 2 end",
+			expected: "select case when \x1B[38;2;0;255;0m(\x1B[0m1\x1B[38;2;0;255;0m)\x1B[0m then 2 end",
+		},
+	}
+
+	for _, c := range cases {
+		tr := lexical.Chain(NewRainbow(color.NewRGB(255, 0, 0), color.NewRGB(0, 255, 0)), rgb.NewTransformer())
+		if got := transform(c.code, tr); c.expected != got {
+			fmt.Printf("want %q, got %q\n", c.expected, got)
+			t.Fail()
+		}
+	}
+}
+
+// transform applies tr to the tokens of code and returns the resulting code.
+func transform(code string, tr lexical.Transformer) string {
+	tp := lexical.NewTokenProvider(lexer.New([]byte(code)), tr)
+
+	var b strings.Builder
+	tok := tp.Next()
+	for tok.Kind != token.KindEOF {
+		b.Write(tok.Lexeme)
+		tok = tp.Next()
+	}
+	return b.String()
+}
@@ -1,8 +1,8 @@
-// This package transforms a sequence of tokens by adding tokens that represent RGB colors. The lexeme
+// This package transforms a sequence of tokens by adding tokens that represent RGB colors and text attributes. The lexeme
 // of that tokens is not suitable to be written to a file, see the subpackage terminal/rgb,
 // these package transforms the colors of this package in formats suitables to be written to a file.
 //
-// The tokens of color added must affect only the next token that is not of the kinds defined in this package.
+// The tokens of color and attribute added must affect only the next token that is not of the kinds defined in this package.
 package color
 
 import (
@@ -45,6 +45,34 @@ func (c RGB) Components() (r, g, b byte) {
 // Nil is for permit the specification that a color must not be set.
 var Nil = RGB(0x01000000)
 
+// Attribute represents a set of text attributes. The attributes can be combined with the | operator.
+type Attribute byte
+
+const (
+	AttributeBold Attribute = 1 << iota
+	AttributeDim
+	AttributeItalic
+	AttributeUnderline
+	AttributeCurlyUnderline
+	// no attribute must be set.
+	AttributeNil Attribute = 0
+)
+
+// Has reports whether all the attributes of b are in a.
+func (a Attribute) Has(b Attribute) bool {
+	return a&b == b
+}
+
+// MarshalLexeme marshalls a to a representation suitable to be used as the lexeme of a token.
+func (a Attribute) MarshalLexeme() [1]byte {
+	return [1]byte{byte(a)}
+}
+
+// UnmarshalLexeme unmarshalls the lexeme into a.
+func (a *Attribute) UnmarshalLexeme(lexeme [1]byte) {
+	*a = Attribute(lexeme[0])
+}
+
 // Transformer is a lexical.Transformer that adds tokens that represent RGB colors.
 type Transformer struct {
 	// kindPredicate determines for which kinds of tokens the transformation should take place.
@@ -53,6 +81,8 @@ type Transformer struct {
 	foregroundColor RGB
 	// backgroundColor is the background color that will be applied. If it is Nil then the background color will not be Set.
 	backgroundColor RGB
+	// attributes are the text attributes that will be applied. If it is AttributeNil then no attribute will be set.
+	attributes Attribute
 }
 
 // NewTransformer creates a Transformer. foregroundColor is the foreground color that will be applied.
@@ -63,8 +93,15 @@ func NewTransformer(kindPredicate func(token.Kind) bool, foregroundColor, backgr
 	return &Transformer{kindPredicate: kindPredicate, foregroundColor: foregroundColor, backgroundColor: backgroundColor}
 }
 
+// NewTransformerAttributes creates a Transformer that also applies the text attributes attrs. See NewTransformer.
+func NewTransformerAttributes(kindPredicate func(token.Kind) bool, foregroundColor, backgroundColor RGB, attrs Attribute) *Transformer {
+	return &Transformer{
+		kindPredicate: kindPredicate, foregroundColor: foregroundColor, backgroundColor: backgroundColor, attributes: attrs,
+	}
+}
+
 // Transform implements lexical.Transformer. It adds tokens with a RGB color if kindPredicate returns true for the kind of tok.
-// The tokens added has kind equals TokenKindForegroundColor or TokenKindBackgroundColor.
+// The tokens added has kind equals TokenKindForegroundColor, TokenKindBackgroundColor or TokenKindAttribute.
 func (t *Transformer) Transform(tok *token.Token) []*token.Token {
 	if t.kindPredicate(tok.Kind) {
 		return t.transform(tok)
@@ -83,6 +120,10 @@ func (t *Transformer) transform(tok *token.Token) []*token.Token {
 		l := t.backgroundColor.MarshalLexeme()
 		result = append(result, token.New(l[0:4], TokenKindBackgroundColor))
 	}
+	if t.attributes != AttributeNil {
+		l := t.attributes.MarshalLexeme()
+		result = append(result, token.New(l[0:1], TokenKindAttribute))
+	}
 	result = append(result, tok)
 	return result
 }
@@ -113,8 +154,10 @@ type tokenKind int
 var (
 	tokenKindForegroundColor            = tokenKind(0)
 	tokenKindBackgroundColor            = tokenKind(1)
+	tokenKindAttribute                  = tokenKind(2)
 	TokenKindForegroundColor token.Kind = &tokenKindForegroundColor
 	TokenKindBackgroundColor token.Kind = &tokenKindBackgroundColor
+	TokenKindAttribute       token.Kind = &tokenKindAttribute
 )
 
 // String returns a string representation of k.
@@ -133,5 +176,5 @@ func (k *tokenKind) IsKeyword() bool {
 // tokenKindStrings contains the string representation of the token kinds specific to this package.
 // Note that the value of a tokenKind is the index of your string representation.
 var tokenKindStrings = []string{
-	"ForegroundColor", "BackgroundColor",
+	"ForegroundColor", "BackgroundColor", "Attribute",
 }
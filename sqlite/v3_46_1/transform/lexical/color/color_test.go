@@ -48,6 +48,31 @@ func TestTransformers(t *testing.T) {
 	}
 }
 
+func TestAttributes(t *testing.T) {
+	lex := lexer.New([]byte("select * where"))
+	tr := NewTransformerAttributes(lexical.IsKeyword, Nil, NewRGB(0xAA, 0xAA, 0xAA), AttributeBold|AttributeItalic)
+	tp := lexical.NewTokenProvider(lex, tr)
+
+	var b strings.Builder
+	tok := tp.Next()
+	for tok.Kind != token.KindEOF {
+		b.Write(tok.Lexeme)
+		tok = tp.Next()
+	}
+
+	expected := "\x00\xaa\xaa\xaa\x05select * \x00\xaa\xaa\xaa\x05where"
+	if expected != b.String() {
+		fmt.Printf("want %q, got %q\n", expected, b.String())
+		t.Fail()
+	}
+
+	var a Attribute
+	a.UnmarshalLexeme((AttributeDim | AttributeCurlyUnderline).MarshalLexeme())
+	if !a.Has(AttributeDim) || !a.Has(AttributeCurlyUnderline) || a.Has(AttributeBold) {
+		t.Errorf("unexpected attributes %08b", a)
+	}
+}
+
 func TestMarshal(t *testing.T) {
 	c := NewRGB(0xAA, 0xBB, 0xCC)
 	b := c.MarshalLexeme()
@@ -82,6 +107,10 @@ func TestTokenKind(t *testing.T) {
 		fmt.Printf("want %s, got %s", "ForegroundColor", TokenKindForegroundColor)
 		t.Fail()
 	}
+	if TokenKindAttribute.String() != "Attribute" {
+		fmt.Printf("want %s, got %s", "Attribute", TokenKindAttribute)
+		t.Fail()
+	}
 	k := tokenKind(-1)
 	if k.String() != "-1" {
 		fmt.Printf("want %s, got %s", "-1", k.String())
@@ -0,0 +1,112 @@
+// This package transforms a sequence of tokens by replacing the tokens with kind equals color.TokenKindForegroundColor,
+// color.TokenKindBackgroundColor or color.TokenKindAttribute by HTML span elements. The token that receives the colors
+// and the attributes is put in a span whose style attribute has the CSS properties of them, for example
+// <span style="color:#569cd6;font-weight:bold">select</span>. The lexemes of the other tokens are escaped, so the
+// result can be put in a HTML document, usually in a pre element.
+package html
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+)
+
+// escaper escapes the special characters of HTML.
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&#34;", "'", "&#39;")
+
+// Transformer is a lexical.Transformer that operates as specified in the documentation for this package.
+type Transformer struct {
+	// style are the CSS properties of the colors and attributes read since the last token that is not of the package
+	// color.
+	style []string
+}
+
+// NewTransformer creates a Transformer.
+func NewTransformer() *Transformer {
+	return &Transformer{}
+}
+
+// Transform implements lexical.Transformer.
+func (t *Transformer) Transform(tok *token.Token) []*token.Token {
+	switch tok.Kind {
+	case color.TokenKindForegroundColor:
+		t.style = append(t.style, "color:"+colorCode(tok))
+		return nil
+	case color.TokenKindBackgroundColor:
+		t.style = append(t.style, "background-color:"+colorCode(tok))
+		return nil
+	case color.TokenKindAttribute:
+		var a color.Attribute
+		a.UnmarshalLexeme([1]byte(tok.Lexeme))
+		t.style = append(t.style, attributeProperties(a)...)
+		return nil
+	case token.KindEOF:
+		return []*token.Token{tok}
+	}
+	escaped := token.New([]byte(escaper.Replace(string(tok.Lexeme))), tok.Kind)
+	if len(t.style) == 0 {
+		return []*token.Token{escaped}
+	}
+	start := token.New([]byte(`<span style="`+strings.Join(t.style, ";")+`">`), TokenKindTag)
+	t.style = nil
+	return []*token.Token{start, escaped, token.New([]byte("</span>"), TokenKindTag)}
+}
+
+// colorCode returns the color of tok in the CSS format #rrggbb.
+func colorCode(tok *token.Token) string {
+	var c color.RGB
+	c.UnmarshalLexeme([4]byte(tok.Lexeme))
+	r, g, b := c.Components()
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// attributeProperties returns the CSS properties of a. The underline is wavy if the curly underline is present.
+func attributeProperties(a color.Attribute) []string {
+	var ps []string
+	if a.Has(color.AttributeBold) {
+		ps = append(ps, "font-weight:bold")
+	}
+	if a.Has(color.AttributeDim) {
+		ps = append(ps, "opacity:0.7")
+	}
+	if a.Has(color.AttributeItalic) {
+		ps = append(ps, "font-style:italic")
+	}
+	if a.Has(color.AttributeCurlyUnderline) {
+		ps = append(ps, "text-decoration:underline wavy")
+	} else if a.Has(color.AttributeUnderline) {
+		ps = append(ps, "text-decoration:underline")
+	}
+	return ps
+}
+
+// tokenKind is a type for token kinds speceific to this package.
+type tokenKind int
+
+var (
+	tokenKindTag = tokenKind(0)
+	// TokenKindTag is the kind of the tokens of the start and end tags of the span elements.
+	TokenKindTag token.Kind = &tokenKindTag
+)
+
+// String returns a string representation of k.
+func (k *tokenKind) String() string {
+	if *k < 0 || int(*k) >= len(tokenKindStrings) {
+		return strconv.Itoa(int(*k))
+	}
+	return tokenKindStrings[*k]
+}
+
+// IsKeyword reports whether this kind is of a keyword.
+func (k *tokenKind) IsKeyword() bool {
+	return false
+}
+
+// tokenKindStrings contains the string representation of the token kinds specific to this package.
+// Note that the value of a tokenKind is the index of your string representation.
+var tokenKindStrings = []string{
+	"Tag",
+}
@@ -0,0 +1,57 @@
+package html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+)
+
+// transform returns the lexemes of the tokens of code transformed by tr and by a Transformer.
+func transform(code string, tr lexical.Transformer) string {
+	tp := lexical.NewTokenProvider(lexer.New([]byte(code)), lexical.Chain(tr, NewTransformer()))
+	var b strings.Builder
+	for tok := tp.Next(); tok.Kind != token.KindEOF; tok = tp.Next() {
+		b.Write(tok.Lexeme)
+	}
+	return b.String()
+}
+
+func TestColor(t *testing.T) {
+	tr := color.NewTransformer(lexical.IsKeyword, color.NewRGB(0xAA, 0xAA, 0xAA), color.NewRGB(0x00, 0x0B, 0xBB))
+	got := transform("select 'a<b' where", tr)
+	want := `<span style="color:#aaaaaa;background-color:#000bbb">select</span> &#39;a&lt;b&#39; ` +
+		`<span style="color:#aaaaaa;background-color:#000bbb">where</span>`
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	cases := []struct {
+		attrs color.Attribute
+		want  string
+	}{
+		{color.AttributeBold | color.AttributeItalic, `<span style="font-weight:bold;font-style:italic">select</span>`},
+		{color.AttributeDim | color.AttributeUnderline, `<span style="opacity:0.7;text-decoration:underline">select</span>`},
+		{color.AttributeUnderline | color.AttributeCurlyUnderline, `<span style="text-decoration:underline wavy">select</span>`},
+	}
+	for _, c := range cases {
+		tr := color.NewTransformerAttributes(lexical.IsKeyword, color.Nil, color.Nil, c.attrs)
+		if got := transform("select", tr); got != c.want {
+			t.Errorf("got %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestTokenKind(t *testing.T) {
+	if TokenKindTag.String() != "Tag" || TokenKindTag.IsKeyword() {
+		t.Errorf("wrong TokenKindTag")
+	}
+	if k := tokenKind(5); k.String() != "5" {
+		t.Errorf("got %q", k.String())
+	}
+}
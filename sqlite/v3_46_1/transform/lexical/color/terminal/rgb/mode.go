@@ -0,0 +1,160 @@
+package rgb
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+)
+
+// Mode is the color mode of a terminal.
+type Mode int
+
+const (
+	// ModeTrueColor uses 24-bit colors: CSI 38;2;r;g;b m and CSI 48;2;r;g;b m.
+	ModeTrueColor Mode = iota
+	// Mode256 uses the 256 colors palette: CSI 38;5;n m and CSI 48;5;n m. Only the colors of the 6x6x6 cube and of the
+	// grayscale ramp are used, since the first 16 colors are usually changed by the terminal themes.
+	Mode256
+	// Mode16 uses the 16 colors palette: CSI n m with n between 30 and 37, 40 and 47, 90 and 97 or 100 and 107.
+	Mode16
+	// ModeNone don't uses colors nor attributes.
+	ModeNone
+)
+
+// String returns a string representation of m.
+func (m Mode) String() string {
+	switch m {
+	case ModeTrueColor:
+		return "TrueColor"
+	case Mode256:
+		return "256"
+	case Mode16:
+		return "16"
+	case ModeNone:
+		return "None"
+	}
+	return strconv.Itoa(int(m))
+}
+
+// DetectMode detects the color mode of the terminal from the environment variables NO_COLOR, COLORTERM and TERM.
+// The getenv function is used to read the variables, usually it is os.Getenv.
+//
+// If NO_COLOR is set to a non-empty value, or TERM is empty or equals to "dumb", the mode is ModeNone. If COLORTERM
+// is "truecolor" or "24bit" the mode is ModeTrueColor. If TERM contains "256color" or "truecolor" the mode is
+// Mode256 or ModeTrueColor, respectively. Otherwise the mode is Mode16.
+func DetectMode(getenv func(string) string) Mode {
+	if getenv("NO_COLOR") != "" {
+		return ModeNone
+	}
+	term := getenv("TERM")
+	if term == "dumb" {
+		return ModeNone
+	}
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ModeTrueColor
+	}
+	if term == "" {
+		return ModeNone
+	}
+	if strings.Contains(term, "truecolor") || strings.Contains(term, "direct") {
+		return ModeTrueColor
+	}
+	if strings.Contains(term, "256color") {
+		return Mode256
+	}
+	return Mode16
+}
+
+// lab is a color in the CIELAB color space.
+type lab struct {
+	l, a, b float64
+}
+
+// newLab converts c to the CIELAB color space, using the D65 illuminant.
+func newLab(c color.RGB) lab {
+	r, g, b := c.Components()
+	lr, lg, lb := linear(r), linear(g), linear(b)
+
+	x := (0.4124564*lr + 0.3575761*lg + 0.1804375*lb) / 0.95047
+	y := 0.2126729*lr + 0.7151522*lg + 0.0721750*lb
+	z := (0.0193339*lr + 0.1191920*lg + 0.9503041*lb) / 1.08883
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return lab{l: 116*fy - 16, a: 500 * (fx - fy), b: 200 * (fy - fz)}
+}
+
+// linear converts a sRGB component to linear light.
+func linear(c byte) float64 {
+	v := float64(c) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// labF is the function f of the conversion from CIEXYZ to CIELAB.
+func labF(t float64) float64 {
+	const delta = 6.0 / 29.0
+	if t > delta*delta*delta {
+		return math.Cbrt(t)
+	}
+	return t/(3*delta*delta) + 4.0/29.0
+}
+
+// distance returns the square of the euclidean distance between c and d, that is, the square of the CIE76 ΔE.
+func (c lab) distance(d lab) float64 {
+	dl, da, db := c.l-d.l, c.a-d.a, c.b-d.b
+	return dl*dl + da*da + db*db
+}
+
+// nearest returns the index of the color of palette that is perceptually nearest to c.
+func nearest(c color.RGB, palette []lab) int {
+	target := newLab(c)
+	best, bestDistance := 0, math.Inf(1)
+	for i := range palette {
+		if d := target.distance(palette[i]); d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return best
+}
+
+// palette16 are the colors of the 16 colors palette, as defined by xterm. The index of a color is its number in
+// the palette.
+var palette16 = newPalette(
+	color.NewRGB(0, 0, 0), color.NewRGB(205, 0, 0), color.NewRGB(0, 205, 0), color.NewRGB(205, 205, 0),
+	color.NewRGB(0, 0, 238), color.NewRGB(205, 0, 205), color.NewRGB(0, 205, 205), color.NewRGB(229, 229, 229),
+	color.NewRGB(127, 127, 127), color.NewRGB(255, 0, 0), color.NewRGB(0, 255, 0), color.NewRGB(255, 255, 0),
+	color.NewRGB(92, 92, 255), color.NewRGB(255, 0, 255), color.NewRGB(0, 255, 255), color.NewRGB(255, 255, 255),
+)
+
+// palette256 are the colors of the 256 colors palette from the number 16 onwards. The index of a color is its
+// number in the palette minus 16.
+var palette256 = func() []lab {
+	levels := []byte{0, 95, 135, 175, 215, 255}
+	var cs []color.RGB
+	for _, r := range levels {
+		for _, g := range levels {
+			for _, b := range levels {
+				cs = append(cs, color.NewRGB(r, g, b))
+			}
+		}
+	}
+	for i := range 24 {
+		v := byte(8 + 10*i)
+		cs = append(cs, color.NewRGB(v, v, v))
+	}
+	return newPalette(cs...)
+}()
+
+// newPalette converts the colors cs to the CIELAB color space.
+func newPalette(cs ...color.RGB) []lab {
+	p := make([]lab, len(cs))
+	for i := range cs {
+		p[i] = newLab(cs[i])
+	}
+	return p
+}
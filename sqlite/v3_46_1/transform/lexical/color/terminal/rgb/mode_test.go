@@ -0,0 +1,106 @@
+package rgb
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+)
+
+func TestDetectMode(t *testing.T) {
+	cases := []struct {
+		env  map[string]string
+		want Mode
+	}{
+		{env: map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, want: ModeTrueColor},
+		{env: map[string]string{"TERM": "xterm", "COLORTERM": "24bit"}, want: ModeTrueColor},
+		{env: map[string]string{"TERM": "xterm-direct"}, want: ModeTrueColor},
+		{env: map[string]string{"TERM": "screen-256color"}, want: Mode256},
+		{env: map[string]string{"TERM": "xterm"}, want: Mode16},
+		{env: map[string]string{"TERM": "dumb", "COLORTERM": "truecolor"}, want: ModeNone},
+		{env: map[string]string{}, want: ModeNone},
+		{env: map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor", "NO_COLOR": "1"}, want: ModeNone},
+	}
+
+	for _, c := range cases {
+		got := DetectMode(func(name string) string { return c.env[name] })
+		if got != c.want {
+			t.Errorf("DetectMode(%v) = %s, want %s", c.env, got, c.want)
+		}
+	}
+}
+
+func TestModeString(t *testing.T) {
+	cases := []struct {
+		m    Mode
+		want string
+	}{
+		{ModeTrueColor, "TrueColor"}, {Mode256, "256"}, {Mode16, "16"}, {ModeNone, "None"}, {Mode(-1), "-1"},
+	}
+
+	for _, c := range cases {
+		if c.m.String() != c.want {
+			t.Errorf("want %s, got %s", c.want, c.m)
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	cases := []struct {
+		c       color.RGB
+		palette []lab
+		want    int
+	}{
+		{color.NewRGB(0, 0, 0), palette16, 0},
+		{color.NewRGB(250, 10, 10), palette16, 9},
+		{color.NewRGB(190, 20, 20), palette16, 1},
+		{color.NewRGB(90, 90, 250), palette16, 12},
+		{color.NewRGB(255, 255, 255), palette256, 231 - 16},
+		{color.NewRGB(0xFF, 0x87, 0x00), palette256, 208 - 16},
+		{color.NewRGB(0x80, 0x80, 0x80), palette256, 244 - 16},
+	}
+
+	for _, c := range cases {
+		r, g, b := c.c.Components()
+		if got := nearest(c.c, c.palette); got != c.want {
+			t.Errorf("nearest(#%02X%02X%02X) = %d, want %d", r, g, b, got, c.want)
+		}
+	}
+}
+
+func TestTransformerMode(t *testing.T) {
+	cases := []struct {
+		mode     Mode
+		expected string
+	}{
+		{mode: ModeTrueColor, expected: "\x1B[38;2;255;135;0m\x1B[48;2;0;0;0m\x1B[1mselect\x1B[0m 1"},
+		{mode: Mode256, expected: "\x1B[38;5;208m\x1B[48;5;16m\x1B[1mselect\x1B[0m 1"},
+		{mode: Mode16, expected: "\x1B[31m\x1B[40m\x1B[1mselect\x1B[0m 1"},
+		{mode: ModeNone, expected: "select 1"},
+	}
+
+	for _, c := range cases {
+		lex := lexer.New([]byte("select 1"))
+		tr := lexical.Chain(
+			color.NewTransformerAttributes(lexical.IsKeyword, color.NewRGB(0xFF, 0x87, 0x00), color.NewRGB(0, 0, 0), color.AttributeBold),
+			NewTransformerMode(c.mode),
+		)
+		tp := lexical.NewTokenProvider(lex, tr)
+
+		var b strings.Builder
+		tok := tp.Next()
+		for tok.Kind != token.KindEOF {
+			b.Write(tok.Lexeme)
+			tok = tp.Next()
+		}
+
+		if c.expected != b.String() {
+			fmt.Printf("mode %s: want %q, got %q\n", c.mode, c.expected, b.String())
+			t.Fail()
+		}
+	}
+}
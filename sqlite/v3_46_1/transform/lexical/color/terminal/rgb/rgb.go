@@ -1,7 +1,11 @@
 // This package transforms a sequence of tokens by replacing tokens with kind equals color.TokenKindForegroundColor or
 // color.TokenKindForegroundColor by RGB colors in the format of ANSI escape codes. The codes of the color follow the
-// pattern: CSI 38;2;r;g;b m (foreground) or CSI 2;r;g;b m (background). This package uses CSI 0 m after an token that
-// received a color.
+// pattern: CSI 38;2;r;g;b m (foreground) or CSI 2;r;g;b m (background). The tokens with kind equals
+// color.TokenKindAttribute are replaced by the SGR codes of the attributes, for example CSI 1 m for bold. This package
+// uses CSI 0 m after an token that received a color or an attribute.
+//
+// On terminals without support for 24-bit colors the Transformer can downsample the colors to the 256 or 16 colors
+// palettes, see Mode and DetectMode.
 // See https://en.wikipedia.org/wiki/ANSI_escape_code.
 package rgb
 
@@ -11,6 +15,7 @@ import (
 
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal"
 )
 
 // resetToken is the token used for removing the color that was applied to a token.
@@ -21,26 +26,43 @@ type Transformer struct {
 	// previousToken stores the previousToken passed to Transform. It is used to determine when is necessary to
 	// put resetToken.
 	previousToken *token.Token
+	// mode is the color mode of the terminal.
+	mode Mode
+	// nearest caches the palette indexes of the colors already downsampled.
+	nearest map[color.RGB]int
 }
 
-// NewTransformer creates a Transformer.
+// NewTransformer creates a Transformer that emits 24-bit colors.
 func NewTransformer() *Transformer {
 	return &Transformer{}
 }
 
+// NewTransformerMode creates a Transformer that emits colors in the mode m. The colors are downsampled to the
+// perceptually nearest color of the palette of m. If m is ModeNone the colors and the attributes are removed.
+func NewTransformerMode(m Mode) *Transformer {
+	return &Transformer{mode: m, nearest: make(map[color.RGB]int)}
+}
+
 // Transform implements lexical.Transformer.
 func (t *Transformer) Transform(tok *token.Token) []*token.Token {
-	if tok.Kind == color.TokenKindForegroundColor {
-		r, g, b := t.rgb(tok)
-		newTok := token.New([]byte(fmt.Sprintf("\x1B[38;2;%d;%d;%dm", r, g, b)), TokenKindColor)
+	if t.mode == ModeNone && (tok.Kind == color.TokenKindForegroundColor || tok.Kind == color.TokenKindBackgroundColor ||
+		tok.Kind == color.TokenKindAttribute) {
+		return nil
+	} else if tok.Kind == color.TokenKindForegroundColor {
+		newTok := token.New([]byte("\x1B["+t.colorCode(tok, false)+"m"), TokenKindColor)
 		t.previousToken = newTok
 		return []*token.Token{newTok}
 	} else if tok.Kind == color.TokenKindBackgroundColor {
-		r, g, b := t.rgb(tok)
-		newTok := token.New([]byte(fmt.Sprintf("\x1B[48;2;%d;%d;%dm", r, g, b)), TokenKindColor)
+		newTok := token.New([]byte("\x1B["+t.colorCode(tok, true)+"m"), TokenKindColor)
 		t.previousToken = newTok
 		return []*token.Token{newTok}
-	} else if t.previousToken.Kind == TokenKindColor {
+	} else if tok.Kind == color.TokenKindAttribute {
+		var a color.Attribute
+		a.UnmarshalLexeme([1]byte(tok.Lexeme))
+		newTok := token.New([]byte("\x1B["+terminalAttribute(a).Codes()+"m"), TokenKindColor)
+		t.previousToken = newTok
+		return []*token.Token{newTok}
+	} else if t.previousToken != nil && t.previousToken.Kind == TokenKindColor {
 		t.previousToken = resetToken
 		return []*token.Token{tok, resetToken}
 	}
@@ -48,11 +70,66 @@ func (t *Transformer) Transform(tok *token.Token) []*token.Token {
 	return []*token.Token{tok}
 }
 
-// rgb extracts the RGB components of tok.
-func (t *Transformer) rgb(tok *token.Token) (r, g, b byte) {
+// colorCode returns the SGR code of the color of tok according to t.mode.
+func (t *Transformer) colorCode(tok *token.Token, background bool) string {
 	var c color.RGB
 	c.UnmarshalLexeme([4]byte(tok.Lexeme))
-	return c.Components()
+	switch t.mode {
+	case Mode256:
+		i, ok := t.nearest[c]
+		if !ok {
+			i = nearest(c, palette256)
+			t.nearest[c] = i
+		}
+		if background {
+			return fmt.Sprintf("48;5;%d", i+16)
+		}
+		return fmt.Sprintf("38;5;%d", i+16)
+	case Mode16:
+		i, ok := t.nearest[c]
+		if !ok {
+			i = nearest(c, palette16)
+			t.nearest[c] = i
+		}
+		code := 30 + i
+		if i >= 8 {
+			code = 90 + i - 8
+		}
+		if background {
+			code += 10
+		}
+		return strconv.Itoa(code)
+	default:
+		r, g, b := c.Components()
+		if background {
+			return fmt.Sprintf("48;2;%d;%d;%d", r, g, b)
+		}
+		return fmt.Sprintf("38;2;%d;%d;%d", r, g, b)
+	}
+}
+
+// terminalAttribute returns the attributes of the package terminal that correspond to a, whose SGR codes are the
+// codes of a.
+func terminalAttribute(a color.Attribute) terminal.Attribute {
+	ta := terminal.AttributeNil
+	for _, m := range attributes {
+		if a.Has(m.color) {
+			ta |= m.terminal
+		}
+	}
+	return ta
+}
+
+// attributes maps the attributes of the package color to the attributes of the package terminal.
+var attributes = []struct {
+	color    color.Attribute
+	terminal terminal.Attribute
+}{
+	{color.AttributeBold, terminal.AttributeBold},
+	{color.AttributeDim, terminal.AttributeDim},
+	{color.AttributeItalic, terminal.AttributeItalic},
+	{color.AttributeUnderline, terminal.AttributeUnderline},
+	{color.AttributeCurlyUnderline, terminal.AttributeCurlyUnderline},
 }
 
 // tokenKind is a type for token kinds speceific to this package.
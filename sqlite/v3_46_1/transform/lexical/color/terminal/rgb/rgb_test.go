@@ -9,6 +9,7 @@ import (
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
 	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color/terminal"
 )
 
 func TestColor(t *testing.T) {
@@ -55,6 +56,46 @@ func TestTransformers(t *testing.T) {
 	}
 }
 
+func TestAttributes(t *testing.T) {
+	lex := lexer.New([]byte("x select where"))
+	tr := lexical.Chain(
+		color.NewTransformers(
+			color.NewTransformerAttributes(
+				func(k token.Kind) bool { return k == token.KindSelect }, color.NewRGB(0xAA, 0xAA, 0xAA), color.Nil,
+				color.AttributeBold|color.AttributeUnderline,
+			),
+			color.NewTransformerAttributes(lexical.IsKeyword, color.Nil, color.Nil, color.AttributeCurlyUnderline|color.AttributeUnderline),
+		),
+		NewTransformer(),
+	)
+	tp := lexical.NewTokenProvider(lex, tr)
+
+	var b strings.Builder
+	tok := tp.Next()
+	for tok.Kind != token.KindEOF {
+		b.Write(tok.Lexeme)
+		tok = tp.Next()
+	}
+
+	expected := "x \x1B[38;2;170;170;170m\x1B[1;4mselect\x1B[0m \x1B[4:3mwhere\x1B[0m"
+	if expected != b.String() {
+		fmt.Printf("want %q, got %q\n", expected, b.String())
+		t.Fail()
+	}
+}
+
+func TestTerminalAttribute(t *testing.T) {
+	for a := color.AttributeBold; a <= color.AttributeCurlyUnderline; a <<= 1 {
+		if terminalAttribute(a) == terminal.AttributeNil {
+			t.Errorf("the attribute %d has no terminal attribute", a)
+		}
+	}
+	all := color.AttributeBold | color.AttributeDim | color.AttributeItalic | color.AttributeUnderline
+	if codes := terminalAttribute(all).Codes(); codes != "1;2;3;4" {
+		t.Errorf("got %q", codes)
+	}
+}
+
 func TestTokenKind(t *testing.T) {
 	if TokenKindColor.String() != "Color" {
 		fmt.Printf("want %s, got %s", "Color", TokenKindColor.String())
@@ -1,7 +1,8 @@
 // This package transforms a sequence of tokens by adding tokens that represent colors in the format of ANSI escape codes.
 // It supports the colors with code between 30 and 37, inclusive, that is, color for wich n is between 30 and 37,
-// inclusive, in the code CSI n m. It also support the colors with code between 40 and 47. This package uses CSI 0 m after
-// an token that received a color.
+// inclusive, in the code CSI n m. It also support the colors with code between 40 and 47, and the text attributes bold,
+// dim, italic, underline and curly underline. This package uses CSI 0 m after an token that received a color or an
+// attribute.
 // See https://en.wikipedia.org/wiki/ANSI_escape_code.
 package terminal
 
@@ -9,6 +10,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
 )
@@ -45,6 +47,40 @@ const (
 	BackgroundNil Background = 0
 )
 
+// Attribute represents a set of text attributes. The attributes can be combined with the | operator.
+type Attribute int
+
+const (
+	AttributeBold Attribute = 1 << iota
+	AttributeDim
+	AttributeItalic
+	AttributeUnderline
+	AttributeCurlyUnderline
+	// no attribute must be set.
+	AttributeNil Attribute = 0
+)
+
+// Codes returns the SGR codes of a separated by semicolons, that is, the n in CSI n m. The underline is omitted if
+// the curly underline is present.
+func (a Attribute) Codes() string {
+	var codes []string
+	if a&AttributeBold != 0 {
+		codes = append(codes, "1")
+	}
+	if a&AttributeDim != 0 {
+		codes = append(codes, "2")
+	}
+	if a&AttributeItalic != 0 {
+		codes = append(codes, "3")
+	}
+	if a&AttributeCurlyUnderline != 0 {
+		codes = append(codes, "4:3")
+	} else if a&AttributeUnderline != 0 {
+		codes = append(codes, "4")
+	}
+	return strings.Join(codes, ";")
+}
+
 // resetToken is the token used for removing the color that was applied to a token.
 var resetToken = token.New([]byte("\x1B[0m"), TokenKindReset)
 
@@ -58,6 +94,9 @@ type Transformer struct {
 	// background is the background color that will be applied to the next token. If it is BackgroundNil then
 	// the background color of the next token will not be Set.
 	background Background
+	// attributes are the text attributes that will be applied to the next token. If it is AttributeNil then no
+	// attribute will be set.
+	attributes Attribute
 }
 
 // NewTransformer creates a ColorTransformer.
@@ -65,8 +104,13 @@ func NewTransformer(kindPredicate func(token.Kind) bool, fg Foreground, bg Backg
 	return &Transformer{kindPredicate: kindPredicate, foreground: fg, background: bg}
 }
 
+// NewTransformerAttributes creates a ColorTransformer that also applies the text attributes attrs.
+func NewTransformerAttributes(kindPredicate func(token.Kind) bool, fg Foreground, bg Background, attrs Attribute) *Transformer {
+	return &Transformer{kindPredicate: kindPredicate, foreground: fg, background: bg, attributes: attrs}
+}
+
 // Transform implements lexical.Transformer. It adds tokens with Color if kindPredicate returns true for the kind of tok.
-// Also it adds tokens for resetting the color after the token that did have the color changed.
+// Also it adds tokens for resetting the color and the attributes after the token that did have them changed.
 func (ct *Transformer) Transform(tok *token.Token) []*token.Token {
 	if ct.kindPredicate(tok.Kind) {
 		return ct.transform(tok)
@@ -79,8 +123,15 @@ func (ct *Transformer) transform(tok *token.Token) []*token.Token {
 	var result []*token.Token
 	var colorChanged bool
 	var code string
+	if ct.attributes != AttributeNil {
+		code = ct.attributes.Codes()
+		colorChanged = true
+	}
 	if ct.foreground != ForegroundNil {
-		code = fmt.Sprintf("%d", ct.foreground)
+		if colorChanged {
+			code += ";"
+		}
+		code += fmt.Sprintf("%d", ct.foreground)
 		colorChanged = true
 	}
 	if ct.background != BackgroundNil {
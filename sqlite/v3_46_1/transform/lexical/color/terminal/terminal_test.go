@@ -48,6 +48,34 @@ func TestTransformers(t *testing.T) {
 	}
 }
 
+func TestAttributes(t *testing.T) {
+	lex := lexer.New([]byte("select -- comment\n*"))
+	tr := NewTransformers(
+		NewTransformerAttributes(lexical.IsKeyword, ForegroundBlue, BackgroundNil, AttributeBold),
+		NewTransformerAttributes(
+			func(k token.Kind) bool { return k == token.KindSQLComment }, ForegroundNil, BackgroundNil, AttributeItalic|AttributeDim,
+		),
+		NewTransformerAttributes(
+			func(k token.Kind) bool { return k == token.KindAsterisk }, ForegroundRed, BackgroundNil,
+			AttributeUnderline|AttributeCurlyUnderline,
+		),
+	)
+	tp := lexical.NewTokenProvider(lex, tr)
+
+	var b strings.Builder
+	tok := tp.Next()
+	for tok.Kind != token.KindEOF {
+		b.Write(tok.Lexeme)
+		tok = tp.Next()
+	}
+
+	expected := "\x1B[1;34mselect\x1B[0m \x1B[2;3m-- comment\x1B[0m\n\x1B[4:3;31m*\x1B[0m"
+	if expected != b.String() {
+		fmt.Printf("want %q, got %q\n", expected, b.String())
+		t.Fail()
+	}
+}
+
 func TestTokenKind(t *testing.T) {
 	if TokenKindColor.String() != "Color" {
 		fmt.Printf("want %s, got %s", "Color", TokenKindColor.String())
@@ -0,0 +1,316 @@
+// This package loads colour themes and builds the transformers of the package color from them. A theme is a JSON
+// document that maps token categories and parse tree kinds to styles, for example:
+//
+//	{
+//		"name": "example",
+//		"tokens": {
+//			"keyword": {"foreground": "#569CD6", "bold": true},
+//			"error": {"foreground": "#FFFFFF", "background": "#F44747", "curlyUnderline": true}
+//		},
+//		"tree": {
+//			"FunctionName": {"foreground": "#DCDCAA"}
+//		}
+//	}
+//
+// The token categories are keyword, operator, identifier, string, blob, numeric, comment, variable, whitespace and
+// error. The keys of tree are the string representations of the kinds of the package parsetree. Besides the colors, a
+// style can have the text attributes bold, dim, italic, underline and curlyUnderline.
+package theme
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parsetree"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical/color"
+)
+
+//go:embed light.json
+var lightJSON []byte
+
+//go:embed dark.json
+var darkJSON []byte
+
+// Style is the style applied to a token.
+type Style struct {
+	// Foreground is the foreground color in the format #RRGGBB. If it is empty the foreground color is not set.
+	Foreground string `json:"foreground,omitempty"`
+	// Background is the background color in the format #RRGGBB. If it is empty the background color is not set.
+	Background string `json:"background,omitempty"`
+	// Bold, Dim, Italic, Underline and CurlyUnderline are the text attributes.
+	Bold           bool `json:"bold,omitempty"`
+	Dim            bool `json:"dim,omitempty"`
+	Italic         bool `json:"italic,omitempty"`
+	Underline      bool `json:"underline,omitempty"`
+	CurlyUnderline bool `json:"curlyUnderline,omitempty"`
+}
+
+// Theme is a colour theme.
+type Theme struct {
+	// Name is the name of the theme.
+	Name string `json:"name"`
+	// Tokens maps token categories to styles.
+	Tokens map[string]Style `json:"tokens"`
+	// Tree maps the string representations of parse tree kinds to styles.
+	Tree map[string]Style `json:"tree"`
+}
+
+// Light returns the built-in light theme.
+func Light() *Theme {
+	return mustLoad(lightJSON)
+}
+
+// Dark returns the built-in dark theme.
+func Dark() *Theme {
+	return mustLoad(darkJSON)
+}
+
+// ErrUnknownTheme is returned by Builtin when there is no built-in theme with the given name.
+var ErrUnknownTheme = errors.New("theme: unknown built-in theme")
+
+// Builtin returns the built-in theme with the given name, that is, "light" or "dark".
+func Builtin(name string) (*Theme, error) {
+	switch name {
+	case "light":
+		return Light(), nil
+	case "dark":
+		return Dark(), nil
+	}
+	return nil, ErrUnknownTheme
+}
+
+// mustLoad loads a built-in theme. It panics on error.
+func mustLoad(data []byte) *Theme {
+	t, err := Load(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Load reads a theme from r and validates it.
+func Load(r io.Reader) (*Theme, error) {
+	var t Theme
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&t); err != nil {
+		return nil, fmt.Errorf("theme: %w", err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// LoadFile reads a theme from the file with the given name.
+func LoadFile(name string) (*Theme, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Validate reports the first invalid category, tree kind or color of t. The token categories are validated before
+// the tree kinds, and the names of each are validated in lexicographic order.
+func (t *Theme) Validate() error {
+	for _, name := range slices.Sorted(maps.Keys(t.Tokens)) {
+		s := t.Tokens[name]
+		if _, ok := categories[name]; !ok {
+			return fmt.Errorf("theme: unknown token category %q", name)
+		}
+		if _, err := s.transformer(nil); err != nil {
+			return fmt.Errorf("theme: token category %q: %w", name, err)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(t.Tree)) {
+		s := t.Tree[name]
+		if _, ok := parsetree.ParseKind(name); !ok {
+			return fmt.Errorf("theme: unknown parse tree kind %q", name)
+		}
+		if _, err := s.transformer(nil); err != nil {
+			return fmt.Errorf("theme: parse tree kind %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Transformers creates the color.Transformers that applies the styles of the token categories of t.
+func (t *Theme) Transformers() color.Transformers {
+	var cts []*color.Transformer
+	for _, name := range categoryOrder {
+		s, ok := t.Tokens[name]
+		if !ok {
+			continue
+		}
+		// the theme was validated when loaded.
+		ct, _ := s.transformer(categories[name])
+		cts = append(cts, ct)
+	}
+	return color.NewTransformers(cts...)
+}
+
+// TreeTransformer creates a lexical.Transformer that applies the styles of the parse tree kinds of t to the tokens
+// of trees, and the styles of the token categories to the others. The style of a token is the style of the kind of
+// its terminal or, if that kind has no style, the style of the nearest ancestor kind that has one.
+//
+// The tokens given to the returned transformer must be the tokens of the code from which trees were parsed, in the
+// same order. They are matched with the terminals of trees by kind and lexeme. The tokens ignored by the parser, like
+// white spaces and comments, receive the styles of their token categories.
+func (t *Theme) TreeTransformer(trees ...parsetree.Construction) lexical.Transformer {
+	tt := &treeTransformer{fallback: t.Transformers()}
+	styles := make(map[parsetree.Kind]*color.Transformer)
+	for name, s := range t.Tree {
+		k, _ := parsetree.ParseKind(name)
+		styles[k], _ = s.transformer(func(token.Kind) bool { return true })
+	}
+	for _, tree := range trees {
+		tt.walk(tree, nil, styles)
+	}
+	return tt
+}
+
+// transformer creates a color.Transformer for s.
+func (s Style) transformer(kindPredicate func(token.Kind) bool) (*color.Transformer, error) {
+	fg, err := parseRGB(s.Foreground)
+	if err != nil {
+		return nil, err
+	}
+	bg, err := parseRGB(s.Background)
+	if err != nil {
+		return nil, err
+	}
+	return color.NewTransformerAttributes(kindPredicate, fg, bg, s.attributes()), nil
+}
+
+// attributes returns the text attributes of s.
+func (s Style) attributes() color.Attribute {
+	a := color.AttributeNil
+	if s.Bold {
+		a |= color.AttributeBold
+	}
+	if s.Dim {
+		a |= color.AttributeDim
+	}
+	if s.Italic {
+		a |= color.AttributeItalic
+	}
+	if s.Underline {
+		a |= color.AttributeUnderline
+	}
+	if s.CurlyUnderline {
+		a |= color.AttributeCurlyUnderline
+	}
+	return a
+}
+
+// parseRGB parses a color in the format #RRGGBB. If s is empty the result is color.Nil.
+func parseRGB(s string) (color.RGB, error) {
+	if s == "" {
+		return color.Nil, nil
+	}
+	if len(s) != 7 || s[0] != '#' {
+		return color.Nil, fmt.Errorf("invalid color %q", s)
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return color.Nil, fmt.Errorf("invalid color %q", s)
+	}
+	return color.NewRGB(byte(v>>16), byte(v>>8), byte(v)), nil
+}
+
+// treeTransformer is the lexical.Transformer returned by Theme.TreeTransformer.
+type treeTransformer struct {
+	// terminals are the terminals of the trees, in order.
+	terminals []styledTerminal
+	// pos is the position on terminals of the next terminal to be matched.
+	pos int
+	// fallback is used for the tokens that doesn't have a style given by the trees.
+	fallback color.Transformers
+}
+
+// styledTerminal is a terminal and the transformer of its style.
+type styledTerminal struct {
+	tok *token.Token
+	// ct is nil if the terminal has no style.
+	ct *color.Transformer
+}
+
+// walk appends the terminals of c to tt.terminals. inherited is the style of the nearest styled ancestor of c.
+func (tt *treeTransformer) walk(c parsetree.Construction, inherited *color.Transformer, styles map[parsetree.Kind]*color.Transformer) {
+	if ct, ok := styles[c.Kind()]; ok {
+		inherited = ct
+	}
+	switch c := c.(type) {
+	case parsetree.NonTerminal:
+		for child := range c.Children {
+			tt.walk(child, inherited, styles)
+		}
+	case parsetree.Terminal:
+		if c.Token() != nil {
+			tt.terminals = append(tt.terminals, styledTerminal{tok: c.Token(), ct: inherited})
+		}
+	}
+}
+
+// Transform implements lexical.Transformer.
+func (tt *treeTransformer) Transform(tok *token.Token) []*token.Token {
+	if tt.pos < len(tt.terminals) {
+		st := tt.terminals[tt.pos]
+		if st.tok.Kind == tok.Kind && bytes.Equal(st.tok.Lexeme, tok.Lexeme) {
+			tt.pos++
+			if st.ct != nil {
+				return st.ct.Transform(tok)
+			}
+		}
+	}
+	return tt.fallback.Transform(tok)
+}
+
+// categoryOrder is the order in which the categories are tried. Note that the categories are disjoint.
+var categoryOrder = []string{
+	"keyword", "operator", "identifier", "string", "blob", "numeric", "comment", "variable", "whitespace", "error",
+}
+
+// categories maps the token categories to the predicate that determines if a kind is of the category.
+var categories = map[string]func(token.Kind) bool{
+	"keyword":  lexical.IsKeyword,
+	"operator": lexical.IsOperator,
+	"identifier": func(k token.Kind) bool {
+		return k == token.KindIdentifier
+	},
+	"string": func(k token.Kind) bool {
+		return k == token.KindString
+	},
+	"blob": func(k token.Kind) bool {
+		return k == token.KindBlob
+	},
+	"numeric": func(k token.Kind) bool {
+		return k == token.KindNumeric
+	},
+	"comment": func(k token.Kind) bool {
+		return k == token.KindSQLComment || k == token.KindCComment
+	},
+	"variable": func(k token.Kind) bool {
+		return k == token.KindQuestionVariable || k == token.KindColonVariable || k == token.KindAtVariable ||
+			k == token.KindDollarVariable
+	},
+	"whitespace": func(k token.Kind) bool {
+		return k == token.KindWhiteSpace || k == token.KindByteOrderMark
+	},
+	"error": func(k token.Kind) bool {
+		return k == token.KindErrorUnexpectedEOF || k == token.KindErrorBlobNotHexadecimal ||
+			k == token.KindErrorInvalidCharacter || k == token.KindErrorInvalidCharacterAfter || k == token.KindErrorInvalidUTF8
+	},
+}
@@ -0,0 +1,132 @@
+package theme
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/parser"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/transform/lexical"
+)
+
+func TestBuiltin(t *testing.T) {
+	for _, name := range []string{"light", "dark"} {
+		th, err := Builtin(name)
+		if err != nil {
+			t.Errorf("Builtin(%q): %s", name, err)
+			continue
+		}
+		if th.Name != name {
+			t.Errorf("want name %q, got %q", name, th.Name)
+		}
+	}
+	if _, err := Builtin("unknown"); err != ErrUnknownTheme {
+		t.Errorf("want ErrUnknownTheme, got %v", err)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	cases := []struct {
+		json string
+		msg  string
+	}{
+		{`{"tokens": {"keywords": {}}}`, `theme: unknown token category "keywords"`},
+		{`{"tree": {"Unknown": {}}}`, `theme: unknown parse tree kind "Unknown"`},
+		{`{"tokens": {"keyword": {"foreground": "red"}}}`, `theme: token category "keyword": invalid color "red"`},
+		{`{"tree": {"TableName": {"background": "#GGGGGG"}}}`, `theme: parse tree kind "TableName": invalid color "#GGGGGG"`},
+		{`{"colors": {}}`, `theme: json: unknown field "colors"`},
+		// the first invalid entry is the first in lexicographic order, the token categories before the tree kinds.
+		{
+			`{"tokens": {"zz": {}, "keyword": {"foreground": "red"}, "aa": {}}, "tree": {"Unknown": {}}}`,
+			`theme: unknown token category "aa"`,
+		},
+		{
+			`{"tree": {"Zz": {}, "TableName": {"background": "#GGGGGG"}, "Aa": {}}}`,
+			`theme: unknown parse tree kind "Aa"`,
+		},
+	}
+
+	for _, c := range cases {
+		_, err := Load(strings.NewReader(c.json))
+		if err == nil {
+			t.Errorf("%s: no error", c.json)
+		} else if err.Error() != c.msg {
+			t.Errorf("got error message %q, want %q", err.Error(), c.msg)
+		}
+	}
+}
+
+func TestTransformers(t *testing.T) {
+	th, err := Load(strings.NewReader(`{"tokens": {"keyword": {"foreground": "#000000", "background": "#AAAAAA"}, "numeric": {"background": "#0000BB"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := transform("select 1", th.Transformers())
+	expected := "\x00\x00\x00\x00\x00\xaa\xaa\xaaselect \x00\x00\x00\xbb1"
+	if expected != got {
+		fmt.Printf("want %q, got %q\n", expected, got)
+		t.Fail()
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	th, err := Load(strings.NewReader(`{"tokens": {
+		"keyword": {"bold": true, "dim": true, "italic": true, "underline": true, "curlyUnderline": true}
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := transform("select 1", th.Transformers())
+	expected := "\x1fselect 1"
+	if expected != got {
+		fmt.Printf("want %q, got %q\n", expected, got)
+		t.Fail()
+	}
+}
+
+func TestTreeTransformer(t *testing.T) {
+	th, err := Load(strings.NewReader(`{
+		"tokens": {"identifier": {"foreground": "#010101"}},
+		"tree": {"TableName": {"foreground": "#020202"}, "ColumnDefinition": {"foreground": "#030303"}}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := "CREATE TABLE t (c) /* comment */ ; x"
+	tree, _ := parser.New(lexer.New([]byte(code))).SQLStatement()
+
+	got := transform(code, th.TreeTransformer(tree))
+	expected := "CREATE TABLE \x00\x02\x02\x02t (\x00\x03\x03\x03c) /* comment */ ; \x00\x01\x01\x01x"
+	if expected != got {
+		fmt.Printf("want %q, got %q\n", expected, got)
+		t.Fail()
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	th, err := LoadFile("dark.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Name != "dark" {
+		t.Errorf("want name %q, got %q", "dark", th.Name)
+	}
+	if _, err := LoadFile("missing.json"); err == nil {
+		t.Error("no error")
+	}
+}
+
+// transform applies tr to the tokens of code and returns the concatenation of the resulting lexemes.
+func transform(code string, tr lexical.Transformer) string {
+	tp := lexical.NewTokenProvider(lexer.New([]byte(code)), tr)
+
+	var b strings.Builder
+	tok := tp.Next()
+	for tok.Kind != token.KindEOF {
+		b.Write(tok.Lexeme)
+		tok = tp.Next()
+	}
+	return b.String()
+}
@@ -16,7 +16,8 @@ type TokenProvider interface {
 
 // Transformer transforms tokens.
 type Transformer interface {
-	// Transform transforms tok.
+	// Transform transforms tok. The result can be empty, that is, tok can be removed. A token with kind
+	// token.KindEOF must not be removed.
 	Transform(tok *token.Token) []*token.Token
 }
 
@@ -79,9 +80,11 @@ func (tp *tokenProvider) Next() *token.Token {
 		tp.pos = 0
 	}
 
-	tok := tp.tp.Next()
-	tp.tokens = tp.t.Transform(tok)
-	tok = tp.tokens[0]
+	// the transformer can remove tokens, but not the EOF.
+	for len(tp.tokens) == 0 {
+		tp.tokens = tp.t.Transform(tp.tp.Next())
+	}
+	tok := tp.tokens[0]
 	tp.pos++
 	return tok
 }
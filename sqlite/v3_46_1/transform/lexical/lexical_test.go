@@ -36,6 +36,14 @@ func TestTransformer(t *testing.T) {
 			code:         "select * WHERE ok in table a",
 			transformers: []Transformer{newRepeat(IsOperator, 2)},
 			expected:     "select *** WHERE ok in table a",
+		}, {
+			code:         "select * WHERE ok in table a",
+			transformers: []Transformer{drop{func(k token.Kind) bool { return k == token.KindWhiteSpace }}},
+			expected:     "select*WHEREokintablea",
+		}, {
+			code:         "select * WHERE ok in table a",
+			transformers: []Transformer{drop{func(k token.Kind) bool { return k == token.KindWhiteSpace }}, KeywordToUppercase()},
+			expected:     "SELECT*WHEREokINTABLEa",
 		},
 	}
 
@@ -77,6 +85,18 @@ func (r *repeat) Transform(tok *token.Token) []*token.Token {
 	return result
 }
 
+// drop is a transformer that removes the tokens whose kind satisfies kindPredicate.
+type drop struct {
+	kindPredicate func(k token.Kind) bool
+}
+
+func (d drop) Transform(tok *token.Token) []*token.Token {
+	if d.kindPredicate(tok.Kind) {
+		return nil
+	}
+	return []*token.Token{tok}
+}
+
 func TestTokenKind(t *testing.T) {
 	if TokenKindAnsiCode.String() != "AnsiCode" {
 		fmt.Printf("TokenKindAnsiCode.String = %q, want \"AnsiCode\"\n", TokenKindAnsiCode.String())
@@ -0,0 +1,131 @@
+package lexical
+
+import (
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+// Window is a token and the tokens around it.
+type Window struct {
+	// previous are the tokens before current, the nearest last.
+	previous []*token.Token
+	// current is the token being transformed.
+	current *token.Token
+	// next are the tokens after current, the nearest first.
+	next []*token.Token
+}
+
+// Current returns the token being transformed.
+func (w *Window) Current() *token.Token {
+	return w.current
+}
+
+// Previous returns the n-th token before the current token, ignoring the tokens whose kind satisfies skip. If skip is
+// nil no token is ignored. The result is nil if the token is outside the window.
+func (w *Window) Previous(n int, skip func(token.Kind) bool) *token.Token {
+	for i := len(w.previous) - 1; i >= 0; i-- {
+		if skip != nil && skip(w.previous[i].Kind) {
+			continue
+		}
+		n--
+		if n == 0 {
+			return w.previous[i]
+		}
+	}
+	return nil
+}
+
+// Next returns the n-th token after the current token, ignoring the tokens whose kind satisfies skip. If skip is nil no
+// token is ignored. The result is nil if the token is outside the window.
+func (w *Window) Next(n int, skip func(token.Kind) bool) *token.Token {
+	for i := range w.next {
+		if skip != nil && skip(w.next[i].Kind) {
+			continue
+		}
+		n--
+		if n == 0 {
+			return w.next[i]
+		}
+	}
+	return nil
+}
+
+// WindowTransformer transforms tokens knowing the tokens around them.
+type WindowTransformer interface {
+	// Transform transforms the current token of w. The other tokens of w are the ones before and after the current
+	// token before the transformation. The result can be empty, but a token with kind token.KindEOF must not be removed.
+	// w must not be retained after Transform returns.
+	Transform(w *Window) []*token.Token
+}
+
+// WindowTransformerFunc is a function that implements WindowTransformer.
+type WindowTransformerFunc func(w *Window) []*token.Token
+
+// Transform implements WindowTransformer.
+func (f WindowTransformerFunc) Transform(w *Window) []*token.Token {
+	return f(w)
+}
+
+// window is a Transformer that applies a WindowTransformer.
+type window struct {
+	// t is the WindowTransformer applied.
+	t WindowTransformer
+	// before is the maximum number of tokens before the current token.
+	before int
+	// after is the maximum number of tokens after the current token.
+	after int
+	// previous are the tokens already transformed that are in the window.
+	previous []*token.Token
+	// pending are the tokens not transformed yet. The first is the next to be transformed.
+	pending []*token.Token
+}
+
+// NewWindow creates a Transformer that applies t with windows of up to before tokens before and after tokens after
+// the current token. The returned Transformer holds after tokens, that are returned when the tokens after them are
+// received, or when the token with kind token.KindEOF is received. It can be used with Chain and NewTokenProvider
+// like any other Transformer.
+func NewWindow(before, after int, t WindowTransformer) Transformer {
+	return &window{t: t, before: before, after: after}
+}
+
+// Transform implements Transformer.
+func (w *window) Transform(tok *token.Token) (result []*token.Token) {
+	w.pending = append(w.pending, tok)
+	for len(w.pending) > w.after || (tok.Kind == token.KindEOF && len(w.pending) > 0) {
+		result = append(result, w.step()...)
+	}
+	return
+}
+
+// step transforms the first pending token.
+func (w *window) step() []*token.Token {
+	result := w.t.Transform(&Window{previous: w.previous, current: w.pending[0], next: w.pending[1:]})
+
+	if w.before > 0 {
+		if len(w.previous) == w.before {
+			copy(w.previous, w.previous[1:])
+			w.previous = w.previous[:len(w.previous)-1]
+		}
+		w.previous = append(w.previous, w.pending[0])
+	}
+	w.pending = w.pending[1:]
+	return result
+}
+
+// DropWhiteSpaceBefore creates a Transformer that removes the white spaces followed by a token whose kind satisfies
+// kindPredicate, for example the white spaces before commas.
+func DropWhiteSpaceBefore(kindPredicate func(token.Kind) bool) Transformer {
+	return NewWindow(0, 1, WindowTransformerFunc(func(w *Window) []*token.Token {
+		if w.Current().Kind == token.KindWhiteSpace {
+			if next := w.Next(1, nil); next != nil && kindPredicate(next.Kind) {
+				return nil
+			}
+		}
+		return []*token.Token{w.Current()}
+	}))
+}
+
+// IsWhiteSpaceOrComment reports if k represents a white space or a comment. It can be used to skip tokens on the
+// methods of Window.
+func IsWhiteSpaceOrComment(k token.Kind) bool {
+	return k == token.KindWhiteSpace || k == token.KindSQLComment || k == token.KindCComment
+}
@@ -0,0 +1,102 @@
+package lexical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/joaobnv/mel/sqlite/v3_46_1/lexer"
+	"github.com/joaobnv/mel/sqlite/v3_46_1/token"
+)
+
+func TestWindow(t *testing.T) {
+	// primaryKey uppercases KEY only when it follows PRIMARY.
+	primaryKey := NewWindow(4, 0, WindowTransformerFunc(func(w *Window) []*token.Token {
+		tok := w.Current()
+		if prev := w.Previous(1, IsWhiteSpaceOrComment); tok.Kind == token.KindKey && prev != nil && prev.Kind == token.KindPrimary {
+			return []*token.Token{token.New(bytes.ToUpper(tok.Lexeme), tok.Kind)}
+		}
+		return []*token.Token{tok}
+	}))
+	// beforeEOF marks the two tokens before the EOF.
+	beforeEOF := NewWindow(0, 2, WindowTransformerFunc(func(w *Window) []*token.Token {
+		tok := w.Current()
+		if next := w.Next(2, nil); tok.Kind != token.KindEOF && (next == nil || next.Kind == token.KindEOF) {
+			return []*token.Token{token.New(append([]byte("_"), tok.Lexeme...), tok.Kind)}
+		}
+		return []*token.Token{tok}
+	}))
+	isComma := func(k token.Kind) bool { return k == token.KindComma }
+
+	cases := []struct {
+		code         string
+		transformers []Transformer
+		expected     string
+	}{
+		{
+			code:         "create table a(b primary key, key text)",
+			transformers: []Transformer{primaryKey},
+			expected:     "create table a(b primary KEY, key text)",
+		}, {
+			code:         "create table a(b primary /* c */ key, key text)",
+			transformers: []Transformer{primaryKey},
+			expected:     "create table a(b primary /* c */ KEY, key text)",
+		}, {
+			code:         "select a , b\t, c",
+			transformers: []Transformer{DropWhiteSpaceBefore(isComma)},
+			expected:     "select a, b, c",
+		}, {
+			code:         "select a , b",
+			transformers: []Transformer{DropWhiteSpaceBefore(isComma), KeywordToUppercase()},
+			expected:     "SELECT a, b",
+		}, {
+			code:         "select a , b",
+			transformers: []Transformer{KeywordToUppercase(), DropWhiteSpaceBefore(isComma), primaryKey},
+			expected:     "SELECT a, b",
+		}, {
+			code:         "select a",
+			transformers: []Transformer{beforeEOF},
+			expected:     "select_ _a",
+		}, {
+			code:         "",
+			transformers: []Transformer{beforeEOF},
+			expected:     "",
+		},
+	}
+
+	for _, c := range cases {
+		tp := NewTokenProvider(lexer.New([]byte(c.code)), Chain(c.transformers...))
+
+		var b strings.Builder
+		tok := tp.Next()
+		for tok.Kind != token.KindEOF {
+			b.Write(tok.Lexeme)
+			tok = tp.Next()
+		}
+
+		if c.expected != b.String() {
+			fmt.Printf("want %q, got %q\n", c.expected, b.String())
+			t.Fail()
+		}
+	}
+}
+
+func TestWindowAccessors(t *testing.T) {
+	toks := []*token.Token{
+		token.New([]byte("a"), token.KindIdentifier), token.New([]byte(" "), token.KindWhiteSpace),
+		token.New([]byte("b"), token.KindIdentifier), token.New([]byte(" "), token.KindWhiteSpace),
+		token.New([]byte("c"), token.KindIdentifier),
+	}
+	w := &Window{previous: toks[:2], current: toks[2], next: toks[3:]}
+
+	if w.Current() != toks[2] {
+		t.Errorf("wrong current token")
+	}
+	if w.Previous(1, nil) != toks[1] || w.Previous(1, IsWhiteSpaceOrComment) != toks[0] || w.Previous(2, IsWhiteSpaceOrComment) != nil {
+		t.Errorf("wrong previous tokens")
+	}
+	if w.Next(1, nil) != toks[3] || w.Next(1, IsWhiteSpaceOrComment) != toks[4] || w.Next(3, nil) != nil {
+		t.Errorf("wrong next tokens")
+	}
+}